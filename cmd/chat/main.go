@@ -1,7 +1,15 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/sesaquecruz/go-chat-api/config"
 	"github.com/sesaquecruz/go-chat-api/di"
@@ -26,14 +34,60 @@ import (
 //	@name						Authorization
 //	@description				API authorization token
 
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	logger := log.NewLogger("Main")
 
 	cfg := config.Load()
 
-	router := di.NewRouter(&cfg.Database, &cfg.Broker, &cfg.Api)
+	app := di.NewApplication(&cfg.Database, &cfg.Broker, &cfg.Api)
 	addr := fmt.Sprintf(":%s", cfg.Api.Port)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var workers sync.WaitGroup
+	workers.Add(3)
+
+	go func() {
+		defer workers.Done()
+		app.OutboxRelay.Start(ctx)
+	}()
+
+	go func() {
+		defer workers.Done()
+		app.IdempotencyKeyCleaner.Start(ctx)
+	}()
+
+	go func() {
+		defer workers.Done()
+		app.PresenceTracker.Start(ctx)
+	}()
+
+	server := &http.Server{
+		Addr:    addr,
+		Handler: app.Router,
+	}
+
+	go func() {
+		err := server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal(err)
+		}
+	}()
+
 	logger.Infof("server started on %s\n", addr)
-	router.Run(addr)
+	<-ctx.Done()
+
+	logger.Info("server shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error(err)
+	}
+
+	workers.Wait()
 }
@@ -15,10 +15,13 @@ type DatabaseConfig struct {
 }
 
 type BrokerConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
+	Host             string
+	Port             string
+	User             string
+	Password         string
+	Exchange         string
+	TypingExchange   string
+	PresenceExchange string
 }
 
 type ApiConfig struct {
@@ -55,6 +58,9 @@ func init() {
 	env.SetDefault("APP_BROKER_PORT", "")
 	env.SetDefault("APP_BROKER_USER", "")
 	env.SetDefault("APP_BROKER_PASSWORD", "")
+	env.SetDefault("APP_BROKER_EXCHANGE", "")
+	env.SetDefault("APP_BROKER_TYPING_EXCHANGE", "")
+	env.SetDefault("APP_BROKER_PRESENCE_EXCHANGE", "")
 	env.SetDefault("APP_API_PORT", "")
 	env.SetDefault("APP_API_PATH", "")
 	env.SetDefault("APP_API_MODE", "")
@@ -91,10 +97,13 @@ func Load() Config {
 	}
 
 	cfg.Broker = BrokerConfig{
-		Host:     getValue("APP_BROKER_HOST"),
-		Port:     getValue("APP_BROKER_PORT"),
-		User:     getValue("APP_BROKER_USER"),
-		Password: getValue("APP_BROKER_PASSWORD"),
+		Host:             getValue("APP_BROKER_HOST"),
+		Port:             getValue("APP_BROKER_PORT"),
+		User:             getValue("APP_BROKER_USER"),
+		Password:         getValue("APP_BROKER_PASSWORD"),
+		Exchange:         getValue("APP_BROKER_EXCHANGE"),
+		TypingExchange:   getValue("APP_BROKER_TYPING_EXCHANGE"),
+		PresenceExchange: getValue("APP_BROKER_PRESENCE_EXCHANGE"),
 	}
 
 	cfg.Api = ApiConfig{
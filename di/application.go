@@ -0,0 +1,15 @@
+package di
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/hub"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Application struct {
+	Router                *gin.Engine
+	OutboxRelay           *database.OutboxRelay
+	IdempotencyKeyCleaner *database.IdempotencyKeyCleaner
+	PresenceTracker       *hub.PresenceTracker
+}
@@ -9,17 +9,31 @@ import (
 	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/database"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/event"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/memory"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/handler"
+	direct_handler "github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/direct"
 	room_handler "github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/room"
+	user_handler "github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/user"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/hub"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/router"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
 	impl_usecase "github.com/sesaquecruz/go-chat-api/internal/usecase/impl"
 	"github.com/sesaquecruz/go-chat-api/pkg/health"
 
-	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 )
 
+// Connections
+var setBrokerConnection = wire.NewSet(
+	event.NewRabbitMqConnection,
+	wire.Bind(new(health.BrokerConnection), new(*event.RabbitMqConnection)),
+)
+
+var setTransactionManager = wire.NewSet(
+	database.NewPostgresTransactionManager,
+	wire.Bind(new(repository.TransactionManager), new(*database.PostgresTransactionManager)),
+)
+
 // Repositories
 var setRoomRepository = wire.NewSet(
 	database.NewRoomPostgresRepository,
@@ -31,10 +45,82 @@ var setMessageRepository = wire.NewSet(
 	wire.Bind(new(repository.MessageRepository), new(*database.MessagePostgresRepository)),
 )
 
+var setMemberRepository = wire.NewSet(
+	database.NewMemberPostgresRepository,
+	wire.Bind(new(repository.MemberRepository), new(*database.MemberPostgresRepository)),
+)
+
+var setInvitationRepository = wire.NewSet(
+	database.NewInvitationPostgresRepository,
+	wire.Bind(new(repository.InvitationRepository), new(*database.InvitationPostgresRepository)),
+)
+
+var setOwnershipTransferRepository = wire.NewSet(
+	database.NewOwnershipTransferPostgresRepository,
+	wire.Bind(new(repository.OwnershipTransferRepository), new(*database.OwnershipTransferPostgresRepository)),
+)
+
+var setBanRepository = wire.NewSet(
+	database.NewBanPostgresRepository,
+	wire.Bind(new(repository.BanRepository), new(*database.BanPostgresRepository)),
+)
+
+var setReactionRepository = wire.NewSet(
+	database.NewReactionPostgresRepository,
+	wire.Bind(new(repository.ReactionRepository), new(*database.ReactionPostgresRepository)),
+)
+
+var setPinRepository = wire.NewSet(
+	database.NewPinPostgresRepository,
+	wire.Bind(new(repository.PinRepository), new(*database.PinPostgresRepository)),
+)
+
+var setMuteRepository = wire.NewSet(
+	database.NewMutePostgresRepository,
+	wire.Bind(new(repository.MuteRepository), new(*database.MutePostgresRepository)),
+)
+
+var setDirectRoomRepository = wire.NewSet(
+	database.NewDirectRoomPostgresRepository,
+	wire.Bind(new(repository.DirectRoomRepository), new(*database.DirectRoomPostgresRepository)),
+)
+
+var setUserRoomRepository = wire.NewSet(
+	database.NewUserRoomPostgresRepository,
+	wire.Bind(new(repository.UserRoomRepository), new(*database.UserRoomPostgresRepository)),
+)
+
+var setMessageRevisionRepository = wire.NewSet(
+	database.NewMessageRevisionPostgresRepository,
+	wire.Bind(new(repository.MessageRevisionRepository), new(*database.MessageRevisionPostgresRepository)),
+)
+
+var setIdempotentRequestRepository = wire.NewSet(
+	database.NewIdempotentRequestPostgresRepository,
+	wire.Bind(new(repository.IdempotentRequestRepository), new(*database.IdempotentRequestPostgresRepository)),
+)
+
+var setPresenceRepository = wire.NewSet(
+	memory.NewPresenceMemoryRepository,
+	wire.Bind(new(repository.PresenceRepository), new(*memory.PresenceMemoryRepository)),
+)
+
 // Gateways
 var setMessageEventGateway = wire.NewSet(
 	event.NewMessageEventRabbitMqGateway,
-	wire.Bind(new(gateway.MessageEventGateway), new(*event.MessageEventRabbitMqGateway)),
+	database.NewMessageEventOutboxGateway,
+	wire.Bind(new(gateway.MessageEventGateway), new(*database.MessageEventOutboxGateway)),
+	wire.Bind(new(database.OutboxPublisher), new(*event.MessageEventRabbitMqGateway)),
+)
+
+var setTypingEventGateway = wire.NewSet(
+	event.NewTypingEventRabbitMqGateway,
+	wire.Bind(new(gateway.TypingEventGateway), new(*event.TypingEventRabbitMqGateway)),
+)
+
+var setPresenceEventGateway = wire.NewSet(
+	event.NewPresenceEventRabbitMqGateway,
+	wire.Bind(new(gateway.PresenceEventGateway), new(*event.PresenceEventRabbitMqGateway)),
 )
 
 // Use Cases
@@ -63,11 +149,186 @@ var setDeleteRoomUseCase = wire.NewSet(
 	wire.Bind(new(usecase.DeleteRoomUseCase), new(*impl_usecase.DeleteRoomUseCase)),
 )
 
+var setTransferOwnershipUseCase = wire.NewSet(
+	impl_usecase.NewTransferOwnershipUseCase,
+	wire.Bind(new(usecase.TransferOwnershipUseCase), new(*impl_usecase.TransferOwnershipUseCase)),
+)
+
+var setListOwnershipTransfersUseCase = wire.NewSet(
+	impl_usecase.NewListOwnershipTransfersUseCase,
+	wire.Bind(new(usecase.ListOwnershipTransfersUseCase), new(*impl_usecase.ListOwnershipTransfersUseCase)),
+)
+
+var setJoinRoomUseCase = wire.NewSet(
+	impl_usecase.NewJoinRoomUseCase,
+	wire.Bind(new(usecase.JoinRoomUseCase), new(*impl_usecase.JoinRoomUseCase)),
+)
+
+var setLeaveRoomUseCase = wire.NewSet(
+	impl_usecase.NewLeaveRoomUseCase,
+	wire.Bind(new(usecase.LeaveRoomUseCase), new(*impl_usecase.LeaveRoomUseCase)),
+)
+
+var setListMembersUseCase = wire.NewSet(
+	impl_usecase.NewListMembersUseCase,
+	wire.Bind(new(usecase.ListMembersUseCase), new(*impl_usecase.ListMembersUseCase)),
+)
+
+var setPromoteMemberUseCase = wire.NewSet(
+	impl_usecase.NewPromoteMemberUseCase,
+	wire.Bind(new(usecase.PromoteMemberUseCase), new(*impl_usecase.PromoteMemberUseCase)),
+)
+
+var setDemoteMemberUseCase = wire.NewSet(
+	impl_usecase.NewDemoteMemberUseCase,
+	wire.Bind(new(usecase.DemoteMemberUseCase), new(*impl_usecase.DemoteMemberUseCase)),
+)
+
+var setKickMemberUseCase = wire.NewSet(
+	impl_usecase.NewKickMemberUseCase,
+	wire.Bind(new(usecase.KickMemberUseCase), new(*impl_usecase.KickMemberUseCase)),
+)
+
+var setBanMemberUseCase = wire.NewSet(
+	impl_usecase.NewBanMemberUseCase,
+	wire.Bind(new(usecase.BanMemberUseCase), new(*impl_usecase.BanMemberUseCase)),
+)
+
+var setUnbanMemberUseCase = wire.NewSet(
+	impl_usecase.NewUnbanMemberUseCase,
+	wire.Bind(new(usecase.UnbanMemberUseCase), new(*impl_usecase.UnbanMemberUseCase)),
+)
+
+var setMuteMemberUseCase = wire.NewSet(
+	impl_usecase.NewMuteMemberUseCase,
+	wire.Bind(new(usecase.MuteMemberUseCase), new(*impl_usecase.MuteMemberUseCase)),
+)
+
+var setUnmuteMemberUseCase = wire.NewSet(
+	impl_usecase.NewUnmuteMemberUseCase,
+	wire.Bind(new(usecase.UnmuteMemberUseCase), new(*impl_usecase.UnmuteMemberUseCase)),
+)
+
+var setOpenDirectRoomUseCase = wire.NewSet(
+	impl_usecase.NewOpenDirectRoomUseCase,
+	wire.Bind(new(usecase.OpenDirectRoomUseCase), new(*impl_usecase.OpenDirectRoomUseCase)),
+)
+
+var setListDirectRoomsUseCase = wire.NewSet(
+	impl_usecase.NewListDirectRoomsUseCase,
+	wire.Bind(new(usecase.ListDirectRoomsUseCase), new(*impl_usecase.ListDirectRoomsUseCase)),
+)
+
+var setCreateInvitationUseCase = wire.NewSet(
+	impl_usecase.NewCreateInvitationUseCase,
+	wire.Bind(new(usecase.CreateInvitationUseCase), new(*impl_usecase.CreateInvitationUseCase)),
+)
+
+var setAcceptInvitationUseCase = wire.NewSet(
+	impl_usecase.NewAcceptInvitationUseCase,
+	wire.Bind(new(usecase.AcceptInvitationUseCase), new(*impl_usecase.AcceptInvitationUseCase)),
+)
+
+var setDeclineInvitationUseCase = wire.NewSet(
+	impl_usecase.NewDeclineInvitationUseCase,
+	wire.Bind(new(usecase.DeclineInvitationUseCase), new(*impl_usecase.DeclineInvitationUseCase)),
+)
+
 var setSendMessageUseCase = wire.NewSet(
 	impl_usecase.NewSendMessageUseCase,
 	wire.Bind(new(usecase.SendMessageUseCase), new(*impl_usecase.SendMessageUseCase)),
 )
 
+var setListMessagesUseCase = wire.NewSet(
+	impl_usecase.NewListMessagesUseCase,
+	wire.Bind(new(usecase.ListMessagesUseCase), new(*impl_usecase.ListMessagesUseCase)),
+)
+
+var setListMessageRevisionsUseCase = wire.NewSet(
+	impl_usecase.NewListMessageRevisionsUseCase,
+	wire.Bind(new(usecase.ListMessageRevisionsUseCase), new(*impl_usecase.ListMessageRevisionsUseCase)),
+)
+
+var setListRepliesUseCase = wire.NewSet(
+	impl_usecase.NewListRepliesUseCase,
+	wire.Bind(new(usecase.ListRepliesUseCase), new(*impl_usecase.ListRepliesUseCase)),
+)
+
+var setFindMessageUseCase = wire.NewSet(
+	impl_usecase.NewFindMessageUseCase,
+	wire.Bind(new(usecase.FindMessageUseCase), new(*impl_usecase.FindMessageUseCase)),
+)
+
+var setEditMessageUseCase = wire.NewSet(
+	impl_usecase.NewEditMessageUseCase,
+	wire.Bind(new(usecase.EditMessageUseCase), new(*impl_usecase.EditMessageUseCase)),
+)
+
+var setDeleteMessageUseCase = wire.NewSet(
+	impl_usecase.NewDeleteMessageUseCase,
+	wire.Bind(new(usecase.DeleteMessageUseCase), new(*impl_usecase.DeleteMessageUseCase)),
+)
+
+var setMarkRoomAsReadUseCase = wire.NewSet(
+	impl_usecase.NewMarkRoomAsReadUseCase,
+	wire.Bind(new(usecase.MarkRoomAsReadUseCase), new(*impl_usecase.MarkRoomAsReadUseCase)),
+)
+
+var setListMessageReadersUseCase = wire.NewSet(
+	impl_usecase.NewListMessageReadersUseCase,
+	wire.Bind(new(usecase.ListMessageReadersUseCase), new(*impl_usecase.ListMessageReadersUseCase)),
+)
+
+var setAddReactionUseCase = wire.NewSet(
+	impl_usecase.NewAddReactionUseCase,
+	wire.Bind(new(usecase.AddReactionUseCase), new(*impl_usecase.AddReactionUseCase)),
+)
+
+var setRemoveReactionUseCase = wire.NewSet(
+	impl_usecase.NewRemoveReactionUseCase,
+	wire.Bind(new(usecase.RemoveReactionUseCase), new(*impl_usecase.RemoveReactionUseCase)),
+)
+
+var setPinMessageUseCase = wire.NewSet(
+	impl_usecase.NewPinMessageUseCase,
+	wire.Bind(new(usecase.PinMessageUseCase), new(*impl_usecase.PinMessageUseCase)),
+)
+
+var setUnpinMessageUseCase = wire.NewSet(
+	impl_usecase.NewUnpinMessageUseCase,
+	wire.Bind(new(usecase.UnpinMessageUseCase), new(*impl_usecase.UnpinMessageUseCase)),
+)
+
+var setListPinsUseCase = wire.NewSet(
+	impl_usecase.NewListPinsUseCase,
+	wire.Bind(new(usecase.ListPinsUseCase), new(*impl_usecase.ListPinsUseCase)),
+)
+
+var setSendTypingUseCase = wire.NewSet(
+	impl_usecase.NewSendTypingUseCase,
+	wire.Bind(new(usecase.SendTypingUseCase), new(*impl_usecase.SendTypingUseCase)),
+)
+
+var setUpdatePresenceUseCase = wire.NewSet(
+	impl_usecase.NewUpdatePresenceUseCase,
+	wire.Bind(new(usecase.UpdatePresenceUseCase), new(*impl_usecase.UpdatePresenceUseCase)),
+)
+
+var setListPresenceUseCase = wire.NewSet(
+	impl_usecase.NewListPresenceUseCase,
+	wire.Bind(new(usecase.ListPresenceUseCase), new(*impl_usecase.ListPresenceUseCase)),
+)
+
+var setListUserRoomsUseCase = wire.NewSet(
+	impl_usecase.NewListUserRoomsUseCase,
+	wire.Bind(new(usecase.ListUserRoomsUseCase), new(*impl_usecase.ListUserRoomsUseCase)),
+)
+
+var setReplayMessagesUseCase = wire.NewSet(
+	impl_usecase.NewReplayMessagesUseCase,
+	wire.Bind(new(usecase.ReplayMessagesUseCase), new(*impl_usecase.ReplayMessagesUseCase)),
+)
+
 // Health
 var setHealth = wire.NewSet(
 	health.NewHealthCheck,
@@ -80,23 +341,48 @@ var setRoomHandler = wire.NewSet(
 	wire.Bind(new(handler.RoomHandler), new(*room_handler.RoomHandler)),
 )
 
+var setDirectHandler = wire.NewSet(
+	direct_handler.NewDirectHandler,
+	wire.Bind(new(handler.DirectHandler), new(*direct_handler.DirectHandler)),
+)
+
+var setUserHandler = wire.NewSet(
+	user_handler.NewUserHandler,
+	wire.Bind(new(handler.UserHandler), new(*user_handler.UserHandler)),
+)
+
 // Factories
-func NewRouter(
+func NewApplication(
 	db *config.DatabaseConfig,
 	broker *config.BrokerConfig,
 	api *config.ApiConfig,
-) *gin.Engine {
+) *Application {
 	wire.Build(
 		// Connections
 		database.PostgresConnection,
-		event.RabbitMqConnection,
+		setBrokerConnection,
+		setTransactionManager,
 
 		// Repositories
 		setRoomRepository,
 		setMessageRepository,
+		setMemberRepository,
+		setInvitationRepository,
+		setOwnershipTransferRepository,
+		setBanRepository,
+		setMuteRepository,
+		setReactionRepository,
+		setPinRepository,
+		setDirectRoomRepository,
+		setUserRoomRepository,
+		setMessageRevisionRepository,
+		setIdempotentRequestRepository,
+		setPresenceRepository,
 
 		// Gateways
 		setMessageEventGateway,
+		setTypingEventGateway,
+		setPresenceEventGateway,
 
 		// Use Cases
 		setCreateRoomUseCase,
@@ -104,17 +390,65 @@ func NewRouter(
 		setFindRoomUseCase,
 		setUpdateRoomUseCase,
 		setDeleteRoomUseCase,
+		setTransferOwnershipUseCase,
+		setListOwnershipTransfersUseCase,
+		setJoinRoomUseCase,
+		setLeaveRoomUseCase,
+		setListMembersUseCase,
+		setPromoteMemberUseCase,
+		setDemoteMemberUseCase,
+		setKickMemberUseCase,
+		setBanMemberUseCase,
+		setUnbanMemberUseCase,
+		setMuteMemberUseCase,
+		setUnmuteMemberUseCase,
+		setOpenDirectRoomUseCase,
+		setListDirectRoomsUseCase,
+		setCreateInvitationUseCase,
+		setAcceptInvitationUseCase,
+		setDeclineInvitationUseCase,
 		setSendMessageUseCase,
+		setListMessagesUseCase,
+		setListRepliesUseCase,
+		setListMessageRevisionsUseCase,
+		setFindMessageUseCase,
+		setEditMessageUseCase,
+		setDeleteMessageUseCase,
+		setMarkRoomAsReadUseCase,
+		setListMessageReadersUseCase,
+		setAddReactionUseCase,
+		setRemoveReactionUseCase,
+		setPinMessageUseCase,
+		setUnpinMessageUseCase,
+		setListPinsUseCase,
+		setSendTypingUseCase,
+		setUpdatePresenceUseCase,
+		setListPresenceUseCase,
+		setListUserRoomsUseCase,
+		setReplayMessagesUseCase,
+
+		// Hubs
+		hub.NewMessageHub,
+		hub.NewPresenceTracker,
 
 		// Health
 		setHealth,
 
 		// Handlers
 		setRoomHandler,
+		setDirectHandler,
+		setUserHandler,
 
 		// Router
 		router.ApiRouter,
+
+		// Workers
+		database.NewOutboxRelay,
+		database.NewIdempotencyKeyCleaner,
+
+		// Application
+		wire.Struct(new(Application), "*"),
 	)
 
-	return &gin.Engine{}
+	return &Application{}
 }
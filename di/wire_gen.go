@@ -7,15 +7,18 @@
 package di
 
 import (
-	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 	"github.com/sesaquecruz/go-chat-api/config"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/database"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/event"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/memory"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/handler"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/direct"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/room"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/user"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/hub"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/router"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase/impl"
@@ -25,33 +28,129 @@ import (
 // Injectors from wire.go:
 
 // Factories
-func NewRouter(db *config.DatabaseConfig, broker *config.BrokerConfig, api *config.ApiConfig) *gin.Engine {
+func NewApplication(db *config.DatabaseConfig, broker *config.BrokerConfig, api *config.ApiConfig) *Application {
 	sqlDB := database.PostgresConnection(db)
-	connection := event.RabbitMqConnection(broker)
-	healthCheck := health.NewHealthCheck(sqlDB, connection)
+	rabbitMqConnection := event.NewRabbitMqConnection(broker)
+	healthCheck := health.NewHealthCheck(sqlDB, rabbitMqConnection)
+	postgresTransactionManager := database.NewPostgresTransactionManager(sqlDB)
 	roomPostgresRepository := database.NewRoomPostgresRepository(sqlDB)
-	createRoomUseCase := impl.NewCreateRoomUseCase(roomPostgresRepository)
+	memberPostgresRepository := database.NewMemberPostgresRepository(sqlDB)
+	createRoomUseCase := impl.NewCreateRoomUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository)
 	searchRoomUseCase := impl.NewSearchRoomUseCase(roomPostgresRepository)
-	findRoomUseCase := impl.NewFindRoomUseCase(roomPostgresRepository)
-	updateRoomUseCase := impl.NewUpdateRoomUseCase(roomPostgresRepository)
-	deleteRoomUseCase := impl.NewDeleteRoomUseCase(roomPostgresRepository)
+	banPostgresRepository := database.NewBanPostgresRepository(sqlDB)
+	findRoomUseCase := impl.NewFindRoomUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository)
+	updateRoomUseCase := impl.NewUpdateRoomUseCase(roomPostgresRepository, memberPostgresRepository)
+	deleteRoomUseCase := impl.NewDeleteRoomUseCase(roomPostgresRepository, memberPostgresRepository)
+	ownershipTransferPostgresRepository := database.NewOwnershipTransferPostgresRepository(sqlDB)
+	messageEventRabbitMqGateway := event.NewMessageEventRabbitMqGateway(rabbitMqConnection, broker)
+	messageEventOutboxGateway := database.NewMessageEventOutboxGateway(sqlDB, messageEventRabbitMqGateway)
+	transferOwnershipUseCase := impl.NewTransferOwnershipUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, ownershipTransferPostgresRepository, messageEventOutboxGateway)
+	listOwnershipTransfersUseCase := impl.NewListOwnershipTransfersUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, ownershipTransferPostgresRepository)
+	joinRoomUseCase := impl.NewJoinRoomUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository)
+	leaveRoomUseCase := impl.NewLeaveRoomUseCase(roomPostgresRepository, memberPostgresRepository)
+	listMembersUseCase := impl.NewListMembersUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository)
+	promoteMemberUseCase := impl.NewPromoteMemberUseCase(roomPostgresRepository, memberPostgresRepository)
+	demoteMemberUseCase := impl.NewDemoteMemberUseCase(roomPostgresRepository, memberPostgresRepository)
+	kickMemberUseCase := impl.NewKickMemberUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, messageEventOutboxGateway)
+	banMemberUseCase := impl.NewBanMemberUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, banPostgresRepository, messageEventOutboxGateway)
+	unbanMemberUseCase := impl.NewUnbanMemberUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository)
+	mutePostgresRepository := database.NewMutePostgresRepository(sqlDB)
+	muteMemberUseCase := impl.NewMuteMemberUseCase(roomPostgresRepository, memberPostgresRepository, mutePostgresRepository)
+	unmuteMemberUseCase := impl.NewUnmuteMemberUseCase(roomPostgresRepository, memberPostgresRepository, mutePostgresRepository)
+	invitationPostgresRepository := database.NewInvitationPostgresRepository(sqlDB)
+	createInvitationUseCase := impl.NewCreateInvitationUseCase(roomPostgresRepository, memberPostgresRepository, invitationPostgresRepository)
+	acceptInvitationUseCase := impl.NewAcceptInvitationUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, banPostgresRepository, invitationPostgresRepository)
+	declineInvitationUseCase := impl.NewDeclineInvitationUseCase(roomPostgresRepository, invitationPostgresRepository)
 	messagePostgresRepository := database.NewMessagePostgresRepository(sqlDB)
-	messageEventRabbitMqGateway := event.NewMessageEventRabbitMqGateway(connection)
-	sendMessageUseCase := impl.NewSendMessageUseCase(roomPostgresRepository, messagePostgresRepository, messageEventRabbitMqGateway)
-	roomHandler := room.NewRoomHandler(createRoomUseCase, searchRoomUseCase, findRoomUseCase, updateRoomUseCase, deleteRoomUseCase, sendMessageUseCase)
-	engine := router.ApiRouter(api, healthCheck, roomHandler)
-	return engine
+	idempotentRequestPostgresRepository := database.NewIdempotentRequestPostgresRepository(sqlDB)
+	sendMessageUseCase := impl.NewSendMessageUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, mutePostgresRepository, messagePostgresRepository, idempotentRequestPostgresRepository, messageEventOutboxGateway)
+	listMessagesUseCase := impl.NewListMessagesUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, messagePostgresRepository)
+	listRepliesUseCase := impl.NewListRepliesUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, messagePostgresRepository)
+	findMessageUseCase := impl.NewFindMessageUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, messagePostgresRepository)
+	messageRevisionPostgresRepository := database.NewMessageRevisionPostgresRepository(sqlDB)
+	editMessageUseCase := impl.NewEditMessageUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, mutePostgresRepository, messagePostgresRepository, messageRevisionPostgresRepository, messageEventOutboxGateway)
+	pinPostgresRepository := database.NewPinPostgresRepository(sqlDB)
+	deleteMessageUseCase := impl.NewDeleteMessageUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, messagePostgresRepository, pinPostgresRepository, messageEventOutboxGateway)
+	markRoomAsReadUseCase := impl.NewMarkRoomAsReadUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, messagePostgresRepository, messageEventOutboxGateway)
+	listMessageReadersUseCase := impl.NewListMessageReadersUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, messagePostgresRepository)
+	listMessageRevisionsUseCase := impl.NewListMessageRevisionsUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, messagePostgresRepository, messageRevisionPostgresRepository)
+	reactionPostgresRepository := database.NewReactionPostgresRepository(sqlDB)
+	addReactionUseCase := impl.NewAddReactionUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, mutePostgresRepository, messagePostgresRepository, reactionPostgresRepository, messageEventOutboxGateway)
+	removeReactionUseCase := impl.NewRemoveReactionUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, messagePostgresRepository, reactionPostgresRepository, messageEventOutboxGateway)
+	pinMessageUseCase := impl.NewPinMessageUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, messagePostgresRepository, pinPostgresRepository, messageEventOutboxGateway)
+	unpinMessageUseCase := impl.NewUnpinMessageUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, pinPostgresRepository, messageEventOutboxGateway)
+	listPinsUseCase := impl.NewListPinsUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, pinPostgresRepository)
+	typingEventRabbitMqGateway := event.NewTypingEventRabbitMqGateway(rabbitMqConnection, broker)
+	sendTypingUseCase := impl.NewSendTypingUseCase(roomPostgresRepository, memberPostgresRepository, mutePostgresRepository, typingEventRabbitMqGateway)
+	presenceEventRabbitMqGateway := event.NewPresenceEventRabbitMqGateway(rabbitMqConnection, broker)
+	updatePresenceUseCase := impl.NewUpdatePresenceUseCase(roomPostgresRepository, memberPostgresRepository, presenceEventRabbitMqGateway)
+	presenceMemoryRepository := memory.NewPresenceMemoryRepository()
+	listPresenceUseCase := impl.NewListPresenceUseCase(roomPostgresRepository, memberPostgresRepository, banPostgresRepository, presenceMemoryRepository)
+	replayMessagesUseCase := impl.NewReplayMessagesUseCase(roomPostgresRepository, messagePostgresRepository)
+	messageHub := hub.NewMessageHub(messageEventOutboxGateway, typingEventRabbitMqGateway)
+	roomHandler := room.NewRoomHandler(createRoomUseCase, searchRoomUseCase, findRoomUseCase, updateRoomUseCase, deleteRoomUseCase, transferOwnershipUseCase, listOwnershipTransfersUseCase, joinRoomUseCase, leaveRoomUseCase, listMembersUseCase, promoteMemberUseCase, demoteMemberUseCase, kickMemberUseCase, banMemberUseCase, unbanMemberUseCase, muteMemberUseCase, unmuteMemberUseCase, createInvitationUseCase, acceptInvitationUseCase, declineInvitationUseCase, sendMessageUseCase, listMessagesUseCase, listRepliesUseCase, findMessageUseCase, editMessageUseCase, deleteMessageUseCase, markRoomAsReadUseCase, listMessageReadersUseCase, listMessageRevisionsUseCase, addReactionUseCase, removeReactionUseCase, pinMessageUseCase, unpinMessageUseCase, listPinsUseCase, sendTypingUseCase, updatePresenceUseCase, listPresenceUseCase, replayMessagesUseCase, messageHub)
+	directRoomPostgresRepository := database.NewDirectRoomPostgresRepository(sqlDB)
+	openDirectRoomUseCase := impl.NewOpenDirectRoomUseCase(postgresTransactionManager, roomPostgresRepository, memberPostgresRepository, directRoomPostgresRepository)
+	listDirectRoomsUseCase := impl.NewListDirectRoomsUseCase(directRoomPostgresRepository)
+	directHandler := direct.NewDirectHandler(openDirectRoomUseCase, listDirectRoomsUseCase)
+	userRoomPostgresRepository := database.NewUserRoomPostgresRepository(sqlDB)
+	listUserRoomsUseCase := impl.NewListUserRoomsUseCase(userRoomPostgresRepository)
+	userHandler := user.NewUserHandler(listUserRoomsUseCase)
+	engine := router.ApiRouter(api, healthCheck, roomHandler, directHandler, userHandler)
+	outboxRelay := database.NewOutboxRelay(sqlDB, messageEventRabbitMqGateway)
+	idempotencyKeyCleaner := database.NewIdempotencyKeyCleaner(sqlDB)
+	presenceTracker := hub.NewPresenceTracker(presenceMemoryRepository, presenceEventRabbitMqGateway, messageHub)
+	application := &Application{
+		Router:                engine,
+		OutboxRelay:           outboxRelay,
+		IdempotencyKeyCleaner: idempotencyKeyCleaner,
+		PresenceTracker:       presenceTracker,
+	}
+	return application
 }
 
 // wire.go:
 
+// Connections
+var setBrokerConnection = wire.NewSet(event.NewRabbitMqConnection, wire.Bind(new(health.BrokerConnection), new(*event.RabbitMqConnection)))
+
+var setTransactionManager = wire.NewSet(database.NewPostgresTransactionManager, wire.Bind(new(repository.TransactionManager), new(*database.PostgresTransactionManager)))
+
 // Repositories
 var setRoomRepository = wire.NewSet(database.NewRoomPostgresRepository, wire.Bind(new(repository.RoomRepository), new(*database.RoomPostgresRepository)))
 
 var setMessageRepository = wire.NewSet(database.NewMessagePostgresRepository, wire.Bind(new(repository.MessageRepository), new(*database.MessagePostgresRepository)))
 
+var setMemberRepository = wire.NewSet(database.NewMemberPostgresRepository, wire.Bind(new(repository.MemberRepository), new(*database.MemberPostgresRepository)))
+
+var setInvitationRepository = wire.NewSet(database.NewInvitationPostgresRepository, wire.Bind(new(repository.InvitationRepository), new(*database.InvitationPostgresRepository)))
+
+var setOwnershipTransferRepository = wire.NewSet(database.NewOwnershipTransferPostgresRepository, wire.Bind(new(repository.OwnershipTransferRepository), new(*database.OwnershipTransferPostgresRepository)))
+
+var setBanRepository = wire.NewSet(database.NewBanPostgresRepository, wire.Bind(new(repository.BanRepository), new(*database.BanPostgresRepository)))
+
+var setReactionRepository = wire.NewSet(database.NewReactionPostgresRepository, wire.Bind(new(repository.ReactionRepository), new(*database.ReactionPostgresRepository)))
+
+var setPinRepository = wire.NewSet(database.NewPinPostgresRepository, wire.Bind(new(repository.PinRepository), new(*database.PinPostgresRepository)))
+
+var setMuteRepository = wire.NewSet(database.NewMutePostgresRepository, wire.Bind(new(repository.MuteRepository), new(*database.MutePostgresRepository)))
+
+var setDirectRoomRepository = wire.NewSet(database.NewDirectRoomPostgresRepository, wire.Bind(new(repository.DirectRoomRepository), new(*database.DirectRoomPostgresRepository)))
+
+var setUserRoomRepository = wire.NewSet(database.NewUserRoomPostgresRepository, wire.Bind(new(repository.UserRoomRepository), new(*database.UserRoomPostgresRepository)))
+
+var setMessageRevisionRepository = wire.NewSet(database.NewMessageRevisionPostgresRepository, wire.Bind(new(repository.MessageRevisionRepository), new(*database.MessageRevisionPostgresRepository)))
+
+var setIdempotentRequestRepository = wire.NewSet(database.NewIdempotentRequestPostgresRepository, wire.Bind(new(repository.IdempotentRequestRepository), new(*database.IdempotentRequestPostgresRepository)))
+
+var setPresenceRepository = wire.NewSet(memory.NewPresenceMemoryRepository, wire.Bind(new(repository.PresenceRepository), new(*memory.PresenceMemoryRepository)))
+
 // Gateways
-var setMessageEventGateway = wire.NewSet(event.NewMessageEventRabbitMqGateway, wire.Bind(new(gateway.MessageEventGateway), new(*event.MessageEventRabbitMqGateway)))
+var setMessageEventGateway = wire.NewSet(event.NewMessageEventRabbitMqGateway, database.NewMessageEventOutboxGateway, wire.Bind(new(gateway.MessageEventGateway), new(*database.MessageEventOutboxGateway)), wire.Bind(new(database.OutboxPublisher), new(*event.MessageEventRabbitMqGateway)))
+
+var setTypingEventGateway = wire.NewSet(event.NewTypingEventRabbitMqGateway, wire.Bind(new(gateway.TypingEventGateway), new(*event.TypingEventRabbitMqGateway)))
+
+var setPresenceEventGateway = wire.NewSet(event.NewPresenceEventRabbitMqGateway, wire.Bind(new(gateway.PresenceEventGateway), new(*event.PresenceEventRabbitMqGateway)))
 
 // Use Cases
 var setCreateRoomUseCase = wire.NewSet(impl.NewCreateRoomUseCase, wire.Bind(new(usecase.CreateRoomUseCase), new(*impl.CreateRoomUseCase)))
@@ -64,10 +163,84 @@ var setUpdateRoomUseCase = wire.NewSet(impl.NewUpdateRoomUseCase, wire.Bind(new(
 
 var setDeleteRoomUseCase = wire.NewSet(impl.NewDeleteRoomUseCase, wire.Bind(new(usecase.DeleteRoomUseCase), new(*impl.DeleteRoomUseCase)))
 
+var setTransferOwnershipUseCase = wire.NewSet(impl.NewTransferOwnershipUseCase, wire.Bind(new(usecase.TransferOwnershipUseCase), new(*impl.TransferOwnershipUseCase)))
+
+var setListOwnershipTransfersUseCase = wire.NewSet(impl.NewListOwnershipTransfersUseCase, wire.Bind(new(usecase.ListOwnershipTransfersUseCase), new(*impl.ListOwnershipTransfersUseCase)))
+
+var setJoinRoomUseCase = wire.NewSet(impl.NewJoinRoomUseCase, wire.Bind(new(usecase.JoinRoomUseCase), new(*impl.JoinRoomUseCase)))
+
+var setLeaveRoomUseCase = wire.NewSet(impl.NewLeaveRoomUseCase, wire.Bind(new(usecase.LeaveRoomUseCase), new(*impl.LeaveRoomUseCase)))
+
+var setListMembersUseCase = wire.NewSet(impl.NewListMembersUseCase, wire.Bind(new(usecase.ListMembersUseCase), new(*impl.ListMembersUseCase)))
+
+var setPromoteMemberUseCase = wire.NewSet(impl.NewPromoteMemberUseCase, wire.Bind(new(usecase.PromoteMemberUseCase), new(*impl.PromoteMemberUseCase)))
+
+var setDemoteMemberUseCase = wire.NewSet(impl.NewDemoteMemberUseCase, wire.Bind(new(usecase.DemoteMemberUseCase), new(*impl.DemoteMemberUseCase)))
+
+var setKickMemberUseCase = wire.NewSet(impl.NewKickMemberUseCase, wire.Bind(new(usecase.KickMemberUseCase), new(*impl.KickMemberUseCase)))
+
+var setBanMemberUseCase = wire.NewSet(impl.NewBanMemberUseCase, wire.Bind(new(usecase.BanMemberUseCase), new(*impl.BanMemberUseCase)))
+
+var setUnbanMemberUseCase = wire.NewSet(impl.NewUnbanMemberUseCase, wire.Bind(new(usecase.UnbanMemberUseCase), new(*impl.UnbanMemberUseCase)))
+
+var setMuteMemberUseCase = wire.NewSet(impl.NewMuteMemberUseCase, wire.Bind(new(usecase.MuteMemberUseCase), new(*impl.MuteMemberUseCase)))
+
+var setUnmuteMemberUseCase = wire.NewSet(impl.NewUnmuteMemberUseCase, wire.Bind(new(usecase.UnmuteMemberUseCase), new(*impl.UnmuteMemberUseCase)))
+
+var setOpenDirectRoomUseCase = wire.NewSet(impl.NewOpenDirectRoomUseCase, wire.Bind(new(usecase.OpenDirectRoomUseCase), new(*impl.OpenDirectRoomUseCase)))
+
+var setListDirectRoomsUseCase = wire.NewSet(impl.NewListDirectRoomsUseCase, wire.Bind(new(usecase.ListDirectRoomsUseCase), new(*impl.ListDirectRoomsUseCase)))
+
+var setCreateInvitationUseCase = wire.NewSet(impl.NewCreateInvitationUseCase, wire.Bind(new(usecase.CreateInvitationUseCase), new(*impl.CreateInvitationUseCase)))
+
+var setAcceptInvitationUseCase = wire.NewSet(impl.NewAcceptInvitationUseCase, wire.Bind(new(usecase.AcceptInvitationUseCase), new(*impl.AcceptInvitationUseCase)))
+
+var setDeclineInvitationUseCase = wire.NewSet(impl.NewDeclineInvitationUseCase, wire.Bind(new(usecase.DeclineInvitationUseCase), new(*impl.DeclineInvitationUseCase)))
+
 var setSendMessageUseCase = wire.NewSet(impl.NewSendMessageUseCase, wire.Bind(new(usecase.SendMessageUseCase), new(*impl.SendMessageUseCase)))
 
+var setListMessagesUseCase = wire.NewSet(impl.NewListMessagesUseCase, wire.Bind(new(usecase.ListMessagesUseCase), new(*impl.ListMessagesUseCase)))
+
+var setListMessageRevisionsUseCase = wire.NewSet(impl.NewListMessageRevisionsUseCase, wire.Bind(new(usecase.ListMessageRevisionsUseCase), new(*impl.ListMessageRevisionsUseCase)))
+
+var setListRepliesUseCase = wire.NewSet(impl.NewListRepliesUseCase, wire.Bind(new(usecase.ListRepliesUseCase), new(*impl.ListRepliesUseCase)))
+
+var setFindMessageUseCase = wire.NewSet(impl.NewFindMessageUseCase, wire.Bind(new(usecase.FindMessageUseCase), new(*impl.FindMessageUseCase)))
+
+var setEditMessageUseCase = wire.NewSet(impl.NewEditMessageUseCase, wire.Bind(new(usecase.EditMessageUseCase), new(*impl.EditMessageUseCase)))
+
+var setDeleteMessageUseCase = wire.NewSet(impl.NewDeleteMessageUseCase, wire.Bind(new(usecase.DeleteMessageUseCase), new(*impl.DeleteMessageUseCase)))
+
+var setMarkRoomAsReadUseCase = wire.NewSet(impl.NewMarkRoomAsReadUseCase, wire.Bind(new(usecase.MarkRoomAsReadUseCase), new(*impl.MarkRoomAsReadUseCase)))
+
+var setListMessageReadersUseCase = wire.NewSet(impl.NewListMessageReadersUseCase, wire.Bind(new(usecase.ListMessageReadersUseCase), new(*impl.ListMessageReadersUseCase)))
+
+var setAddReactionUseCase = wire.NewSet(impl.NewAddReactionUseCase, wire.Bind(new(usecase.AddReactionUseCase), new(*impl.AddReactionUseCase)))
+
+var setRemoveReactionUseCase = wire.NewSet(impl.NewRemoveReactionUseCase, wire.Bind(new(usecase.RemoveReactionUseCase), new(*impl.RemoveReactionUseCase)))
+
+var setPinMessageUseCase = wire.NewSet(impl.NewPinMessageUseCase, wire.Bind(new(usecase.PinMessageUseCase), new(*impl.PinMessageUseCase)))
+
+var setUnpinMessageUseCase = wire.NewSet(impl.NewUnpinMessageUseCase, wire.Bind(new(usecase.UnpinMessageUseCase), new(*impl.UnpinMessageUseCase)))
+
+var setListPinsUseCase = wire.NewSet(impl.NewListPinsUseCase, wire.Bind(new(usecase.ListPinsUseCase), new(*impl.ListPinsUseCase)))
+
+var setSendTypingUseCase = wire.NewSet(impl.NewSendTypingUseCase, wire.Bind(new(usecase.SendTypingUseCase), new(*impl.SendTypingUseCase)))
+
+var setUpdatePresenceUseCase = wire.NewSet(impl.NewUpdatePresenceUseCase, wire.Bind(new(usecase.UpdatePresenceUseCase), new(*impl.UpdatePresenceUseCase)))
+
+var setListPresenceUseCase = wire.NewSet(impl.NewListPresenceUseCase, wire.Bind(new(usecase.ListPresenceUseCase), new(*impl.ListPresenceUseCase)))
+
+var setListUserRoomsUseCase = wire.NewSet(impl.NewListUserRoomsUseCase, wire.Bind(new(usecase.ListUserRoomsUseCase), new(*impl.ListUserRoomsUseCase)))
+
+var setReplayMessagesUseCase = wire.NewSet(impl.NewReplayMessagesUseCase, wire.Bind(new(usecase.ReplayMessagesUseCase), new(*impl.ReplayMessagesUseCase)))
+
 // Health
 var setHealth = wire.NewSet(health.NewHealthCheck, wire.Bind(new(health.Health), new(*health.HealthCheck)))
 
 // Handlers
 var setRoomHandler = wire.NewSet(room.NewRoomHandler, wire.Bind(new(handler.RoomHandler), new(*room.RoomHandler)))
+
+var setDirectHandler = wire.NewSet(direct.NewDirectHandler, wire.Bind(new(handler.DirectHandler), new(*direct.DirectHandler)))
+
+var setUserHandler = wire.NewSet(user.NewUserHandler, wire.Bind(new(handler.UserHandler), new(*user.UserHandler)))
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrUserBanned = validation.ForbiddenError("user is banned from the room")
+const ErrInvalidBanExpiration = validation.ValidationError("ban expiration must be in the future")
+
+// Ban keeps a user out of a room. A ban without expiration is permanent.
+type Ban struct {
+	roomId    *valueobject.Id
+	userId    *valueobject.UserId
+	bannedBy  *valueobject.UserId
+	createdAt *valueobject.Timestamp
+	expiresAt *valueobject.Timestamp
+}
+
+func NewBan(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	bannedBy *valueobject.UserId,
+	expiresAt *valueobject.Timestamp,
+) (*Ban, error) {
+
+	if expiresAt != nil && !time.Now().Before(expiresAt.Time()) {
+		return nil, ErrInvalidBanExpiration
+	}
+
+	return NewBanWith(
+		roomId,
+		userId,
+		bannedBy,
+		valueobject.NewTimestamp(),
+		expiresAt,
+	), nil
+}
+
+func NewBanWith(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	bannedBy *valueobject.UserId,
+	createdAt *valueobject.Timestamp,
+	expiresAt *valueobject.Timestamp,
+) *Ban {
+	return &Ban{
+		roomId:    roomId,
+		userId:    userId,
+		bannedBy:  bannedBy,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (b *Ban) RoomId() *valueobject.Id {
+	return b.roomId
+}
+
+func (b *Ban) UserId() *valueobject.UserId {
+	return b.userId
+}
+
+func (b *Ban) BannedBy() *valueobject.UserId {
+	return b.bannedBy
+}
+
+func (b *Ban) CreatedAt() *valueobject.Timestamp {
+	return b.createdAt
+}
+
+func (b *Ban) ExpiresAt() *valueobject.Timestamp {
+	return b.expiresAt
+}
+
+func (b *Ban) IsActive() bool {
+	return b.expiresAt == nil || time.Now().Before(b.expiresAt.Time())
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBan_ShouldCreateABanWhenDataIsValid(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	bannedBy, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	expiresAt := valueobject.NewTimestamp().Add(time.Hour)
+
+	ban, err := NewBan(roomId, userId, bannedBy, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, roomId.Value(), ban.RoomId().Value())
+	assert.Equal(t, userId.Value(), ban.UserId().Value())
+	assert.Equal(t, bannedBy.Value(), ban.BannedBy().Value())
+	assert.NotNil(t, ban.CreatedAt())
+	assert.Nil(t, ban.ExpiresAt())
+	assert.True(t, ban.IsActive())
+
+	ban, err = NewBan(roomId, userId, bannedBy, expiresAt)
+	assert.Nil(t, err)
+	assert.Equal(t, expiresAt.Value(), ban.ExpiresAt().Value())
+	assert.True(t, ban.IsActive())
+
+	createdAt := valueobject.NewTimestamp().Add(-2 * time.Hour)
+	ban = NewBanWith(roomId, userId, bannedBy, createdAt, createdAt.Add(time.Hour))
+	assert.Equal(t, createdAt.Value(), ban.CreatedAt().Value())
+	assert.False(t, ban.IsActive())
+}
+
+func TestBan_ShouldReturnAnErrorWhenTheExpirationIsInThePast(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	bannedBy, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ban, err := NewBan(roomId, userId, bannedBy, valueobject.NewTimestamp().Add(-time.Hour))
+	assert.Nil(t, ban)
+	assert.ErrorIs(t, err, ErrInvalidBanExpiration)
+	assert.IsType(t, validation.ValidationError(""), err)
+}
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrInvalidDirectRoomUser = validation.ValidationError("direct room must be between two different users")
+const ErrDirectRoomVisibility = validation.ValidationError("room visibility cannot be direct")
+const ErrDirectRoomUpdate = validation.ValidationError("direct room cannot be updated")
+const ErrDirectRoomInvitation = validation.ValidationError("direct room cannot have invitations")
+const ErrDirectRoomTransfer = validation.ValidationError("direct room cannot be transferred")
+
+const (
+	directRoomName     = "Direct message"
+	directRoomCategory = "General"
+)
+
+// DirectRoom is a private conversation between two users. It is kept as a
+// room, so it shares the messages, events and history of the rooms. The user
+// ids are sorted, so a pair of users has a single direct room.
+type DirectRoom struct {
+	room         *Room
+	firstUserId  *valueobject.UserId
+	secondUserId *valueobject.UserId
+	lastMessage  *Message
+}
+
+func NewDirectRoom(userId *valueobject.UserId, otherUserId *valueobject.UserId) (*DirectRoom, error) {
+	if userId.Value() == otherUserId.Value() {
+		return nil, ErrInvalidDirectRoomUser
+	}
+
+	name, _ := valueobject.NewRoomNameWith(directRoomName)
+	category, _ := valueobject.NewRoomCategoryWith(directRoomCategory)
+	visibility, _ := valueobject.NewRoomVisibilityWith(valueobject.RoomVisibilityDirect)
+	now := valueobject.NewTimestamp()
+
+	room := NewRoomWith(
+		valueobject.NewId(),
+		userId,
+		name,
+		category,
+		visibility,
+		now,
+		now,
+		nil,
+	)
+
+	return NewDirectRoomWith(room, userId, otherUserId, nil), nil
+}
+
+func NewDirectRoomWith(
+	room *Room,
+	firstUserId *valueobject.UserId,
+	secondUserId *valueobject.UserId,
+	lastMessage *Message,
+) *DirectRoom {
+	if firstUserId.Value() > secondUserId.Value() {
+		firstUserId, secondUserId = secondUserId, firstUserId
+	}
+
+	return &DirectRoom{
+		room:         room,
+		firstUserId:  firstUserId,
+		secondUserId: secondUserId,
+		lastMessage:  lastMessage,
+	}
+}
+
+func (d *DirectRoom) Room() *Room {
+	return d.room
+}
+
+func (d *DirectRoom) FirstUserId() *valueobject.UserId {
+	return d.firstUserId
+}
+
+func (d *DirectRoom) SecondUserId() *valueobject.UserId {
+	return d.secondUserId
+}
+
+// LastMessage returns the latest message of the room, or nil when the room
+// has no messages.
+func (d *DirectRoom) LastMessage() *Message {
+	return d.lastMessage
+}
+
+// OtherUserId returns the user of the room that is not the given user.
+func (d *DirectRoom) OtherUserId(userId *valueobject.UserId) *valueobject.UserId {
+	if d.firstUserId.Value() == userId.Value() {
+		return d.secondUserId
+	}
+
+	return d.firstUserId
+}
+
+// Members returns the memberships of the two users. None of them owns the
+// room, so the room cannot be updated, deleted or shared.
+func (d *DirectRoom) Members() []*Member {
+	return []*Member{
+		NewMember(d.room.Id(), d.firstUserId),
+		NewMember(d.room.Id(), d.secondUserId),
+	}
+}
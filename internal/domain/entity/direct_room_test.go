@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectRoom_ShouldCreateADirectRoomWhenUsersAreDifferent(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	otherUserId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+
+	directRoom, err := NewDirectRoom(userId, otherUserId)
+	assert.Nil(t, err)
+	assert.NotNil(t, directRoom.Room())
+	assert.True(t, directRoom.Room().IsDirect())
+	assert.True(t, directRoom.Room().IsPrivate())
+	assert.Equal(t, userId.Value(), directRoom.Room().AdminId().Value())
+	assert.Equal(t, otherUserId.Value(), directRoom.FirstUserId().Value())
+	assert.Equal(t, userId.Value(), directRoom.SecondUserId().Value())
+	assert.Equal(t, otherUserId.Value(), directRoom.OtherUserId(userId).Value())
+	assert.Equal(t, userId.Value(), directRoom.OtherUserId(otherUserId).Value())
+	assert.Nil(t, directRoom.LastMessage())
+
+	members := directRoom.Members()
+	assert.Len(t, members, 2)
+
+	for _, member := range members {
+		assert.Equal(t, directRoom.Room().Id().Value(), member.RoomId().Value())
+		assert.False(t, member.IsOwner())
+		assert.False(t, member.HasPermission(PermissionInviteMember))
+	}
+}
+
+func TestDirectRoom_ShouldReturnAnErrorWhenUsersAreTheSame(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+
+	directRoom, err := NewDirectRoom(userId, userId)
+	assert.Nil(t, directRoom)
+	assert.ErrorIs(t, err, ErrInvalidDirectRoomUser)
+	assert.IsType(t, validation.ValidationError(""), err)
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const IdempotentRequestTtl = 24 * time.Hour
+
+const ErrIdempotencyKeyReused = validation.ValidationError("idempotency key already used for a different request")
+
+// IdempotentRequest records the message created by a request sent with an
+// idempotency key, so retries of the request return the same message. The
+// request is identified by a hash of its content, as the message itself may be
+// edited or deleted before a retry.
+type IdempotentRequest struct {
+	senderId    *valueobject.UserId
+	key         *valueobject.IdempotencyKey
+	roomId      *valueobject.Id
+	messageId   *valueobject.Id
+	requestHash string
+	createdAt   *valueobject.Timestamp
+	expiresAt   *valueobject.Timestamp
+}
+
+func NewIdempotentRequest(
+	key *valueobject.IdempotencyKey,
+	message *Message,
+) *IdempotentRequest {
+	createdAt := valueobject.NewTimestamp()
+
+	return NewIdempotentRequestWith(
+		message.SenderId(),
+		key,
+		message.RoomId(),
+		message.Id(),
+		requestHash(message.RoomId(), message.Text(), message.ParentId()),
+		createdAt,
+		createdAt.Add(IdempotentRequestTtl),
+	)
+}
+
+func NewIdempotentRequestWith(
+	senderId *valueobject.UserId,
+	key *valueobject.IdempotencyKey,
+	roomId *valueobject.Id,
+	messageId *valueobject.Id,
+	requestHash string,
+	createdAt *valueobject.Timestamp,
+	expiresAt *valueobject.Timestamp,
+) *IdempotentRequest {
+	return &IdempotentRequest{
+		senderId:    senderId,
+		key:         key,
+		roomId:      roomId,
+		messageId:   messageId,
+		requestHash: requestHash,
+		createdAt:   createdAt,
+		expiresAt:   expiresAt,
+	}
+}
+
+func (r *IdempotentRequest) SenderId() *valueobject.UserId {
+	return r.senderId
+}
+
+func (r *IdempotentRequest) Key() *valueobject.IdempotencyKey {
+	return r.key
+}
+
+func (r *IdempotentRequest) RoomId() *valueobject.Id {
+	return r.roomId
+}
+
+func (r *IdempotentRequest) MessageId() *valueobject.Id {
+	return r.messageId
+}
+
+func (r *IdempotentRequest) RequestHash() string {
+	return r.requestHash
+}
+
+func (r *IdempotentRequest) CreatedAt() *valueobject.Timestamp {
+	return r.createdAt
+}
+
+func (r *IdempotentRequest) ExpiresAt() *valueobject.Timestamp {
+	return r.expiresAt
+}
+
+func (r *IdempotentRequest) IsExpired() bool {
+	return !time.Now().Before(r.expiresAt.Time())
+}
+
+// Matches reports whether a message with the room, text and parent is the one
+// the request was sent for.
+func (r *IdempotentRequest) Matches(
+	roomId *valueobject.Id,
+	text *valueobject.MessageText,
+	parentId *valueobject.Id,
+) bool {
+	return r.requestHash == requestHash(roomId, text, parentId)
+}
+
+func requestHash(roomId *valueobject.Id, text *valueobject.MessageText, parentId *valueobject.Id) string {
+	parent := ""
+	if parentId != nil {
+		parent = parentId.Value()
+	}
+
+	hash := sha256.Sum256([]byte(roomId.Value() + "|" + text.Value() + "|" + parent))
+	return hex.EncodeToString(hash[:])
+}
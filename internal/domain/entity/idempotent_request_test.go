@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotentRequest_ShouldCreateAnIdempotentRequestWhenDataIsValid(t *testing.T) {
+	roomId := valueobject.NewId()
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderName, _ := valueobject.NewUserNameWith("a username")
+	text, _ := valueobject.NewMessageTextWith("a simple message")
+	message := NewMessage(roomId, senderId, senderName, text)
+	key, _ := valueobject.NewIdempotencyKeyWith("8e03978e-40d5-43e8-bc93-6894a57f9324")
+
+	request := NewIdempotentRequest(key, message)
+	assert.Equal(t, senderId.Value(), request.SenderId().Value())
+	assert.Equal(t, key.Value(), request.Key().Value())
+	assert.Equal(t, roomId.Value(), request.RoomId().Value())
+	assert.Equal(t, message.Id().Value(), request.MessageId().Value())
+	assert.Equal(t, IdempotentRequestTtl, request.ExpiresAt().Time().Sub(request.CreatedAt().Time()))
+	assert.False(t, request.IsExpired())
+	assert.True(t, request.Matches(roomId, text, nil))
+}
+
+func TestIdempotentRequest_ShouldMatchTheSameRequestOnly(t *testing.T) {
+	roomId := valueobject.NewId()
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderName, _ := valueobject.NewUserNameWith("a username")
+	text, _ := valueobject.NewMessageTextWith("a simple message")
+	message := NewMessage(roomId, senderId, senderName, text)
+	key, _ := valueobject.NewIdempotencyKeyWith("8e03978e-40d5-43e8-bc93-6894a57f9324")
+
+	request := NewIdempotentRequest(key, message)
+
+	assert.True(t, request.Matches(roomId, text, nil))
+
+	otherText, _ := valueobject.NewMessageTextWith("another message")
+	assert.False(t, request.Matches(roomId, otherText, nil))
+	assert.False(t, request.Matches(valueobject.NewId(), text, nil))
+	assert.False(t, request.Matches(roomId, text, valueobject.NewId()))
+
+	edited, _ := valueobject.NewMessageTextWith("an edited message")
+	message.Edit(edited)
+	assert.True(t, request.Matches(roomId, text, nil))
+}
+
+func TestIdempotentRequest_ShouldBeExpiredAfterTheExpirationTime(t *testing.T) {
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	key, _ := valueobject.NewIdempotencyKeyWith("8e03978e-40d5-43e8-bc93-6894a57f9324")
+	createdAt, _ := valueobject.NewTimestampWith("2023-08-01T10:00:00Z")
+
+	request := NewIdempotentRequestWith(
+		senderId,
+		key,
+		valueobject.NewId(),
+		valueobject.NewId(),
+		"",
+		createdAt,
+		createdAt.Add(IdempotentRequestTtl),
+	)
+	assert.True(t, request.IsExpired())
+}
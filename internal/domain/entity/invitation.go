@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const InvitationTtl = 7 * 24 * time.Hour
+
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+)
+
+const ErrInvalidInvitationInvitee = validation.UnauthorizedError("invitation invitee is invalid")
+const ErrInvitationAlreadyAnswered = validation.ValidationError("invitation already answered")
+const ErrInvitationExpired = validation.ValidationError("invitation has expired")
+
+// Invitation lets a user join a private room. It is addressed to a single
+// user and identified by a random token that expires after InvitationTtl.
+type Invitation struct {
+	id         *valueobject.Id
+	roomId     *valueobject.Id
+	inviterId  *valueobject.UserId
+	inviteeId  *valueobject.UserId
+	token      *valueobject.InvitationToken
+	status     string
+	createdAt  *valueobject.Timestamp
+	expiresAt  *valueobject.Timestamp
+	answeredAt *valueobject.Timestamp
+}
+
+func NewInvitation(
+	roomId *valueobject.Id,
+	inviterId *valueobject.UserId,
+	inviteeId *valueobject.UserId,
+) *Invitation {
+	createdAt := valueobject.NewTimestamp()
+
+	return NewInvitationWith(
+		valueobject.NewId(),
+		roomId,
+		inviterId,
+		inviteeId,
+		valueobject.NewInvitationToken(),
+		InvitationPending,
+		createdAt,
+		createdAt.Add(InvitationTtl),
+		nil,
+	)
+}
+
+func NewInvitationWith(
+	id *valueobject.Id,
+	roomId *valueobject.Id,
+	inviterId *valueobject.UserId,
+	inviteeId *valueobject.UserId,
+	token *valueobject.InvitationToken,
+	status string,
+	createdAt *valueobject.Timestamp,
+	expiresAt *valueobject.Timestamp,
+	answeredAt *valueobject.Timestamp,
+) *Invitation {
+	return &Invitation{
+		id:         id,
+		roomId:     roomId,
+		inviterId:  inviterId,
+		inviteeId:  inviteeId,
+		token:      token,
+		status:     status,
+		createdAt:  createdAt,
+		expiresAt:  expiresAt,
+		answeredAt: answeredAt,
+	}
+}
+
+func (i *Invitation) Id() *valueobject.Id {
+	return i.id
+}
+
+func (i *Invitation) RoomId() *valueobject.Id {
+	return i.roomId
+}
+
+func (i *Invitation) InviterId() *valueobject.UserId {
+	return i.inviterId
+}
+
+func (i *Invitation) InviteeId() *valueobject.UserId {
+	return i.inviteeId
+}
+
+func (i *Invitation) Token() *valueobject.InvitationToken {
+	return i.token
+}
+
+func (i *Invitation) Status() string {
+	return i.status
+}
+
+func (i *Invitation) CreatedAt() *valueobject.Timestamp {
+	return i.createdAt
+}
+
+func (i *Invitation) ExpiresAt() *valueobject.Timestamp {
+	return i.expiresAt
+}
+
+func (i *Invitation) AnsweredAt() *valueobject.Timestamp {
+	return i.answeredAt
+}
+
+func (i *Invitation) IsExpired() bool {
+	return !time.Now().Before(i.expiresAt.Time())
+}
+
+// Accept answers the invitation and returns the membership of the invitee.
+func (i *Invitation) Accept(userId *valueobject.UserId) (*Member, error) {
+	if err := i.answer(userId, InvitationAccepted); err != nil {
+		return nil, err
+	}
+
+	return NewMember(i.roomId, i.inviteeId), nil
+}
+
+func (i *Invitation) Decline(userId *valueobject.UserId) error {
+	return i.answer(userId, InvitationDeclined)
+}
+
+func (i *Invitation) answer(userId *valueobject.UserId, status string) error {
+	if i.inviteeId.Value() != userId.Value() {
+		return ErrInvalidInvitationInvitee
+	}
+
+	if i.status != InvitationPending {
+		return ErrInvitationAlreadyAnswered
+	}
+
+	if i.IsExpired() {
+		return ErrInvitationExpired
+	}
+
+	i.status = status
+	i.answeredAt = valueobject.NewTimestamp()
+	return nil
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitation_ShouldCreateAnInvitationWhenDataIsValid(t *testing.T) {
+	roomId := valueobject.NewId()
+	inviterId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	invitation := NewInvitation(roomId, inviterId, inviteeId)
+	assert.NotNil(t, invitation.Id())
+	assert.Equal(t, roomId.Value(), invitation.RoomId().Value())
+	assert.Equal(t, inviterId.Value(), invitation.InviterId().Value())
+	assert.Equal(t, inviteeId.Value(), invitation.InviteeId().Value())
+	assert.NotNil(t, invitation.Token())
+	assert.Equal(t, InvitationPending, invitation.Status())
+	assert.Equal(t, InvitationTtl, invitation.ExpiresAt().Time().Sub(invitation.CreatedAt().Time()))
+	assert.Nil(t, invitation.AnsweredAt())
+	assert.False(t, invitation.IsExpired())
+}
+
+func TestInvitation_ShouldAcceptAnInvitationOnlyOnceByTheInvitee(t *testing.T) {
+	roomId := valueobject.NewId()
+	inviterId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := NewInvitation(roomId, inviterId, inviteeId)
+
+	member, err := invitation.Accept(inviterId)
+	assert.Nil(t, member)
+	assert.ErrorIs(t, err, ErrInvalidInvitationInvitee)
+
+	member, err = invitation.Accept(inviteeId)
+	assert.Nil(t, err)
+	assert.Equal(t, roomId.Value(), member.RoomId().Value())
+	assert.Equal(t, inviteeId.Value(), member.UserId().Value())
+	assert.Equal(t, InvitationAccepted, invitation.Status())
+	assert.NotNil(t, invitation.AnsweredAt())
+
+	err = invitation.Decline(inviteeId)
+	assert.ErrorIs(t, err, ErrInvitationAlreadyAnswered)
+}
+
+func TestInvitation_ShouldDeclineAnInvitation(t *testing.T) {
+	inviterId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := NewInvitation(valueobject.NewId(), inviterId, inviteeId)
+
+	err := invitation.Decline(inviteeId)
+	assert.Nil(t, err)
+	assert.Equal(t, InvitationDeclined, invitation.Status())
+
+	_, err = invitation.Accept(inviteeId)
+	assert.ErrorIs(t, err, ErrInvitationAlreadyAnswered)
+}
+
+func TestInvitation_ShouldNotBeAnsweredAfterTheExpirationTime(t *testing.T) {
+	inviterId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	createdAt, _ := valueobject.NewTimestampWith("2023-08-01T10:00:00Z")
+
+	invitation := NewInvitationWith(
+		valueobject.NewId(),
+		valueobject.NewId(),
+		inviterId,
+		inviteeId,
+		valueobject.NewInvitationToken(),
+		InvitationPending,
+		createdAt,
+		createdAt.Add(InvitationTtl),
+		nil,
+	)
+	assert.True(t, invitation.IsExpired())
+
+	_, err := invitation.Accept(inviteeId)
+	assert.ErrorIs(t, err, ErrInvitationExpired)
+	assert.Equal(t, InvitationPending, invitation.Status())
+}
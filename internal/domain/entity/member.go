@@ -0,0 +1,168 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrNotRoomMember = validation.UnauthorizedError("user is not a room member")
+const ErrAlreadyRoomMember = validation.ValidationError("user is already a room member")
+const ErrRoomOwnerCannotLeave = validation.ValidationError("room owner cannot leave the room")
+const ErrInvalidMemberPromotion = validation.ValidationError("only members can be promoted")
+const ErrInvalidMemberDemotion = validation.ValidationError("only moderators can be demoted")
+const ErrCannotModerateMember = validation.ForbiddenError("member has a role equal or above the user")
+
+type Member struct {
+	roomId   *valueobject.Id
+	userId   *valueobject.UserId
+	role     *valueobject.MemberRole
+	joinedAt *valueobject.Timestamp
+
+	lastReadMessageId *valueobject.Id
+}
+
+func NewMember(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) *Member {
+	return NewMemberWith(
+		roomId,
+		userId,
+		valueobject.NewMemberRole(),
+		valueobject.NewTimestamp(),
+		nil,
+	)
+}
+
+func NewRoomOwner(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) *Member {
+	role, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleOwner)
+
+	return NewMemberWith(
+		roomId,
+		userId,
+		role,
+		valueobject.NewTimestamp(),
+		nil,
+	)
+}
+
+func NewMemberWith(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	role *valueobject.MemberRole,
+	joinedAt *valueobject.Timestamp,
+	lastReadMessageId *valueobject.Id,
+) *Member {
+	return &Member{
+		roomId:            roomId,
+		userId:            userId,
+		role:              role,
+		joinedAt:          joinedAt,
+		lastReadMessageId: lastReadMessageId,
+	}
+}
+
+func (m *Member) RoomId() *valueobject.Id {
+	return m.roomId
+}
+
+func (m *Member) UserId() *valueobject.UserId {
+	return m.userId
+}
+
+func (m *Member) Role() *valueobject.MemberRole {
+	return m.role
+}
+
+func (m *Member) JoinedAt() *valueobject.Timestamp {
+	return m.joinedAt
+}
+
+// LastReadMessageId returns the last message read by the member, or nil when
+// the member did not read the room yet.
+func (m *Member) LastReadMessageId() *valueobject.Id {
+	return m.lastReadMessageId
+}
+
+func (m *Member) IsOwner() bool {
+	return m.role.IsOwner()
+}
+
+func (m *Member) HasPermission(permission Permission) bool {
+	for _, p := range rolePermissions[m.role.Value()] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Member) ValidatePermission(permission Permission) error {
+	if !m.HasPermission(permission) {
+		return ErrMissingPermission
+	}
+
+	return nil
+}
+
+func (m *Member) Promote() error {
+	if m.role.Value() != valueobject.MemberRoleMember {
+		return ErrInvalidMemberPromotion
+	}
+
+	m.role, _ = valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+	return nil
+}
+
+func (m *Member) Demote() error {
+	if !m.role.IsModerator() {
+		return ErrInvalidMemberDemotion
+	}
+
+	m.role = valueobject.NewMemberRole()
+	return nil
+}
+
+func (m *Member) MarkAsRead(messageId *valueobject.Id) {
+	m.lastReadMessageId = messageId
+}
+
+// TransferOwnership makes the member the owner of the room and the previous
+// owner a moderator. A previous admin that is not the owner keeps its role.
+func (m *Member) TransferOwnership(owner *Member) error {
+	if m.IsOwner() {
+		return ErrRoomAlreadyOwnedByUser
+	}
+
+	if owner.IsOwner() {
+		owner.role, _ = valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+	}
+
+	m.role, _ = valueobject.NewMemberRoleWith(valueobject.MemberRoleOwner)
+	return nil
+}
+
+// ValidateModeration allows the member to kick, ban or mute only the members
+// with a lower role.
+func (m *Member) ValidateModeration(member *Member) error {
+	if roleRank(m.role) <= roleRank(member.role) {
+		return ErrCannotModerateMember
+	}
+
+	return nil
+}
+
+func roleRank(role *valueobject.MemberRole) int {
+	switch {
+	case role.IsOwner():
+		return 2
+	case role.IsModerator():
+		return 1
+	default:
+		return 0
+	}
+}
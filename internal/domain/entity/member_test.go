@@ -0,0 +1,200 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMember_ShouldCreateAMemberWhenDataIsValid(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	role, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+	joinedAt := valueobject.NewTimestamp()
+	lastReadMessageId := valueobject.NewId()
+
+	member := NewMember(roomId, userId)
+	assert.Equal(t, roomId.Value(), member.RoomId().Value())
+	assert.Equal(t, userId.Value(), member.UserId().Value())
+	assert.Equal(t, valueobject.MemberRoleMember, member.Role().Value())
+	assert.NotNil(t, member.JoinedAt())
+	assert.Nil(t, member.LastReadMessageId())
+
+	member = NewRoomOwner(roomId, userId)
+	assert.Equal(t, roomId.Value(), member.RoomId().Value())
+	assert.Equal(t, userId.Value(), member.UserId().Value())
+	assert.Equal(t, valueobject.MemberRoleOwner, member.Role().Value())
+	assert.True(t, member.IsOwner())
+	assert.NotNil(t, member.JoinedAt())
+
+	member = NewMemberWith(roomId, userId, role, joinedAt, lastReadMessageId)
+	assert.Equal(t, roomId.Value(), member.RoomId().Value())
+	assert.Equal(t, userId.Value(), member.UserId().Value())
+	assert.Equal(t, role.Value(), member.Role().Value())
+	assert.Equal(t, joinedAt.Value(), member.JoinedAt().Value())
+	assert.Equal(t, lastReadMessageId.Value(), member.LastReadMessageId().Value())
+}
+
+func TestMember_ShouldMarkTheRoomAsRead(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	messageId := valueobject.NewId()
+
+	member := NewMember(roomId, userId)
+	member.MarkAsRead(messageId)
+	assert.Equal(t, messageId.Value(), member.LastReadMessageId().Value())
+}
+
+func TestMember_ShouldCheckThePermissionsOfTheRole(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+
+	testCases := []struct {
+		role        string
+		permissions map[Permission]bool
+	}{
+		{
+			valueobject.MemberRoleOwner,
+			map[Permission]bool{
+				PermissionUpdateRoom:       true,
+				PermissionDeleteRoom:       true,
+				PermissionInviteMember:     true,
+				PermissionDeleteAnyMessage: true,
+				PermissionKickMember:       true,
+				PermissionBanMember:        true,
+				PermissionMuteMember:       true,
+				PermissionManageRoles:      true,
+				PermissionTransferRoom:     true,
+				PermissionPinMessage:       true,
+			},
+		},
+		{
+			valueobject.MemberRoleModerator,
+			map[Permission]bool{
+				PermissionUpdateRoom:       false,
+				PermissionDeleteRoom:       false,
+				PermissionInviteMember:     true,
+				PermissionDeleteAnyMessage: true,
+				PermissionKickMember:       true,
+				PermissionBanMember:        true,
+				PermissionMuteMember:       true,
+				PermissionManageRoles:      false,
+				PermissionTransferRoom:     false,
+				PermissionPinMessage:       false,
+			},
+		},
+		{
+			valueobject.MemberRoleMember,
+			map[Permission]bool{
+				PermissionUpdateRoom:       false,
+				PermissionDeleteRoom:       false,
+				PermissionInviteMember:     false,
+				PermissionDeleteAnyMessage: false,
+				PermissionKickMember:       false,
+				PermissionBanMember:        false,
+				PermissionMuteMember:       false,
+				PermissionManageRoles:      false,
+				PermissionTransferRoom:     false,
+				PermissionPinMessage:       false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.role, func(t *testing.T) {
+			role, _ := valueobject.NewMemberRoleWith(tc.role)
+			member := NewMemberWith(roomId, userId, role, valueobject.NewTimestamp(), nil)
+
+			for permission, allowed := range tc.permissions {
+				assert.Equal(t, allowed, member.HasPermission(permission), permission)
+
+				err := member.ValidatePermission(permission)
+				if allowed {
+					assert.Nil(t, err)
+				} else {
+					assert.ErrorIs(t, err, ErrMissingPermission)
+					assert.IsType(t, validation.UnauthorizedError(""), err)
+				}
+			}
+		})
+	}
+}
+
+func TestMember_ShouldPromoteAndDemoteAMember(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+
+	member := NewMember(roomId, userId)
+	assert.ErrorIs(t, member.Demote(), ErrInvalidMemberDemotion)
+
+	assert.Nil(t, member.Promote())
+	assert.Equal(t, valueobject.MemberRoleModerator, member.Role().Value())
+	assert.ErrorIs(t, member.Promote(), ErrInvalidMemberPromotion)
+
+	assert.Nil(t, member.Demote())
+	assert.Equal(t, valueobject.MemberRoleMember, member.Role().Value())
+
+	owner := NewRoomOwner(roomId, userId)
+	assert.ErrorIs(t, owner.Promote(), ErrInvalidMemberPromotion)
+	assert.ErrorIs(t, owner.Demote(), ErrInvalidMemberDemotion)
+	assert.True(t, owner.IsOwner())
+}
+
+func TestMember_ShouldTransferTheOwnershipOfTheRoom(t *testing.T) {
+	roomId := valueobject.NewId()
+	ownerId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	owner := NewRoomOwner(roomId, ownerId)
+	member := NewMember(roomId, userId)
+
+	assert.ErrorIs(t, owner.TransferOwnership(owner), ErrRoomAlreadyOwnedByUser)
+
+	err := member.TransferOwnership(owner)
+	assert.Nil(t, err)
+	assert.True(t, member.IsOwner())
+	assert.Equal(t, valueobject.MemberRoleModerator, owner.Role().Value())
+}
+
+func TestMember_ShouldKeepTheRoleOfAPreviousAdminThatIsNotTheOwner(t *testing.T) {
+	roomId := valueobject.NewId()
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	admin := NewMember(roomId, adminId)
+	member := NewMember(roomId, userId)
+
+	err := member.TransferOwnership(admin)
+	assert.Nil(t, err)
+	assert.True(t, member.IsOwner())
+	assert.Equal(t, valueobject.MemberRoleMember, admin.Role().Value())
+}
+
+func TestMember_ShouldValidateTheModerationOfAMember(t *testing.T) {
+	roomId := valueobject.NewId()
+	ownerId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	moderatorId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+	moderatorRole, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+
+	owner := NewRoomOwner(roomId, ownerId)
+	moderator := NewMemberWith(roomId, moderatorId, moderatorRole, valueobject.NewTimestamp(), nil)
+	member := NewMember(roomId, userId)
+
+	assert.Nil(t, owner.ValidateModeration(moderator))
+	assert.Nil(t, owner.ValidateModeration(member))
+	assert.Nil(t, moderator.ValidateModeration(member))
+
+	for _, err := range []error{
+		moderator.ValidateModeration(owner),
+		moderator.ValidateModeration(moderator),
+		member.ValidateModeration(member),
+		member.ValidateModeration(owner),
+	} {
+		assert.ErrorIs(t, err, ErrCannotModerateMember)
+		assert.IsType(t, validation.ForbiddenError(""), err)
+	}
+}
@@ -1,16 +1,33 @@
 package entity
 
 import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 )
 
+const ErrMessageAlreadyDeleted = validation.ValidationError("message already deleted")
+const ErrInvalidMessageSender = validation.UnauthorizedError("message sender is invalid")
+const ErrInvalidMessageParent = validation.ValidationError("parent message is not in the room")
+const ErrNestedMessageReply = validation.ValidationError("a reply cannot be replied, reply to its parent message")
+
+// Message is sent to a room, or to the thread of a message when it has a
+// parent. Threads have one level, the replies of a parent message are not
+// replied. The reply count, the last reply and the reaction counts are read
+// from the replies and the reactions, they are not changed by the message
+// itself.
 type Message struct {
-	id         *valueobject.Id
-	roomId     *valueobject.Id
-	senderId   *valueobject.UserId
-	senderName *valueobject.UserName
-	text       *valueobject.MessageText
-	createdAt  *valueobject.Timestamp
+	id          *valueobject.Id
+	roomId      *valueobject.Id
+	senderId    *valueobject.UserId
+	senderName  *valueobject.UserName
+	text        *valueobject.MessageText
+	createdAt   *valueobject.Timestamp
+	editedAt    *valueobject.Timestamp
+	deletedAt   *valueobject.Timestamp
+	parentId    *valueobject.Id
+	replyCount  int
+	lastReplyAt *valueobject.Timestamp
+	reactions   []*ReactionCount
 }
 
 func NewMessage(
@@ -26,6 +43,12 @@ func NewMessage(
 		senderName,
 		text,
 		valueobject.NewTimestamp(),
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
 	)
 }
 
@@ -36,14 +59,26 @@ func NewMessageWith(
 	senderName *valueobject.UserName,
 	text *valueobject.MessageText,
 	createdAt *valueobject.Timestamp,
+	editedAt *valueobject.Timestamp,
+	deletedAt *valueobject.Timestamp,
+	parentId *valueobject.Id,
+	replyCount int,
+	lastReplyAt *valueobject.Timestamp,
+	reactions []*ReactionCount,
 ) *Message {
 	return &Message{
-		id:         id,
-		roomId:     roomId,
-		senderId:   senderId,
-		senderName: senderName,
-		text:       text,
-		createdAt:  createdAt,
+		id:          id,
+		roomId:      roomId,
+		senderId:    senderId,
+		senderName:  senderName,
+		text:        text,
+		createdAt:   createdAt,
+		editedAt:    editedAt,
+		deletedAt:   deletedAt,
+		parentId:    parentId,
+		replyCount:  replyCount,
+		lastReplyAt: lastReplyAt,
+		reactions:   reactions,
 	}
 }
 
@@ -70,3 +105,88 @@ func (m *Message) Text() *valueobject.MessageText {
 func (m *Message) CreatedAt() *valueobject.Timestamp {
 	return m.createdAt
 }
+
+func (m *Message) EditedAt() *valueobject.Timestamp {
+	return m.editedAt
+}
+
+func (m *Message) IsEdited() bool {
+	return m.editedAt != nil
+}
+
+func (m *Message) DeletedAt() *valueobject.Timestamp {
+	return m.deletedAt
+}
+
+func (m *Message) IsDeleted() bool {
+	return m.deletedAt != nil
+}
+
+func (m *Message) ParentId() *valueobject.Id {
+	return m.parentId
+}
+
+func (m *Message) IsReply() bool {
+	return m.parentId != nil
+}
+
+func (m *Message) ReplyCount() int {
+	return m.replyCount
+}
+
+func (m *Message) LastReplyAt() *valueobject.Timestamp {
+	return m.lastReplyAt
+}
+
+// Reactions returns the count of each emoji the message was reacted with.
+func (m *Message) Reactions() []*ReactionCount {
+	return m.reactions
+}
+
+// ReplyTo sends the message to the thread of the parent message, which must be
+// in the same room and must not be deleted or be a reply.
+func (m *Message) ReplyTo(parent *Message) error {
+	if parent.roomId.Value() != m.roomId.Value() {
+		return ErrInvalidMessageParent
+	}
+
+	if parent.IsDeleted() {
+		return ErrMessageAlreadyDeleted
+	}
+
+	if parent.IsReply() {
+		return ErrNestedMessageReply
+	}
+
+	m.parentId = parent.id
+
+	return nil
+}
+
+func (m *Message) ValidateSender(senderId *valueobject.UserId) error {
+	if m.senderId.Value() != senderId.Value() {
+		return ErrInvalidMessageSender
+	}
+
+	return nil
+}
+
+// Edit replaces the message text and returns a revision with the previous one.
+func (m *Message) Edit(text *valueobject.MessageText) *MessageRevision {
+	revision := NewMessageRevision(m.id, m.text)
+
+	m.text = text
+	m.editedAt = revision.CreatedAt()
+
+	return revision
+}
+
+func (m *Message) Delete() error {
+	if m.IsDeleted() {
+		return ErrMessageAlreadyDeleted
+	}
+
+	m.deletedAt = valueobject.NewTimestamp()
+
+	return nil
+}
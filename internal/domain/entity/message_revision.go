@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// MessageRevision keeps the text a message had before an edit.
+type MessageRevision struct {
+	id        *valueobject.Id
+	messageId *valueobject.Id
+	text      *valueobject.MessageText
+	createdAt *valueobject.Timestamp
+}
+
+func NewMessageRevision(
+	messageId *valueobject.Id,
+	text *valueobject.MessageText,
+) *MessageRevision {
+	return NewMessageRevisionWith(
+		valueobject.NewId(),
+		messageId,
+		text,
+		valueobject.NewTimestamp(),
+	)
+}
+
+func NewMessageRevisionWith(
+	id *valueobject.Id,
+	messageId *valueobject.Id,
+	text *valueobject.MessageText,
+	createdAt *valueobject.Timestamp,
+) *MessageRevision {
+	return &MessageRevision{
+		id:        id,
+		messageId: messageId,
+		text:      text,
+		createdAt: createdAt,
+	}
+}
+
+func (r *MessageRevision) Id() *valueobject.Id {
+	return r.id
+}
+
+func (r *MessageRevision) MessageId() *valueobject.Id {
+	return r.messageId
+}
+
+func (r *MessageRevision) Text() *valueobject.MessageText {
+	return r.text
+}
+
+func (r *MessageRevision) CreatedAt() *valueobject.Timestamp {
+	return r.createdAt
+}
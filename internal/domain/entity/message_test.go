@@ -3,6 +3,7 @@ package entity
 import (
 	"testing"
 
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 
 	"github.com/stretchr/testify/assert"
@@ -23,12 +24,108 @@ func TestMessage_ShouldCreateAMessageWhenDataIsValid(t *testing.T) {
 	assert.Equal(t, senderName.Value(), message.SenderName().Value())
 	assert.Equal(t, text.Value(), message.Text().Value())
 	assert.NotNil(t, message.CreatedAt())
+	assert.Nil(t, message.EditedAt())
+	assert.False(t, message.IsEdited())
+	assert.Nil(t, message.DeletedAt())
+	assert.False(t, message.IsDeleted())
 
-	message = NewMessageWith(id, roomId, senderId, senderName, text, createdAt)
+	assert.Nil(t, message.ParentId())
+	assert.False(t, message.IsReply())
+	assert.Zero(t, message.ReplyCount())
+	assert.Nil(t, message.LastReplyAt())
+
+	assert.Empty(t, message.Reactions())
+
+	emoji, _ := valueobject.NewEmojiWith("👍")
+	reactions := []*ReactionCount{NewReactionCountWith(emoji, 1)}
+
+	message = NewMessageWith(id, roomId, senderId, senderName, text, createdAt, nil, nil, nil, 2, createdAt, reactions)
 	assert.Equal(t, id.Value(), message.Id().Value())
 	assert.Equal(t, roomId.Value(), message.RoomId().Value())
 	assert.Equal(t, senderId.Value(), message.SenderId().Value())
 	assert.Equal(t, senderName.Value(), message.SenderName().Value())
 	assert.Equal(t, text.Value(), message.Text().Value())
 	assert.Equal(t, createdAt.Value(), message.CreatedAt().Value())
+	assert.Equal(t, 2, message.ReplyCount())
+	assert.Equal(t, createdAt.Value(), message.LastReplyAt().Value())
+	assert.Equal(t, reactions, message.Reactions())
+}
+
+func TestShouldReplyToAMessageOfTheSameRoom(t *testing.T) {
+	roomId := valueobject.NewId()
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderName, _ := valueobject.NewUserNameWith("a username")
+	text, _ := valueobject.NewMessageTextWith("a simple message")
+
+	parent := NewMessage(roomId, senderId, senderName, text)
+	reply := NewMessage(roomId, senderId, senderName, text)
+
+	err := reply.ReplyTo(parent)
+	assert.Nil(t, err)
+	assert.True(t, reply.IsReply())
+	assert.Equal(t, parent.Id().Value(), reply.ParentId().Value())
+
+	err = NewMessage(roomId, senderId, senderName, text).ReplyTo(reply)
+	assert.IsType(t, validation.ValidationError(""), err)
+	assert.ErrorIs(t, err, ErrNestedMessageReply)
+
+	err = NewMessage(valueobject.NewId(), senderId, senderName, text).ReplyTo(parent)
+	assert.IsType(t, validation.ValidationError(""), err)
+	assert.ErrorIs(t, err, ErrInvalidMessageParent)
+
+	parent.Delete()
+	err = NewMessage(roomId, senderId, senderName, text).ReplyTo(parent)
+	assert.IsType(t, validation.ValidationError(""), err)
+	assert.ErrorIs(t, err, ErrMessageAlreadyDeleted)
+}
+
+func TestShouldValidateAMessageSender(t *testing.T) {
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderName, _ := valueobject.NewUserNameWith("a username")
+	text, _ := valueobject.NewMessageTextWith("a simple message")
+	message := NewMessage(valueobject.NewId(), senderId, senderName, text)
+
+	err := message.ValidateSender(senderId)
+	assert.Nil(t, err)
+
+	otherId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533g")
+	err = message.ValidateSender(otherId)
+	assert.IsType(t, validation.UnauthorizedError(""), err)
+	assert.ErrorIs(t, err, ErrInvalidMessageSender)
+}
+
+func TestShouldEditAMessage(t *testing.T) {
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderName, _ := valueobject.NewUserNameWith("a username")
+	text, _ := valueobject.NewMessageTextWith("a simple message")
+	message := NewMessage(valueobject.NewId(), senderId, senderName, text)
+
+	newText, _ := valueobject.NewMessageTextWith("an edited message")
+
+	revision := message.Edit(newText)
+	assert.Equal(t, newText.Value(), message.Text().Value())
+	assert.True(t, message.IsEdited())
+	assert.Equal(t, revision.CreatedAt().Value(), message.EditedAt().Value())
+
+	assert.NotNil(t, revision.Id())
+	assert.Equal(t, message.Id().Value(), revision.MessageId().Value())
+	assert.Equal(t, text.Value(), revision.Text().Value())
+}
+
+func TestShouldDeleteAMessage(t *testing.T) {
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderName, _ := valueobject.NewUserNameWith("a username")
+	text, _ := valueobject.NewMessageTextWith("a simple message")
+	message := NewMessage(valueobject.NewId(), senderId, senderName, text)
+
+	err := message.Delete()
+	assert.Nil(t, err)
+	assert.True(t, message.IsDeleted())
+
+	deletedAt := message.DeletedAt()
+
+	err = message.Delete()
+	assert.IsType(t, validation.ValidationError(""), err)
+	assert.ErrorIs(t, err, ErrMessageAlreadyDeleted)
+	assert.Equal(t, deletedAt, message.DeletedAt())
 }
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrUserMuted = validation.ForbiddenError("user is muted in the room")
+const ErrRequiredMuteExpiration = validation.ValidationError("mute expiration is required")
+const ErrInvalidMuteExpiration = validation.ValidationError("mute expiration must be in the future")
+
+// Mute keeps a member from sending messages to a room until it expires.
+type Mute struct {
+	roomId    *valueobject.Id
+	userId    *valueobject.UserId
+	mutedBy   *valueobject.UserId
+	createdAt *valueobject.Timestamp
+	expiresAt *valueobject.Timestamp
+}
+
+func NewMute(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	mutedBy *valueobject.UserId,
+	expiresAt *valueobject.Timestamp,
+) (*Mute, error) {
+
+	if expiresAt == nil {
+		return nil, ErrRequiredMuteExpiration
+	}
+
+	if !time.Now().Before(expiresAt.Time()) {
+		return nil, ErrInvalidMuteExpiration
+	}
+
+	return NewMuteWith(
+		roomId,
+		userId,
+		mutedBy,
+		valueobject.NewTimestamp(),
+		expiresAt,
+	), nil
+}
+
+func NewMuteWith(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	mutedBy *valueobject.UserId,
+	createdAt *valueobject.Timestamp,
+	expiresAt *valueobject.Timestamp,
+) *Mute {
+	return &Mute{
+		roomId:    roomId,
+		userId:    userId,
+		mutedBy:   mutedBy,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (m *Mute) RoomId() *valueobject.Id {
+	return m.roomId
+}
+
+func (m *Mute) UserId() *valueobject.UserId {
+	return m.userId
+}
+
+func (m *Mute) MutedBy() *valueobject.UserId {
+	return m.mutedBy
+}
+
+func (m *Mute) CreatedAt() *valueobject.Timestamp {
+	return m.createdAt
+}
+
+func (m *Mute) ExpiresAt() *valueobject.Timestamp {
+	return m.expiresAt
+}
+
+func (m *Mute) IsActive() bool {
+	return time.Now().Before(m.expiresAt.Time())
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMute_ShouldCreateAMuteWhenDataIsValid(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	mutedBy, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	expiresAt := valueobject.NewTimestamp().Add(time.Hour)
+
+	mute, err := NewMute(roomId, userId, mutedBy, expiresAt)
+	assert.Nil(t, err)
+	assert.Equal(t, roomId.Value(), mute.RoomId().Value())
+	assert.Equal(t, userId.Value(), mute.UserId().Value())
+	assert.Equal(t, mutedBy.Value(), mute.MutedBy().Value())
+	assert.NotNil(t, mute.CreatedAt())
+	assert.Equal(t, expiresAt.Value(), mute.ExpiresAt().Value())
+	assert.True(t, mute.IsActive())
+
+	createdAt := valueobject.NewTimestamp().Add(-2 * time.Hour)
+	mute = NewMuteWith(roomId, userId, mutedBy, createdAt, createdAt.Add(time.Hour))
+	assert.Equal(t, createdAt.Value(), mute.CreatedAt().Value())
+	assert.False(t, mute.IsActive())
+}
+
+func TestMute_ShouldReturnAnErrorWhenTheExpirationIsInvalid(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	mutedBy, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	testCases := []struct {
+		test      string
+		expiresAt *valueobject.Timestamp
+		err       error
+	}{
+		{"empty expiration", nil, ErrRequiredMuteExpiration},
+		{"past expiration", valueobject.NewTimestamp().Add(-time.Hour), ErrInvalidMuteExpiration},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			mute, err := NewMute(roomId, userId, mutedBy, tc.expiresAt)
+			assert.Nil(t, mute)
+			assert.ErrorIs(t, err, tc.err)
+			assert.IsType(t, validation.ValidationError(""), err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// OwnershipTransfer records a change of the room admin. The transfer can be
+// made by the previous admin or by a platform administrator.
+type OwnershipTransfer struct {
+	id              *valueobject.Id
+	roomId          *valueobject.Id
+	previousAdminId *valueobject.UserId
+	adminId         *valueobject.UserId
+	transferredBy   *valueobject.UserId
+	transferredAt   *valueobject.Timestamp
+}
+
+func NewOwnershipTransfer(
+	roomId *valueobject.Id,
+	previousAdminId *valueobject.UserId,
+	adminId *valueobject.UserId,
+	transferredBy *valueobject.UserId,
+) *OwnershipTransfer {
+	return NewOwnershipTransferWith(
+		valueobject.NewId(),
+		roomId,
+		previousAdminId,
+		adminId,
+		transferredBy,
+		valueobject.NewTimestamp(),
+	)
+}
+
+func NewOwnershipTransferWith(
+	id *valueobject.Id,
+	roomId *valueobject.Id,
+	previousAdminId *valueobject.UserId,
+	adminId *valueobject.UserId,
+	transferredBy *valueobject.UserId,
+	transferredAt *valueobject.Timestamp,
+) *OwnershipTransfer {
+	return &OwnershipTransfer{
+		id:              id,
+		roomId:          roomId,
+		previousAdminId: previousAdminId,
+		adminId:         adminId,
+		transferredBy:   transferredBy,
+		transferredAt:   transferredAt,
+	}
+}
+
+func (t *OwnershipTransfer) Id() *valueobject.Id {
+	return t.id
+}
+
+func (t *OwnershipTransfer) RoomId() *valueobject.Id {
+	return t.roomId
+}
+
+func (t *OwnershipTransfer) PreviousAdminId() *valueobject.UserId {
+	return t.previousAdminId
+}
+
+func (t *OwnershipTransfer) AdminId() *valueobject.UserId {
+	return t.adminId
+}
+
+func (t *OwnershipTransfer) TransferredBy() *valueobject.UserId {
+	return t.transferredBy
+}
+
+func (t *OwnershipTransfer) TransferredAt() *valueobject.Timestamp {
+	return t.transferredAt
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnershipTransfer_ShouldCreateAnOwnershipTransferWhenDataIsValid(t *testing.T) {
+	id := valueobject.NewId()
+	roomId := valueobject.NewId()
+	previousAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	transferredBy, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+	transferredAt := valueobject.NewTimestamp()
+
+	transfer := NewOwnershipTransfer(roomId, previousAdminId, adminId, transferredBy)
+	assert.NotNil(t, transfer.Id())
+	assert.Equal(t, roomId.Value(), transfer.RoomId().Value())
+	assert.Equal(t, previousAdminId.Value(), transfer.PreviousAdminId().Value())
+	assert.Equal(t, adminId.Value(), transfer.AdminId().Value())
+	assert.Equal(t, transferredBy.Value(), transfer.TransferredBy().Value())
+	assert.NotNil(t, transfer.TransferredAt())
+
+	transfer = NewOwnershipTransferWith(id, roomId, previousAdminId, adminId, transferredBy, transferredAt)
+	assert.Equal(t, id.Value(), transfer.Id().Value())
+	assert.Equal(t, roomId.Value(), transfer.RoomId().Value())
+	assert.Equal(t, previousAdminId.Value(), transfer.PreviousAdminId().Value())
+	assert.Equal(t, adminId.Value(), transfer.AdminId().Value())
+	assert.Equal(t, transferredBy.Value(), transfer.TransferredBy().Value())
+	assert.Equal(t, transferredAt.Value(), transfer.TransferredAt().Value())
+}
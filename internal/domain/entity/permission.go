@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type Permission string
+
+const (
+	PermissionUpdateRoom       Permission = "update_room"
+	PermissionDeleteRoom       Permission = "delete_room"
+	PermissionInviteMember     Permission = "invite_member"
+	PermissionDeleteAnyMessage Permission = "delete_any_message"
+	PermissionKickMember       Permission = "kick_member"
+	PermissionBanMember        Permission = "ban_member"
+	PermissionMuteMember       Permission = "mute_member"
+	PermissionManageRoles      Permission = "manage_roles"
+	PermissionTransferRoom     Permission = "transfer_room"
+	PermissionPinMessage       Permission = "pin_message"
+)
+
+const ErrMissingPermission = validation.UnauthorizedError("member does not have the permission")
+
+var rolePermissions = map[string][]Permission{
+	valueobject.MemberRoleOwner: {
+		PermissionUpdateRoom,
+		PermissionDeleteRoom,
+		PermissionInviteMember,
+		PermissionDeleteAnyMessage,
+		PermissionKickMember,
+		PermissionBanMember,
+		PermissionMuteMember,
+		PermissionManageRoles,
+		PermissionTransferRoom,
+		PermissionPinMessage,
+	},
+	valueobject.MemberRoleModerator: {
+		PermissionInviteMember,
+		PermissionDeleteAnyMessage,
+		PermissionKickMember,
+		PermissionBanMember,
+		PermissionMuteMember,
+	},
+	valueobject.MemberRoleMember: {},
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const MaxRoomPins = 10
+
+const ErrRoomPinLimit = validation.ValidationError("a room can have up to 10 pinned messages")
+
+// Pin keeps a message of the room, such as an announcement, on top of the
+// room for its members.
+type Pin struct {
+	roomId    *valueobject.Id
+	messageId *valueobject.Id
+	pinnedBy  *valueobject.UserId
+	createdAt *valueobject.Timestamp
+}
+
+func NewPin(
+	roomId *valueobject.Id,
+	messageId *valueobject.Id,
+	pinnedBy *valueobject.UserId,
+) *Pin {
+	return NewPinWith(
+		roomId,
+		messageId,
+		pinnedBy,
+		valueobject.NewTimestamp(),
+	)
+}
+
+func NewPinWith(
+	roomId *valueobject.Id,
+	messageId *valueobject.Id,
+	pinnedBy *valueobject.UserId,
+	createdAt *valueobject.Timestamp,
+) *Pin {
+	return &Pin{
+		roomId:    roomId,
+		messageId: messageId,
+		pinnedBy:  pinnedBy,
+		createdAt: createdAt,
+	}
+}
+
+func (p *Pin) RoomId() *valueobject.Id {
+	return p.roomId
+}
+
+func (p *Pin) MessageId() *valueobject.Id {
+	return p.messageId
+}
+
+func (p *Pin) PinnedBy() *valueobject.UserId {
+	return p.pinnedBy
+}
+
+func (p *Pin) CreatedAt() *valueobject.Timestamp {
+	return p.createdAt
+}
+
+// ValidatePinLimit rejects the rooms with more than MaxRoomPins pinned
+// messages.
+func ValidatePinLimit(pins int64) error {
+	if pins > MaxRoomPins {
+		return ErrRoomPinLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPin_ShouldCreateAPinWhenDataIsValid(t *testing.T) {
+	roomId := valueobject.NewId()
+	messageId := valueobject.NewId()
+	pinnedBy, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+
+	pin := NewPin(roomId, messageId, pinnedBy)
+	assert.Equal(t, roomId.Value(), pin.RoomId().Value())
+	assert.Equal(t, messageId.Value(), pin.MessageId().Value())
+	assert.Equal(t, pinnedBy.Value(), pin.PinnedBy().Value())
+	assert.NotNil(t, pin.CreatedAt())
+
+	createdAt := valueobject.NewTimestamp()
+	pin = NewPinWith(roomId, messageId, pinnedBy, createdAt)
+	assert.Equal(t, createdAt.Value(), pin.CreatedAt().Value())
+}
+
+func TestPin_ShouldLimitThePinsOfARoom(t *testing.T) {
+	assert.Nil(t, ValidatePinLimit(1))
+	assert.Nil(t, ValidatePinLimit(MaxRoomPins))
+	assert.ErrorIs(t, ValidatePinLimit(MaxRoomPins+1), ErrRoomPinLimit)
+}
@@ -0,0 +1,23 @@
+package entity
+
+// PinnedMessage is a pin of a room with the message it keeps on top of the
+// room.
+type PinnedMessage struct {
+	pin     *Pin
+	message *Message
+}
+
+func NewPinnedMessageWith(pin *Pin, message *Message) *PinnedMessage {
+	return &PinnedMessage{
+		pin:     pin,
+		message: message,
+	}
+}
+
+func (p *PinnedMessage) Pin() *Pin {
+	return p.pin
+}
+
+func (p *PinnedMessage) Message() *Message {
+	return p.message
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinnedMessage_ShouldReturnThePinAndItsMessage(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("An announcement")
+
+	message := NewMessage(valueobject.NewId(), userId, senderName, text)
+	pin := NewPin(message.RoomId(), message.Id(), userId)
+
+	pinnedMessage := NewPinnedMessageWith(pin, message)
+	assert.Equal(t, pin, pinnedMessage.Pin())
+	assert.Equal(t, message, pinnedMessage.Message())
+}
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// PresenceHeartbeatPeriod is how often the sessions refresh their presence.
+const PresenceHeartbeatPeriod = 20 * time.Second
+
+// PresenceTimeout is how long a session is kept without a heartbeat before the
+// user is considered offline in it.
+const PresenceTimeout = 3 * PresenceHeartbeatPeriod
+
+// Presence is the status of a user in a room as seen from one of its sessions,
+// a live connection or a client sending heartbeats. It is kept in memory only.
+type Presence struct {
+	roomId    *valueobject.Id
+	userId    *valueobject.UserId
+	sessionId string
+	status    *valueobject.PresenceStatus
+	seenAt    *valueobject.Timestamp
+}
+
+func NewPresence(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	sessionId string,
+	status *valueobject.PresenceStatus,
+) *Presence {
+	return NewPresenceWith(
+		roomId,
+		userId,
+		sessionId,
+		status,
+		valueobject.NewTimestamp(),
+	)
+}
+
+func NewPresenceWith(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	sessionId string,
+	status *valueobject.PresenceStatus,
+	seenAt *valueobject.Timestamp,
+) *Presence {
+	return &Presence{
+		roomId:    roomId,
+		userId:    userId,
+		sessionId: sessionId,
+		status:    status,
+		seenAt:    seenAt,
+	}
+}
+
+func (p *Presence) RoomId() *valueobject.Id {
+	return p.roomId
+}
+
+func (p *Presence) UserId() *valueobject.UserId {
+	return p.userId
+}
+
+func (p *Presence) SessionId() string {
+	return p.sessionId
+}
+
+func (p *Presence) Status() *valueobject.PresenceStatus {
+	return p.status
+}
+
+func (p *Presence) SeenAt() *valueobject.Timestamp {
+	return p.seenAt
+}
+
+// IsExpired reports whether the session was not refreshed within the
+// PresenceTimeout.
+func (p *Presence) IsExpired() bool {
+	return time.Since(p.seenAt.Time()) >= PresenceTimeout
+}
+
+// MergePresences returns the presence of a user from the presences of its
+// sessions in a room. The user is online when any session is online, and is
+// seen at the latest heartbeat of the sessions.
+func MergePresences(presences []*Presence) *Presence {
+	var merged *Presence
+
+	for _, p := range presences {
+		if merged == nil {
+			merged = NewPresenceWith(p.roomId, p.userId, "", p.status, p.seenAt)
+			continue
+		}
+
+		if p.status.IsOnline() {
+			merged.status = p.status
+		}
+
+		if p.seenAt.Time().After(merged.seenAt.Time()) {
+			merged.seenAt = p.seenAt
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresence_ShouldExpireAfterThePresenceTimeout(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	status := valueobject.NewPresenceStatus()
+
+	presence := NewPresence(roomId, userId, "a session", status)
+	assert.Equal(t, roomId.Value(), presence.RoomId().Value())
+	assert.Equal(t, userId.Value(), presence.UserId().Value())
+	assert.Equal(t, "a session", presence.SessionId())
+	assert.Equal(t, status.Value(), presence.Status().Value())
+	assert.WithinDuration(t, time.Now(), presence.SeenAt().Time(), time.Second)
+	assert.False(t, presence.IsExpired())
+
+	presence = NewPresenceWith(roomId, userId, "a session", status, valueobject.NewTimestamp().Add(-PresenceTimeout))
+	assert.True(t, presence.IsExpired())
+}
+
+func TestPresence_ShouldMergeThePresencesOfTheUserSessions(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	online := valueobject.NewPresenceStatus()
+	away, _ := valueobject.NewPresenceStatusWith(valueobject.PresenceStatusAway)
+
+	assert.Nil(t, MergePresences(nil))
+
+	seenAt := valueobject.NewTimestamp()
+
+	merged := MergePresences([]*Presence{
+		NewPresenceWith(roomId, userId, "a session", away, seenAt),
+		NewPresenceWith(roomId, userId, "another session", away, seenAt.Add(-time.Second)),
+	})
+	assert.Equal(t, roomId.Value(), merged.RoomId().Value())
+	assert.Equal(t, userId.Value(), merged.UserId().Value())
+	assert.Empty(t, merged.SessionId())
+	assert.True(t, merged.Status().IsAway())
+	assert.Equal(t, seenAt.Value(), merged.SeenAt().Value())
+
+	merged = MergePresences([]*Presence{
+		NewPresenceWith(roomId, userId, "a session", away, seenAt),
+		NewPresenceWith(roomId, userId, "another session", online, seenAt.Add(-time.Second)),
+	})
+	assert.True(t, merged.Status().IsOnline())
+	assert.Equal(t, seenAt.Value(), merged.SeenAt().Value())
+}
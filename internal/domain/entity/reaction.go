@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// Reaction is an emoji added by a user to a message. A user reacts once with
+// each emoji to a message.
+type Reaction struct {
+	messageId *valueobject.Id
+	userId    *valueobject.UserId
+	emoji     *valueobject.Emoji
+	createdAt *valueobject.Timestamp
+}
+
+func NewReaction(
+	messageId *valueobject.Id,
+	userId *valueobject.UserId,
+	emoji *valueobject.Emoji,
+) *Reaction {
+	return NewReactionWith(
+		messageId,
+		userId,
+		emoji,
+		valueobject.NewTimestamp(),
+	)
+}
+
+func NewReactionWith(
+	messageId *valueobject.Id,
+	userId *valueobject.UserId,
+	emoji *valueobject.Emoji,
+	createdAt *valueobject.Timestamp,
+) *Reaction {
+	return &Reaction{
+		messageId: messageId,
+		userId:    userId,
+		emoji:     emoji,
+		createdAt: createdAt,
+	}
+}
+
+func (r *Reaction) MessageId() *valueobject.Id {
+	return r.messageId
+}
+
+func (r *Reaction) UserId() *valueobject.UserId {
+	return r.userId
+}
+
+func (r *Reaction) Emoji() *valueobject.Emoji {
+	return r.emoji
+}
+
+func (r *Reaction) CreatedAt() *valueobject.Timestamp {
+	return r.createdAt
+}
+
+// ReactionCount is the number of users that reacted to a message with an emoji.
+type ReactionCount struct {
+	emoji *valueobject.Emoji
+	count int
+}
+
+func NewReactionCountWith(emoji *valueobject.Emoji, count int) *ReactionCount {
+	return &ReactionCount{
+		emoji: emoji,
+		count: count,
+	}
+}
+
+func (c *ReactionCount) Emoji() *valueobject.Emoji {
+	return c.emoji
+}
+
+func (c *ReactionCount) Count() int {
+	return c.count
+}
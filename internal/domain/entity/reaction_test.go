@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaction_ShouldCreateAReactionWhenDataIsValid(t *testing.T) {
+	messageId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	emoji, _ := valueobject.NewEmojiWith("👍")
+
+	reaction := NewReaction(messageId, userId, emoji)
+	assert.Equal(t, messageId.Value(), reaction.MessageId().Value())
+	assert.Equal(t, userId.Value(), reaction.UserId().Value())
+	assert.Equal(t, emoji.Value(), reaction.Emoji().Value())
+	assert.NotNil(t, reaction.CreatedAt())
+
+	createdAt := valueobject.NewTimestamp()
+	reaction = NewReactionWith(messageId, userId, emoji, createdAt)
+	assert.Equal(t, createdAt.Value(), reaction.CreatedAt().Value())
+
+	count := NewReactionCountWith(emoji, 3)
+	assert.Equal(t, emoji.Value(), count.Emoji().Value())
+	assert.Equal(t, 3, count.Count())
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+)
+
+const MaxReadReceiptRoomMembers = 50
+
+const ErrReadReceiptsUnavailable = validation.ValidationError("read receipts are only available in rooms up to 50 members")
+
+// ValidateReadReceipts allows listing the readers of a message only in small
+// rooms, where the list is meaningful to the sender.
+func ValidateReadReceipts(members int64) error {
+	if members > MaxReadReceiptRoomMembers {
+		return ErrReadReceiptsUnavailable
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadReceipt_ShouldBeAvailableOnlyInSmallRooms(t *testing.T) {
+	assert.Nil(t, ValidateReadReceipts(2))
+	assert.Nil(t, ValidateReadReceipts(MaxReadReceiptRoomMembers))
+	assert.ErrorIs(t, ValidateReadReceipts(MaxReadReceiptRoomMembers+1), ErrReadReceiptsUnavailable)
+}
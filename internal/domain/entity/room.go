@@ -7,15 +7,18 @@ import (
 
 const ErrRoomAlreadyDeleted = validation.ValidationError("room already deleted")
 const ErrInvalidRoomAdmin = validation.UnauthorizedError("room admin is invalid")
+const ErrRoomAlreadyOwnedByUser = validation.ValidationError("room is already owned by the user")
+const ErrRoomAdminNotMember = validation.ValidationError("room admin must be a room member")
 
 type Room struct {
-	id        *valueobject.Id
-	adminId   *valueobject.UserId
-	name      *valueobject.RoomName
-	category  *valueobject.RoomCategory
-	createdAt *valueobject.Timestamp
-	updatedAt *valueobject.Timestamp
-	deletedAt *valueobject.Timestamp
+	id         *valueobject.Id
+	adminId    *valueobject.UserId
+	name       *valueobject.RoomName
+	category   *valueobject.RoomCategory
+	visibility *valueobject.RoomVisibility
+	createdAt  *valueobject.Timestamp
+	updatedAt  *valueobject.Timestamp
+	deletedAt  *valueobject.Timestamp
 }
 
 func NewRoom(
@@ -29,6 +32,7 @@ func NewRoom(
 		adminId,
 		name,
 		category,
+		valueobject.NewRoomVisibility(),
 		now,
 		now,
 		nil,
@@ -40,18 +44,20 @@ func NewRoomWith(
 	adminId *valueobject.UserId,
 	name *valueobject.RoomName,
 	category *valueobject.RoomCategory,
+	visibility *valueobject.RoomVisibility,
 	createdAt *valueobject.Timestamp,
 	updatedAt *valueobject.Timestamp,
 	deletedAt *valueobject.Timestamp,
 ) *Room {
 	return &Room{
-		id:        id,
-		adminId:   adminId,
-		name:      name,
-		category:  category,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
-		deletedAt: deletedAt,
+		id:         id,
+		adminId:    adminId,
+		name:       name,
+		category:   category,
+		visibility: visibility,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+		deletedAt:  deletedAt,
 	}
 }
 
@@ -71,6 +77,18 @@ func (r *Room) Category() *valueobject.RoomCategory {
 	return r.category
 }
 
+func (r *Room) Visibility() *valueobject.RoomVisibility {
+	return r.visibility
+}
+
+func (r *Room) IsPrivate() bool {
+	return r.visibility.IsPrivate()
+}
+
+func (r *Room) IsDirect() bool {
+	return r.visibility.IsDirect()
+}
+
 func (r *Room) CreatedAt() *valueobject.Timestamp {
 	return r.createdAt
 }
@@ -97,6 +115,11 @@ func (r *Room) UpdateCategory(category *valueobject.RoomCategory) {
 	r.updatedAt = valueobject.NewTimestamp()
 }
 
+func (r *Room) UpdateVisibility(visibility *valueobject.RoomVisibility) {
+	r.visibility = visibility
+	r.updatedAt = valueobject.NewTimestamp()
+}
+
 func (r *Room) ValidateAdmin(adminId *valueobject.UserId) error {
 	if r.adminId.Value() != adminId.Value() {
 		return ErrInvalidRoomAdmin
@@ -105,6 +128,20 @@ func (r *Room) ValidateAdmin(adminId *valueobject.UserId) error {
 	return nil
 }
 
+func (r *Room) TransferOwnership(adminId *valueobject.UserId) error {
+	if r.IsDirect() {
+		return ErrDirectRoomTransfer
+	}
+
+	if r.adminId.Value() == adminId.Value() {
+		return ErrRoomAlreadyOwnedByUser
+	}
+
+	r.adminId = adminId
+	r.updatedAt = valueobject.NewTimestamp()
+	return nil
+}
+
 func (r *Room) Delete() error {
 	if r.IsDeleted() {
 		return ErrRoomAlreadyDeleted
@@ -14,6 +14,7 @@ func TestRoom_ShouldCreateARoomWhenDateIsValid(t *testing.T) {
 	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
 	name, _ := valueobject.NewRoomNameWith("Golang")
 	category, _ := valueobject.NewRoomCategoryWith("Tech")
+	visibility, _ := valueobject.NewRoomVisibilityWith("private")
 	createdAt := valueobject.NewTimestamp()
 	updateAt := valueobject.NewTimestamp()
 	var deleteAt *valueobject.Timestamp = nil
@@ -23,15 +24,18 @@ func TestRoom_ShouldCreateARoomWhenDateIsValid(t *testing.T) {
 	assert.Equal(t, adminId.Value(), room.AdminId().Value())
 	assert.Equal(t, name.Value(), room.Name().Value())
 	assert.Equal(t, category.Value(), room.Category().Value())
+	assert.False(t, room.IsPrivate())
 	assert.NotNil(t, room.CreatedAt())
 	assert.NotNil(t, room.UpdatedAt())
 	assert.Nil(t, room.deletedAt)
 
-	room = NewRoomWith(id, adminId, name, category, createdAt, updateAt, deleteAt)
+	room = NewRoomWith(id, adminId, name, category, visibility, createdAt, updateAt, deleteAt)
 	assert.Equal(t, id.Value(), room.Id().Value())
 	assert.Equal(t, adminId.Value(), room.AdminId().Value())
 	assert.Equal(t, name.Value(), room.Name().Value())
 	assert.Equal(t, category.Value(), room.Category().Value())
+	assert.Equal(t, visibility.Value(), room.Visibility().Value())
+	assert.True(t, room.IsPrivate())
 	assert.Equal(t, createdAt.Value(), room.CreatedAt().Value())
 	assert.Equal(t, updateAt.Value(), room.UpdatedAt().Value())
 	assert.Equal(t, deleteAt, room.DeletedAt())
@@ -65,6 +69,21 @@ func TestShouldUpdateARoomCategory(t *testing.T) {
 	assert.True(t, room.updatedAt.Time().After(oldUpdatedAt.Time()))
 }
 
+func TestShouldUpdateARoomVisibility(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Golang")
+	category, _ := valueobject.NewRoomCategoryWith("Tech")
+	room := NewRoom(adminId, name, category)
+
+	oldUpdatedAt := room.UpdatedAt()
+	newVisibility, _ := valueobject.NewRoomVisibilityWith("private")
+
+	room.UpdateVisibility(newVisibility)
+	assert.Equal(t, newVisibility.Value(), room.Visibility().Value())
+	assert.True(t, room.IsPrivate())
+	assert.True(t, room.updatedAt.Time().After(oldUpdatedAt.Time()))
+}
+
 func TestShouldValidateARoomAdmin(t *testing.T) {
 	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
 	name, _ := valueobject.NewRoomNameWith("Golang")
@@ -80,6 +99,38 @@ func TestShouldValidateARoomAdmin(t *testing.T) {
 	assert.Error(t, ErrInvalidRoomAdmin, err)
 }
 
+func TestShouldTransferTheOwnershipOfARoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Golang")
+	category, _ := valueobject.NewRoomCategoryWith("Tech")
+	room := NewRoom(adminId, name, category)
+
+	oldUpdatedAt := room.UpdatedAt()
+
+	err := room.TransferOwnership(adminId)
+	assert.IsType(t, validation.ValidationError(""), err)
+	assert.ErrorIs(t, err, ErrRoomAlreadyOwnedByUser)
+
+	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	err = room.TransferOwnership(newAdminId)
+	assert.Nil(t, err)
+	assert.Equal(t, newAdminId.Value(), room.AdminId().Value())
+	assert.True(t, room.UpdatedAt().Time().After(oldUpdatedAt.Time()))
+	assert.Nil(t, room.ValidateAdmin(newAdminId))
+	assert.ErrorIs(t, room.ValidateAdmin(adminId), ErrInvalidRoomAdmin)
+}
+
+func TestShouldNotTransferTheOwnershipOfADirectRoom(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	otherUserId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	directRoom, _ := NewDirectRoom(userId, otherUserId)
+	room := directRoom.Room()
+
+	err := room.TransferOwnership(otherUserId)
+	assert.ErrorIs(t, err, ErrDirectRoomTransfer)
+	assert.Equal(t, userId.Value(), room.AdminId().Value())
+}
+
 func TestShouldDeleteARoomn(t *testing.T) {
 	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
 	name, _ := valueobject.NewRoomNameWith("Golang")
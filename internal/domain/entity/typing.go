@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// TypingDuration is how long a user is shown as typing after a typing
+// notification, unless a new one is sent.
+const TypingDuration = 5 * time.Second
+
+// Typing tells the room members that a user is typing. It is not persisted and
+// expires after the TypingDuration.
+type Typing struct {
+	roomId    *valueobject.Id
+	userId    *valueobject.UserId
+	userName  *valueobject.UserName
+	expiresAt *valueobject.Timestamp
+}
+
+func NewTyping(
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+	userName *valueobject.UserName,
+) *Typing {
+	return &Typing{
+		roomId:    roomId,
+		userId:    userId,
+		userName:  userName,
+		expiresAt: valueobject.NewTimestamp().Add(TypingDuration),
+	}
+}
+
+func (t *Typing) RoomId() *valueobject.Id {
+	return t.roomId
+}
+
+func (t *Typing) UserId() *valueobject.UserId {
+	return t.userId
+}
+
+func (t *Typing) UserName() *valueobject.UserName {
+	return t.userName
+}
+
+func (t *Typing) ExpiresAt() *valueobject.Timestamp {
+	return t.expiresAt
+}
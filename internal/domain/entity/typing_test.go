@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTyping_ShouldExpireAfterTheTypingDuration(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	userName, _ := valueobject.NewUserNameWith("An username")
+
+	typing := NewTyping(roomId, userId, userName)
+	assert.Equal(t, roomId.Value(), typing.RoomId().Value())
+	assert.Equal(t, userId.Value(), typing.UserId().Value())
+	assert.Equal(t, userName.Value(), typing.UserName().Value())
+	assert.WithinDuration(t, time.Now().Add(TypingDuration), typing.ExpiresAt().Time(), time.Second)
+}
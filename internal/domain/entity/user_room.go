@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// UserRoom is a room as seen by one of its members, with the latest message
+// of the room and the number of messages the member did not read yet.
+type UserRoom struct {
+	room        *Room
+	member      *Member
+	lastMessage *Message
+	unreadCount int64
+}
+
+func NewUserRoomWith(
+	room *Room,
+	member *Member,
+	lastMessage *Message,
+	unreadCount int64,
+) *UserRoom {
+	return &UserRoom{
+		room:        room,
+		member:      member,
+		lastMessage: lastMessage,
+		unreadCount: unreadCount,
+	}
+}
+
+func (u *UserRoom) Room() *Room {
+	return u.room
+}
+
+func (u *UserRoom) Member() *Member {
+	return u.member
+}
+
+// LastMessage returns the latest message of the room, or nil when the room
+// has no messages.
+func (u *UserRoom) LastMessage() *Message {
+	return u.lastMessage
+}
+
+func (u *UserRoom) UnreadCount() int64 {
+	return u.unreadCount
+}
+
+// LastActivityAt returns the time of the latest message, or the last update
+// of the room when it has no messages.
+func (u *UserRoom) LastActivityAt() *valueobject.Timestamp {
+	if u.lastMessage != nil {
+		return u.lastMessage.CreatedAt()
+	}
+
+	return u.room.UpdatedAt()
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRoom_ShouldReturnTheLastActivityOfTheRoom(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Golang")
+	category, _ := valueobject.NewRoomCategoryWith("Tech")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	room := NewRoom(userId, name, category)
+	member := NewRoomOwner(room.Id(), userId)
+
+	userRoom := NewUserRoomWith(room, member, nil, 0)
+	assert.Equal(t, room.Id().Value(), userRoom.Room().Id().Value())
+	assert.Equal(t, userId.Value(), userRoom.Member().UserId().Value())
+	assert.Nil(t, userRoom.LastMessage())
+	assert.Equal(t, int64(0), userRoom.UnreadCount())
+	assert.Equal(t, room.UpdatedAt().Value(), userRoom.LastActivityAt().Value())
+
+	message := NewMessage(room.Id(), userId, senderName, text)
+
+	userRoom = NewUserRoomWith(room, member, message, 3)
+	assert.Equal(t, message.Id().Value(), userRoom.LastMessage().Id().Value())
+	assert.Equal(t, int64(3), userRoom.UnreadCount())
+	assert.Equal(t, message.CreatedAt().Value(), userRoom.LastActivityAt().Value())
+}
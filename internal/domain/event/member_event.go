@@ -0,0 +1,43 @@
+package event
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const (
+	MemberKicked = "member.kicked"
+	MemberBanned = "member.banned"
+)
+
+// NewMemberKickedEvent notifies the room members that a member was removed
+// from the room. The sender is the moderator that kicked the member.
+func NewMemberKickedEvent(member *entity.Member, kickedBy *valueobject.UserId) *MessageEvent {
+	return &MessageEvent{
+		Type:      MemberKicked,
+		Id:        valueobject.NewId().Value(),
+		RoomId:    member.RoomId().Value(),
+		SenderId:  kickedBy.Value(),
+		CreatedAt: valueobject.NewTimestamp().Value(),
+		MemberId:  member.UserId().Value(),
+	}
+}
+
+// NewMemberBannedEvent notifies the room members that a user was banned from
+// the room. The sender is the moderator that banned the user.
+func NewMemberBannedEvent(ban *entity.Ban) *MessageEvent {
+	return &MessageEvent{
+		Type:      MemberBanned,
+		Id:        valueobject.NewId().Value(),
+		RoomId:    ban.RoomId().Value(),
+		SenderId:  ban.BannedBy().Value(),
+		CreatedAt: ban.CreatedAt().Value(),
+		MemberId:  ban.UserId().Value(),
+	}
+}
+
+// RemovesMember reports whether the event removes the member from the room,
+// so the streams of the member must be closed.
+func (e *MessageEvent) RemovesMember() bool {
+	return e.Type == MemberKicked || e.Type == MemberBanned
+}
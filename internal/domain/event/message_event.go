@@ -4,17 +4,51 @@ import (
 	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
 )
 
+const (
+	MessageCreated = "message.created"
+	MessageEdited  = "message.edited"
+	MessageDeleted = "message.deleted"
+)
+
 type MessageEvent struct {
+	Type       string `json:"type"`
 	Id         string `json:"id"`
 	RoomId     string `json:"room_id"`
 	SenderId   string `json:"sender_id"`
 	SenderName string `json:"sender_name"`
 	Text       string `json:"text"`
 	CreatedAt  string `json:"created_at"`
+	EditedAt   string `json:"edited_at,omitempty"`
+	DeletedAt  string `json:"deleted_at,omitempty"`
+	ParentId   string `json:"parent_id,omitempty"`
+	Emoji      string `json:"emoji,omitempty"`
+
+	PreviousAdminId string `json:"previous_admin_id,omitempty"`
+	AdminId         string `json:"admin_id,omitempty"`
+	MemberId        string `json:"member_id,omitempty"`
 }
 
 func NewMessageEvent(message *entity.Message) *MessageEvent {
+	return newMessageEvent(MessageCreated, message)
+}
+
+func NewMessageEditedEvent(message *entity.Message) *MessageEvent {
+	return newMessageEvent(MessageEdited, message)
+}
+
+func NewMessageDeletedEvent(message *entity.Message) *MessageEvent {
+	return newMessageEvent(MessageDeleted, message)
+}
+
+// IsCreated reports whether the event is about a new message. Events
+// published before the type was added are message creations.
+func (e *MessageEvent) IsCreated() bool {
+	return e.Type == "" || e.Type == MessageCreated
+}
+
+func newMessageEvent(eventType string, message *entity.Message) *MessageEvent {
 	messageEvent := &MessageEvent{
+		Type:       eventType,
 		Id:         message.Id().Value(),
 		RoomId:     message.RoomId().Value(),
 		SenderId:   message.SenderId().Value(),
@@ -23,5 +57,18 @@ func NewMessageEvent(message *entity.Message) *MessageEvent {
 		CreatedAt:  message.CreatedAt().Value(),
 	}
 
+	if message.ParentId() != nil {
+		messageEvent.ParentId = message.ParentId().Value()
+	}
+
+	if message.EditedAt() != nil {
+		messageEvent.EditedAt = message.EditedAt().Value()
+	}
+
+	if message.DeletedAt() != nil {
+		messageEvent.Text = ""
+		messageEvent.DeletedAt = message.DeletedAt().Value()
+	}
+
 	return messageEvent
 }
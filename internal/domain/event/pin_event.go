@@ -0,0 +1,31 @@
+package event
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+)
+
+const (
+	MessagePinned   = "message.pinned"
+	MessageUnpinned = "message.unpinned"
+)
+
+// NewMessagePinnedEvent notifies the room members that the pinned messages of
+// the room changed. The id is the id of the message and the sender is the
+// member that pinned or unpinned it.
+func NewMessagePinnedEvent(pin *entity.Pin) *MessageEvent {
+	return newPinEvent(MessagePinned, pin)
+}
+
+func NewMessageUnpinnedEvent(pin *entity.Pin) *MessageEvent {
+	return newPinEvent(MessageUnpinned, pin)
+}
+
+func newPinEvent(eventType string, pin *entity.Pin) *MessageEvent {
+	return &MessageEvent{
+		Type:      eventType,
+		Id:        pin.MessageId().Value(),
+		RoomId:    pin.RoomId().Value(),
+		SenderId:  pin.PinnedBy().Value(),
+		CreatedAt: pin.CreatedAt().Value(),
+	}
+}
@@ -0,0 +1,32 @@
+package event
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+)
+
+const (
+	PresenceChanged = "presence.changed"
+)
+
+// PresenceEvent carries the presence of a user in a room. The instances share
+// the presence of each session through it, and the room members receive it
+// without the session when the status of the user changes.
+type PresenceEvent struct {
+	Type      string `json:"type"`
+	RoomId    string `json:"room_id"`
+	UserId    string `json:"user_id"`
+	SessionId string `json:"session_id,omitempty"`
+	Status    string `json:"status"`
+	SeenAt    string `json:"seen_at"`
+}
+
+func NewPresenceEvent(presence *entity.Presence) *PresenceEvent {
+	return &PresenceEvent{
+		Type:      PresenceChanged,
+		RoomId:    presence.RoomId().Value(),
+		UserId:    presence.UserId().Value(),
+		SessionId: presence.SessionId(),
+		Status:    presence.Status().Value(),
+		SeenAt:    presence.SeenAt().Value(),
+	}
+}
@@ -0,0 +1,32 @@
+package event
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+)
+
+const (
+	ReactionAdded   = "reaction.added"
+	ReactionRemoved = "reaction.removed"
+)
+
+// NewReactionAddedEvent notifies the room members that a user reacted to the
+// message. The id is the id of the message and the sender is the user that
+// reacted.
+func NewReactionAddedEvent(message *entity.Message, reaction *entity.Reaction) *MessageEvent {
+	return newReactionEvent(ReactionAdded, message, reaction)
+}
+
+func NewReactionRemovedEvent(message *entity.Message, reaction *entity.Reaction) *MessageEvent {
+	return newReactionEvent(ReactionRemoved, message, reaction)
+}
+
+func newReactionEvent(eventType string, message *entity.Message, reaction *entity.Reaction) *MessageEvent {
+	return &MessageEvent{
+		Type:      eventType,
+		Id:        message.Id().Value(),
+		RoomId:    message.RoomId().Value(),
+		SenderId:  reaction.UserId().Value(),
+		Emoji:     reaction.Emoji().Value(),
+		CreatedAt: reaction.CreatedAt().Value(),
+	}
+}
@@ -0,0 +1,23 @@
+package event
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const (
+	MessageRead = "message.read"
+)
+
+// NewReadReceiptEvent notifies the room members that the member read the
+// messages up to its last read message. The id is the id of that message and
+// the sender is the member that read it.
+func NewReadReceiptEvent(member *entity.Member) *MessageEvent {
+	return &MessageEvent{
+		Type:      MessageRead,
+		Id:        member.LastReadMessageId().Value(),
+		RoomId:    member.RoomId().Value(),
+		SenderId:  member.UserId().Value(),
+		CreatedAt: valueobject.NewTimestamp().Value(),
+	}
+}
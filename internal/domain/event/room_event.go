@@ -0,0 +1,24 @@
+package event
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+)
+
+const (
+	RoomOwnershipTransferred = "room.ownership_transferred"
+)
+
+// NewRoomOwnershipTransferredEvent notifies the room members of a new admin.
+// Room events are delivered with the messages of the room, the sender is the
+// user that made the change.
+func NewRoomOwnershipTransferredEvent(transfer *entity.OwnershipTransfer) *MessageEvent {
+	return &MessageEvent{
+		Type:            RoomOwnershipTransferred,
+		Id:              transfer.Id().Value(),
+		RoomId:          transfer.RoomId().Value(),
+		SenderId:        transfer.TransferredBy().Value(),
+		CreatedAt:       transfer.TransferredAt().Value(),
+		PreviousAdminId: transfer.PreviousAdminId().Value(),
+		AdminId:         transfer.AdminId().Value(),
+	}
+}
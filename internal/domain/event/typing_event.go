@@ -0,0 +1,29 @@
+package event
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+)
+
+const (
+	UserTyping = "user.typing"
+)
+
+// TypingEvent is delivered to the room members while a user is typing. It is
+// not stored, the clients stop showing it after its expiration.
+type TypingEvent struct {
+	Type      string `json:"type"`
+	RoomId    string `json:"room_id"`
+	UserId    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+func NewTypingEvent(typing *entity.Typing) *TypingEvent {
+	return &TypingEvent{
+		Type:      UserTyping,
+		RoomId:    typing.RoomId().Value(),
+		UserId:    typing.UserId().Value(),
+		UserName:  typing.UserName().Value(),
+		ExpiresAt: typing.ExpiresAt().Value(),
+	}
+}
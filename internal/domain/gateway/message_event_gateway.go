@@ -4,9 +4,18 @@ import (
 	"context"
 
 	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+)
+
+const (
+	ErrEventNotConfirmed = validation.DeliveryError("event delivery was not confirmed")
+	ErrEventRejected     = validation.DeliveryError("event was rejected by the broker")
+	ErrEventUnroutable   = validation.DeliveryError("event could not be routed")
 )
 
 type MessageEventGateway interface {
 	Send(ctx context.Context, messageEvent *event.MessageEvent) error
-	Receive(ctx context.Context, messageEvents chan<- *event.MessageEvent) error
+	// Receive delivers the events of the room until the context is done. The
+	// ready function is called once the events of the room are being captured.
+	Receive(ctx context.Context, roomId string, messageEvents chan<- *event.MessageEvent, ready func()) error
 }
@@ -0,0 +1,17 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+)
+
+type PresenceEventGateway interface {
+	// Send publishes the presence of a session to every instance without
+	// storing it. Events that are not delivered before the presence timeout
+	// are dropped.
+	Send(ctx context.Context, presenceEvent *event.PresenceEvent) error
+	// Receive delivers the presence events of every room until the context is
+	// done. The ready function is called once the events are being captured.
+	Receive(ctx context.Context, presenceEvents chan<- *event.PresenceEvent, ready func()) error
+}
@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+)
+
+type TypingEventGateway interface {
+	// Send publishes the event without storing it. Events that are not
+	// delivered before the typing expiration are dropped.
+	Send(ctx context.Context, typingEvent *event.TypingEvent) error
+	// Receive delivers the typing events of the room until the context is
+	// done. The ready function is called once the events are being captured.
+	Receive(ctx context.Context, roomId string, typingEvents chan<- *event.TypingEvent, ready func()) error
+}
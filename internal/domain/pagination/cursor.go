@@ -0,0 +1,127 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const (
+	ErrInvalidQueryCursor    = validation.ValidationError("query 'cursor' is invalid")
+	ErrInvalidQueryDirection = validation.ValidationError("query 'direction' must be 'before' or 'after'")
+)
+
+const (
+	DirectionBefore = "BEFORE"
+	DirectionAfter  = "AFTER"
+)
+
+// Cursor points to an item by its creation timestamp and id, which together
+// give a stable order even when new items are inserted between requests.
+type Cursor struct {
+	createdAt *valueobject.Timestamp
+	id        *valueobject.Id
+}
+
+func NewCursor(createdAt *valueobject.Timestamp, id *valueobject.Id) *Cursor {
+	return &Cursor{
+		createdAt: createdAt,
+		id:        id,
+	}
+}
+
+func NewCursorWith(value string) (*Cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidQueryCursor
+	}
+
+	parts := strings.Split(string(decoded), "|")
+	if len(parts) != 2 {
+		return nil, ErrInvalidQueryCursor
+	}
+
+	createdAt, err := valueobject.NewTimestampWith(parts[0])
+	if err != nil {
+		return nil, ErrInvalidQueryCursor
+	}
+
+	id, err := valueobject.NewIdWith(parts[1])
+	if err != nil {
+		return nil, ErrInvalidQueryCursor
+	}
+
+	return NewCursor(createdAt, id), nil
+}
+
+func (c *Cursor) CreatedAt() *valueobject.Timestamp {
+	return c.createdAt
+}
+
+func (c *Cursor) Id() *valueobject.Id {
+	return c.id
+}
+
+func (c *Cursor) Value() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.createdAt.Value() + "|" + c.id.Value()))
+}
+
+type CursorQuery struct {
+	cursor    *Cursor
+	size      int
+	direction string
+}
+
+func NewCursorQuery(cursor, size, direction string) (*CursorQuery, error) {
+	if size == "" {
+		size = "10"
+	}
+
+	if direction == "" {
+		direction = "before"
+	}
+
+	var cr *Cursor
+
+	if cursor != "" {
+		c, err := NewCursorWith(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		cr = c
+	}
+
+	sz, err := strconv.Atoi(size)
+	if err != nil || sz < 1 || sz > 50 {
+		return nil, ErrInvalidQuerySize
+	}
+
+	dr := strings.ToUpper(direction)
+	if dr != DirectionBefore && dr != DirectionAfter {
+		return nil, ErrInvalidQueryDirection
+	}
+
+	return &CursorQuery{
+		cursor:    cr,
+		size:      sz,
+		direction: dr,
+	}, nil
+}
+
+// Cursor returns nil when the query starts from the newest item (direction
+// before) or from the oldest item (direction after).
+func (q *CursorQuery) Cursor() *Cursor {
+	return q.cursor
+}
+
+func (q *CursorQuery) Size() int {
+	return q.size
+}
+
+func (q *CursorQuery) Direction() string {
+	return q.direction
+}
@@ -0,0 +1,62 @@
+package pagination
+
+// CursorPage holds items ordered from the newest to the oldest. Before is the
+// cursor to load older items and is empty when there are none. After is the
+// cursor to load newer items, it is kept even when there are none yet so
+// clients can poll for items created later. More tells whether the query
+// matched more items than the page size in the query direction.
+type CursorPage[T any] struct {
+	Size   int    `json:"size"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+	Items  []T    `json:"items"`
+	More   bool   `json:"-"`
+}
+
+// NewCursorPage builds a page from items already ordered from the newest to
+// the oldest. The flag more tells whether the query matched more items than
+// the page size in the query direction.
+func NewCursorPage[T any](query *CursorQuery, items []T, more bool, cursorOf func(T) *Cursor) *CursorPage[T] {
+	page := &CursorPage[T]{
+		Size:  query.Size(),
+		Items: items,
+		More:  more,
+	}
+
+	if len(items) == 0 {
+		if query.Cursor() != nil {
+			page.After = query.Cursor().Value()
+
+			if query.Direction() == DirectionAfter {
+				page.Before = query.Cursor().Value()
+			}
+		}
+
+		return page
+	}
+
+	page.After = cursorOf(items[0]).Value()
+
+	if (query.Direction() == DirectionBefore && more) ||
+		(query.Direction() == DirectionAfter && query.Cursor() != nil) {
+		page.Before = cursorOf(items[len(items)-1]).Value()
+	}
+
+	return page
+}
+
+func MapCursorPage[T any, K any](page *CursorPage[T], mapper func(T) K) *CursorPage[K] {
+	result := &CursorPage[K]{
+		Size:   page.Size,
+		Before: page.Before,
+		After:  page.After,
+		Items:  make([]K, len(page.Items)),
+		More:   page.More,
+	}
+
+	for i := 0; i < len(page.Items); i++ {
+		result.Items[i] = mapper(page.Items[i])
+	}
+
+	return result
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrNotFoundBan = validation.NotFoundError("ban not found")
+
+type BanRepository interface {
+	// Save replaces the existing ban when the user already has one in the room.
+	Save(ctx context.Context, ban *entity.Ban) error
+	FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Ban, error)
+	Delete(ctx context.Context, ban *entity.Ban) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const (
+	ErrNotFoundDirectRoom  = validation.NotFoundError("direct room not found")
+	ErrDuplicateDirectRoom = validation.ValidationError("direct room already exists")
+)
+
+type DirectRoomRepository interface {
+	// Save returns ErrDuplicateDirectRoom when the users already have a direct room.
+	// The room and its members are saved by their own repositories.
+	Save(ctx context.Context, directRoom *entity.DirectRoom) error
+	FindByUsers(ctx context.Context, userId *valueobject.UserId, otherUserId *valueobject.UserId) (*entity.DirectRoom, error)
+	// ListByUser returns the direct rooms the user is a member of with their last
+	// message, the most recently active first.
+	ListByUser(ctx context.Context, userId *valueobject.UserId, query *pagination.Query) (*pagination.Page[*entity.DirectRoom], error)
+}
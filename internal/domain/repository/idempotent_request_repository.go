@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const (
+	ErrNotFoundIdempotentRequest  = validation.NotFoundError("idempotent request not found")
+	ErrDuplicateIdempotentRequest = validation.ValidationError("idempotency key already used")
+)
+
+type IdempotentRequestRepository interface {
+	// Save returns ErrDuplicateIdempotentRequest when the sender already has
+	// a request with the key that has not expired.
+	Save(ctx context.Context, request *entity.IdempotentRequest) error
+	FindByKey(ctx context.Context, senderId *valueobject.UserId, key *valueobject.IdempotencyKey) (*entity.IdempotentRequest, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const (
+	ErrNotFoundInvitation = validation.NotFoundError("invitation not found")
+	ErrAnsweredInvitation = validation.ConflictError("invitation was already answered")
+)
+
+type InvitationRepository interface {
+	Save(ctx context.Context, invitation *entity.Invitation) error
+	FindByToken(ctx context.Context, token *valueobject.InvitationToken) (*entity.Invitation, error)
+	// Update answers a pending invitation and returns ErrAnsweredInvitation when
+	// it was answered concurrently.
+	Update(ctx context.Context, invitation *entity.Invitation) error
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrNotFoundMember = validation.NotFoundError("member not found")
+
+type MemberRepository interface {
+	// Save keeps the existing member when the user already joined the room.
+	Save(ctx context.Context, member *entity.Member) error
+	FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Member, error)
+	ListByRoom(ctx context.Context, roomId *valueobject.Id, query *pagination.Query) (*pagination.Page[*entity.Member], error)
+	// ListReaders returns the members, other than the sender, whose last read
+	// message is the message or a newer one.
+	ListReaders(ctx context.Context, message *entity.Message, query *pagination.Query) (*pagination.Page[*entity.Member], error)
+	CountByRoom(ctx context.Context, roomId *valueobject.Id) (int64, error)
+	Update(ctx context.Context, member *entity.Member) error
+	// UpdateLastRead keeps the last read message when it is newer than the
+	// message read by the member. It reports whether the last read message
+	// changed.
+	UpdateLastRead(ctx context.Context, member *entity.Member) (bool, error)
+	Delete(ctx context.Context, member *entity.Member) error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 )
@@ -13,4 +14,8 @@ const ErrNotFoundMessage = validation.NotFoundError("message not found")
 type MessageRepository interface {
 	Save(ctx context.Context, message *entity.Message) error
 	FindById(ctx context.Context, id *valueobject.Id) (*entity.Message, error)
+	// ListByRoom lists every message of the room, including the replies.
+	ListByRoom(ctx context.Context, roomId *valueobject.Id, query *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error)
+	ListReplies(ctx context.Context, parentId *valueobject.Id, query *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error)
+	Update(ctx context.Context, message *entity.Message) error
 }
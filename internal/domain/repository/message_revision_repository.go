@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type MessageRevisionRepository interface {
+	Save(ctx context.Context, revision *entity.MessageRevision) error
+	ListByMessage(ctx context.Context, messageId *valueobject.Id) ([]*entity.MessageRevision, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrNotFoundMute = validation.NotFoundError("mute not found")
+
+type MuteRepository interface {
+	// Save replaces the existing mute when the user already has one in the room.
+	Save(ctx context.Context, mute *entity.Mute) error
+	FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Mute, error)
+	Delete(ctx context.Context, mute *entity.Mute) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type OwnershipTransferRepository interface {
+	Save(ctx context.Context, transfer *entity.OwnershipTransfer) error
+	ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.OwnershipTransfer, error)
+}
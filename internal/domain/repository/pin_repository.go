@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const (
+	ErrNotFoundPin  = validation.NotFoundError("pin not found")
+	ErrDuplicatePin = validation.ValidationError("message already pinned")
+)
+
+type PinRepository interface {
+	// Save returns ErrDuplicatePin when the message is already pinned.
+	Save(ctx context.Context, pin *entity.Pin) error
+	// Delete returns ErrNotFoundPin when the message is not pinned.
+	Delete(ctx context.Context, pin *entity.Pin) error
+	CountByRoom(ctx context.Context, roomId *valueobject.Id) (int64, error)
+	// ListByRoom lists the pins of the room with their messages from the newest
+	// to the oldest.
+	ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.PinnedMessage, error)
+}
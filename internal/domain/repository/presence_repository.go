@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+const ErrNotFoundPresence = validation.NotFoundError("presence not found")
+
+type PresenceRepository interface {
+	// Save replaces the presence of the session when it already has one.
+	Save(ctx context.Context, presence *entity.Presence) error
+	Delete(ctx context.Context, presence *entity.Presence) error
+	// FindByRoomAndUser returns the presence of the user merged from its
+	// sessions in the room.
+	FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Presence, error)
+	// ListByRoom returns the merged presence of each user with a session in
+	// the room.
+	ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.Presence, error)
+	// ListExpired returns the sessions that were not refreshed within the
+	// presence timeout.
+	ListExpired(ctx context.Context) ([]*entity.Presence, error)
+}
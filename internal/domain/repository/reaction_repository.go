@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+)
+
+const (
+	ErrNotFoundReaction  = validation.NotFoundError("reaction not found")
+	ErrDuplicateReaction = validation.ValidationError("reaction already added")
+)
+
+type ReactionRepository interface {
+	// Save returns ErrDuplicateReaction when the user already reacted to the
+	// message with the emoji.
+	Save(ctx context.Context, reaction *entity.Reaction) error
+	// Delete returns ErrNotFoundReaction when the user has not reacted to the
+	// message with the emoji.
+	Delete(ctx context.Context, reaction *entity.Reaction) error
+}
@@ -14,6 +14,11 @@ const ErrNotFoundRoom = validation.NotFoundError("room not found")
 type RoomRepository interface {
 	Save(ctx context.Context, room *entity.Room) error
 	FindById(ctx context.Context, id *valueobject.Id) (*entity.Room, error)
-	Search(ctx context.Context, query *pagination.Query) (*pagination.Page[*entity.Room], error)
+	// Search returns the public rooms and the private rooms the user is a member of.
+	// The direct rooms are listed by the DirectRoomRepository.
+	Search(ctx context.Context, userId *valueobject.UserId, query *pagination.Query) (*pagination.Page[*entity.Room], error)
 	Update(ctx context.Context, room *entity.Room) error
+	// Lock locks the room until the end of the transaction in the context, so
+	// the changes that depend on counts of the room are made one at a time.
+	Lock(ctx context.Context, id *valueobject.Id) error
 }
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+)
+
+// TransactionManager runs fn in a transaction. Repositories called with the
+// context given to fn take part in the transaction, which is committed when fn
+// returns nil and rolled back otherwise.
+type TransactionManager interface {
+	Execute(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type UserRoomRepository interface {
+	// ListByUser returns the rooms the user is a member of, the most recently
+	// active first.
+	ListByUser(ctx context.Context, userId *valueobject.UserId, query *pagination.Query) (*pagination.Page[*entity.UserRoom], error)
+}
@@ -0,0 +1,7 @@
+package validation
+
+type ConflictError string
+
+func (e ConflictError) Error() string {
+	return string(e)
+}
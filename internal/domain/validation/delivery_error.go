@@ -0,0 +1,7 @@
+package validation
+
+type DeliveryError string
+
+func (e DeliveryError) Error() string {
+	return string(e)
+}
@@ -0,0 +1,7 @@
+package validation
+
+type ForbiddenError string
+
+func (e ForbiddenError) Error() string {
+	return string(e)
+}
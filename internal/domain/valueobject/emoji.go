@@ -0,0 +1,145 @@
+package valueobject
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+)
+
+const (
+	ErrRequiredEmoji = validation.ValidationError("emoji is required")
+	ErrInvalidEmoji  = validation.ValidationError("emoji is invalid")
+)
+
+// MaxEmojiLength is the length in bytes of the longest emoji sequences, such as
+// families and subdivision flags.
+const MaxEmojiLength = 32
+
+// emojiPictographs are the ranges of the characters shown as emojis.
+var emojiPictographs = [][2]rune{
+	{0x00A9, 0x00A9},
+	{0x00AE, 0x00AE},
+	{0x203C, 0x203C},
+	{0x2049, 0x2049},
+	{0x2122, 0x2122},
+	{0x2139, 0x2139},
+	{0x2194, 0x21AA},
+	{0x231A, 0x23FF},
+	{0x24C2, 0x24C2},
+	{0x25AA, 0x25FE},
+	{0x2600, 0x27BF},
+	{0x2934, 0x2935},
+	{0x2B05, 0x2B55},
+	{0x3030, 0x3030},
+	{0x303D, 0x303D},
+	{0x3297, 0x3299},
+	{0x1F000, 0x1FAFF},
+}
+
+// The characters that only compose emojis.
+var (
+	emojiModifiers     = [][2]rune{{0x1F3FB, 0x1F3FF}}
+	regionalIndicators = [][2]rune{{0x1F1E6, 0x1F1FF}}
+	keycapBases        = [][2]rune{{'#', '#'}, {'*', '*'}, {'0', '9'}}
+	emojiTags          = [][2]rune{{0xE0020, 0xE007E}}
+)
+
+const (
+	zeroWidthJoiner = "\u200D"
+	keycapMark      = 0x20E3
+	cancelTag       = 0xE007F
+)
+
+// variationSelectors only choose between the text and the emoji style, they
+// are removed so both styles are the same emoji.
+var variationSelectors = strings.NewReplacer("\uFE0E", "", "\uFE0F", "")
+
+type Emoji struct {
+	value string
+}
+
+func NewEmojiWith(emoji string) (*Emoji, error) {
+	value := strings.TrimSpace(emoji)
+
+	if value == "" {
+		return nil, ErrRequiredEmoji
+	}
+
+	value = variationSelectors.Replace(value)
+
+	if len(value) > MaxEmojiLength || !isEmoji(value) {
+		return nil, ErrInvalidEmoji
+	}
+
+	return &Emoji{value: value}, nil
+}
+
+func (e *Emoji) Value() string {
+	return e.value
+}
+
+// isEmoji reports whether the value is a single emoji: a keycap, a flag, a
+// subdivision flag or pictographs joined by zero width joiners, each one with
+// an optional skin tone.
+func isEmoji(value string) bool {
+	runes := []rune(value)
+
+	if isKeycap(runes) || isFlag(runes) || isSubdivisionFlag(runes) {
+		return true
+	}
+
+	for _, element := range strings.Split(value, zeroWidthJoiner) {
+		runes := []rune(element)
+
+		switch {
+		case len(runes) == 1 && isPictograph(runes[0]):
+		case len(runes) == 2 && isPictograph(runes[0]) && inRanges(runes[1], emojiModifiers):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isKeycap(runes []rune) bool {
+	return len(runes) == 2 && inRanges(runes[0], keycapBases) && runes[1] == keycapMark
+}
+
+func isFlag(runes []rune) bool {
+	return len(runes) == 2 && inRanges(runes[0], regionalIndicators) && inRanges(runes[1], regionalIndicators)
+}
+
+func isSubdivisionFlag(runes []rune) bool {
+	if len(runes) < 3 || !isPictograph(runes[0]) || runes[len(runes)-1] != cancelTag {
+		return false
+	}
+
+	for _, r := range runes[1 : len(runes)-1] {
+		if !inRanges(r, emojiTags) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPictograph reports whether the character is an assigned pictograph, the
+// skin tones and the regional indicators are only parts of an emoji.
+func isPictograph(r rune) bool {
+	return inRanges(r, emojiPictographs) &&
+		unicode.IsGraphic(r) &&
+		!inRanges(r, emojiModifiers) &&
+		!inRanges(r, regionalIndicators)
+}
+
+func inRanges(r rune, ranges [][2]rune) bool {
+	for _, rg := range ranges {
+		if r >= rg[0] && r <= rg[1] {
+			return true
+		}
+	}
+
+	return false
+}
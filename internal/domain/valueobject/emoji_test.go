@@ -0,0 +1,70 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmoji_ShouldCreateAnEmojiWhenValueIsValid(t *testing.T) {
+	testCases := []struct {
+		test  string
+		emoji string
+		value string
+	}{
+		{"pictograph", "😀", "😀"},
+		{"symbol with variation selector", "❤️", "❤"},
+		{"symbol without variation selector", "❤", "❤"},
+		{"symbol with text variation selector", "❤︎", "❤"},
+		{"skin tone", "👍🏽", "👍🏽"},
+		{"zero width joiner sequence", "👩‍💻", "👩‍💻"},
+		{"zero width joiner sequence with variation selector", "🏳️‍🌈", "🏳‍🌈"},
+		{"flag", "🇧🇷", "🇧🇷"},
+		{"keycap", "1️⃣", "1⃣"},
+		{"subdivision flag", "🏴󠁧󠁢󠁳󠁣󠁴󠁿", "🏴󠁧󠁢󠁳󠁣󠁴󠁿"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			emoji, err := NewEmojiWith(tc.emoji)
+			assert.Nil(t, err)
+			assert.NotNil(t, emoji)
+			assert.Equal(t, tc.value, emoji.Value())
+		})
+	}
+}
+
+func TestEmoji_ShouldReturnAValidationErrorWhenValueIsInvalid(t *testing.T) {
+	testCases := []struct {
+		test  string
+		emoji string
+		err   error
+	}{
+		{"empty emoji", "", ErrRequiredEmoji},
+		{"blank emoji", "   ", ErrRequiredEmoji},
+		{"text", "like", ErrInvalidEmoji},
+		{"text with emoji", "ok👍", ErrInvalidEmoji},
+		{"keycap base", "1", ErrInvalidEmoji},
+		{"pictograph with digit", "©1", ErrInvalidEmoji},
+		{"multiple emojis", "😀😀", ErrInvalidEmoji},
+		{"multiple flags", "🇧🇷🇵🇹", ErrInvalidEmoji},
+		{"multiple emojis with variation selectors", "❤️❤️", ErrInvalidEmoji},
+		{"skin tone alone", "🏽", ErrInvalidEmoji},
+		{"regional indicator alone", "🇧", ErrInvalidEmoji},
+		{"trailing zero width joiner", "👩‍", ErrInvalidEmoji},
+		{"variation selector alone", "️", ErrInvalidEmoji},
+		{"unassigned code point", "\U0001FAFF", ErrInvalidEmoji},
+		{"too long", "👩‍👩‍👩‍👩‍👩‍👩", ErrInvalidEmoji},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			emoji, err := NewEmojiWith(tc.emoji)
+			assert.Nil(t, emoji)
+			assert.ErrorIs(t, err, tc.err)
+			assert.IsType(t, validation.ValidationError(""), err)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package valueobject
+
+import (
+	"strings"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+)
+
+const (
+	ErrRequiredIdempotencyKey = validation.ValidationError("idempotency key is required")
+	ErrInvalidIdempotencyKey  = validation.ValidationError("idempotency key length must be less than or equal to 255")
+)
+
+type IdempotencyKey struct {
+	value string
+}
+
+func NewIdempotencyKeyWith(key string) (*IdempotencyKey, error) {
+	value := strings.TrimSpace(key)
+
+	if value == "" {
+		return nil, ErrRequiredIdempotencyKey
+	}
+
+	if len(value) > 255 {
+		return nil, ErrInvalidIdempotencyKey
+	}
+
+	return &IdempotencyKey{value: value}, nil
+}
+
+func (k *IdempotencyKey) Value() string {
+	return k.value
+}
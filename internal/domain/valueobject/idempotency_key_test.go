@@ -0,0 +1,52 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyKey_ShouldCreateAnIdempotencyKeyWhenValueIsValid(t *testing.T) {
+	key := "8e03978e-40d5-43e8-bc93-6894a57f9324"
+	idempotencyKey, err := NewIdempotencyKeyWith(key)
+	assert.NotNil(t, idempotencyKey)
+	assert.Nil(t, err)
+	assert.Equal(t, key, idempotencyKey.Value())
+}
+
+func TestIdempotencyKey_ShouldReturnAValidationErrorWhenValueIsInvalid(t *testing.T) {
+	testCases := []struct {
+		test string
+		key  string
+		err  error
+	}{
+		{
+			"empty key",
+			"",
+			ErrRequiredIdempotencyKey,
+		},
+		{
+			"blank key",
+			"     ",
+			ErrRequiredIdempotencyKey,
+		},
+		{
+			"invalid key size",
+			strings.Repeat("k", 256),
+			ErrInvalidIdempotencyKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			idempotencyKey, err := NewIdempotencyKeyWith(tc.key)
+			assert.Nil(t, idempotencyKey)
+			assert.NotNil(t, err)
+			assert.ErrorIs(t, err, tc.err)
+			assert.IsType(t, validation.ValidationError(""), err)
+		})
+	}
+}
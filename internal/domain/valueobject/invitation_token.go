@@ -0,0 +1,49 @@
+package valueobject
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+)
+
+const invitationTokenBytes = 32
+
+const (
+	ErrRequiredInvitationToken = validation.ValidationError("invitation token is required")
+	ErrInvalidInvitationToken  = validation.ValidationError("invitation token is invalid")
+)
+
+type InvitationToken struct {
+	value string
+}
+
+// NewInvitationToken panics when the system random source fails, as a token
+// that is not random must never be issued.
+func NewInvitationToken() *InvitationToken {
+	b := make([]byte, invitationTokenBytes)
+
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	token, _ := NewInvitationTokenWith(hex.EncodeToString(b))
+	return token
+}
+
+func NewInvitationTokenWith(value string) (*InvitationToken, error) {
+	if value == "" {
+		return nil, ErrRequiredInvitationToken
+	}
+
+	b, err := hex.DecodeString(value)
+	if err != nil || len(b) != invitationTokenBytes {
+		return nil, ErrInvalidInvitationToken
+	}
+
+	return &InvitationToken{value: hex.EncodeToString(b)}, nil
+}
+
+func (t *InvitationToken) Value() string {
+	return t.value
+}
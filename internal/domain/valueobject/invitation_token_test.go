@@ -0,0 +1,56 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitationToken_ShouldCreateAnInvitationTokenWhenValueIsValid(t *testing.T) {
+	token := NewInvitationToken()
+	assert.Equal(t, 64, len(token.Value()))
+	assert.NotEqual(t, token.Value(), NewInvitationToken().Value())
+
+	value := strings.Repeat("a1", 32)
+	token, err := NewInvitationTokenWith(value)
+	assert.NotNil(t, token)
+	assert.Nil(t, err)
+	assert.Equal(t, value, token.Value())
+}
+
+func TestInvitationToken_ShouldReturnAValidationErrorWhenValueIsInvalid(t *testing.T) {
+	testCases := []struct {
+		test  string
+		value string
+		err   error
+	}{
+		{
+			"empty value",
+			"",
+			ErrRequiredInvitationToken,
+		},
+		{
+			"not hexadecimal",
+			strings.Repeat("zz", 32),
+			ErrInvalidInvitationToken,
+		},
+		{
+			"short value",
+			strings.Repeat("a1", 16),
+			ErrInvalidInvitationToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			token, err := NewInvitationTokenWith(tc.value)
+			assert.Nil(t, token)
+			assert.NotNil(t, err)
+			assert.ErrorIs(t, err, tc.err)
+			assert.IsType(t, validation.ValidationError(""), err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import "github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+const (
+	MemberRoleOwner     = "owner"
+	MemberRoleModerator = "moderator"
+	MemberRoleMember    = "member"
+)
+
+const (
+	ErrRequiredMemberRole = validation.ValidationError("member role is required")
+	ErrInvalidMemberRole  = validation.ValidationError("member role is invalid")
+)
+
+type MemberRole struct {
+	value string
+}
+
+func NewMemberRole() *MemberRole {
+	return &MemberRole{value: MemberRoleMember}
+}
+
+func NewMemberRoleWith(value string) (*MemberRole, error) {
+	if value == "" {
+		return nil, ErrRequiredMemberRole
+	}
+
+	switch value {
+	case MemberRoleOwner:
+	case MemberRoleModerator:
+	case MemberRoleMember:
+	default:
+		return nil, ErrInvalidMemberRole
+	}
+
+	return &MemberRole{value: value}, nil
+}
+
+func (r *MemberRole) Value() string {
+	return r.value
+}
+
+func (r *MemberRole) IsOwner() bool {
+	return r.value == MemberRoleOwner
+}
+
+func (r *MemberRole) IsModerator() bool {
+	return r.value == MemberRoleModerator
+}
@@ -0,0 +1,56 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberRole_ShouldCreateAMemberRoleByDefault(t *testing.T) {
+	role := NewMemberRole()
+	assert.Equal(t, MemberRoleMember, role.Value())
+	assert.False(t, role.IsOwner())
+	assert.False(t, role.IsModerator())
+}
+
+func TestMemberRole_ShouldCreateAMemberRoleWhenValueIsValid(t *testing.T) {
+	for _, value := range []string{MemberRoleOwner, MemberRoleModerator, MemberRoleMember} {
+		role, err := NewMemberRoleWith(value)
+		assert.NotNil(t, role)
+		assert.Nil(t, err)
+		assert.Equal(t, value, role.Value())
+		assert.Equal(t, value == MemberRoleOwner, role.IsOwner())
+		assert.Equal(t, value == MemberRoleModerator, role.IsModerator())
+	}
+}
+
+func TestMemberRole_ShouldReturnAValidationErrorWhenValueIsInvalid(t *testing.T) {
+	testCases := []struct {
+		test  string
+		value string
+		err   error
+	}{
+		{
+			"empty value",
+			"",
+			ErrRequiredMemberRole,
+		},
+		{
+			"invalid value",
+			"admin",
+			ErrInvalidMemberRole,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			role, err := NewMemberRoleWith(tc.value)
+			assert.Nil(t, role)
+			assert.NotNil(t, err)
+			assert.ErrorIs(t, err, tc.err)
+			assert.IsType(t, validation.ValidationError(""), err)
+		})
+	}
+}
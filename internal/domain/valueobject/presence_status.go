@@ -0,0 +1,54 @@
+package valueobject
+
+import "github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+const (
+	PresenceStatusOnline  = "online"
+	PresenceStatusAway    = "away"
+	PresenceStatusOffline = "offline"
+)
+
+const (
+	ErrRequiredPresenceStatus = validation.ValidationError("presence status is required")
+	ErrInvalidPresenceStatus  = validation.ValidationError("presence status is invalid")
+)
+
+type PresenceStatus struct {
+	value string
+}
+
+func NewPresenceStatus() *PresenceStatus {
+	return &PresenceStatus{value: PresenceStatusOnline}
+}
+
+func NewPresenceStatusWith(value string) (*PresenceStatus, error) {
+	if value == "" {
+		return nil, ErrRequiredPresenceStatus
+	}
+
+	switch value {
+	case PresenceStatusOnline:
+	case PresenceStatusAway:
+	case PresenceStatusOffline:
+	default:
+		return nil, ErrInvalidPresenceStatus
+	}
+
+	return &PresenceStatus{value: value}, nil
+}
+
+func (s *PresenceStatus) Value() string {
+	return s.value
+}
+
+func (s *PresenceStatus) IsOnline() bool {
+	return s.value == PresenceStatusOnline
+}
+
+func (s *PresenceStatus) IsAway() bool {
+	return s.value == PresenceStatusAway
+}
+
+func (s *PresenceStatus) IsOffline() bool {
+	return s.value == PresenceStatusOffline
+}
@@ -0,0 +1,58 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenceStatus_ShouldCreateAnOnlineStatusByDefault(t *testing.T) {
+	status := NewPresenceStatus()
+	assert.Equal(t, PresenceStatusOnline, status.Value())
+	assert.True(t, status.IsOnline())
+	assert.False(t, status.IsAway())
+	assert.False(t, status.IsOffline())
+}
+
+func TestPresenceStatus_ShouldCreateAPresenceStatusWhenValueIsValid(t *testing.T) {
+	for _, value := range []string{PresenceStatusOnline, PresenceStatusAway, PresenceStatusOffline} {
+		status, err := NewPresenceStatusWith(value)
+		assert.NotNil(t, status)
+		assert.Nil(t, err)
+		assert.Equal(t, value, status.Value())
+		assert.Equal(t, value == PresenceStatusOnline, status.IsOnline())
+		assert.Equal(t, value == PresenceStatusAway, status.IsAway())
+		assert.Equal(t, value == PresenceStatusOffline, status.IsOffline())
+	}
+}
+
+func TestPresenceStatus_ShouldReturnAValidationErrorWhenValueIsInvalid(t *testing.T) {
+	testCases := []struct {
+		test  string
+		value string
+		err   error
+	}{
+		{
+			"empty value",
+			"",
+			ErrRequiredPresenceStatus,
+		},
+		{
+			"invalid value",
+			"busy",
+			ErrInvalidPresenceStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			status, err := NewPresenceStatusWith(tc.value)
+			assert.Nil(t, status)
+			assert.NotNil(t, err)
+			assert.ErrorIs(t, err, tc.err)
+			assert.IsType(t, validation.ValidationError(""), err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package valueobject
+
+import "github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+const (
+	RoomVisibilityPublic  = "public"
+	RoomVisibilityPrivate = "private"
+	RoomVisibilityDirect  = "direct"
+)
+
+const (
+	ErrRequiredRoomVisibility = validation.ValidationError("room visibility is required")
+	ErrInvalidRoomVisibility  = validation.ValidationError("room visibility is invalid")
+)
+
+type RoomVisibility struct {
+	value string
+}
+
+func NewRoomVisibility() *RoomVisibility {
+	return &RoomVisibility{value: RoomVisibilityPublic}
+}
+
+func NewRoomVisibilityWith(value string) (*RoomVisibility, error) {
+	if value == "" {
+		return nil, ErrRequiredRoomVisibility
+	}
+
+	switch value {
+	case RoomVisibilityPublic:
+	case RoomVisibilityPrivate:
+	case RoomVisibilityDirect:
+	default:
+		return nil, ErrInvalidRoomVisibility
+	}
+
+	return &RoomVisibility{value: value}, nil
+}
+
+func (v *RoomVisibility) Value() string {
+	return v.value
+}
+
+// IsPrivate reports whether the room is hidden from the users that are not
+// its members, which includes the direct rooms.
+func (v *RoomVisibility) IsPrivate() bool {
+	return v.value == RoomVisibilityPrivate || v.value == RoomVisibilityDirect
+}
+
+func (v *RoomVisibility) IsDirect() bool {
+	return v.value == RoomVisibilityDirect
+}
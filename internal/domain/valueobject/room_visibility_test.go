@@ -0,0 +1,56 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoomVisibility_ShouldCreateAPublicRoomVisibilityByDefault(t *testing.T) {
+	visibility := NewRoomVisibility()
+	assert.Equal(t, RoomVisibilityPublic, visibility.Value())
+	assert.False(t, visibility.IsPrivate())
+	assert.False(t, visibility.IsDirect())
+}
+
+func TestRoomVisibility_ShouldCreateARoomVisibilityWhenValueIsValid(t *testing.T) {
+	for _, value := range []string{RoomVisibilityPublic, RoomVisibilityPrivate, RoomVisibilityDirect} {
+		visibility, err := NewRoomVisibilityWith(value)
+		assert.NotNil(t, visibility)
+		assert.Nil(t, err)
+		assert.Equal(t, value, visibility.Value())
+		assert.Equal(t, value != RoomVisibilityPublic, visibility.IsPrivate())
+		assert.Equal(t, value == RoomVisibilityDirect, visibility.IsDirect())
+	}
+}
+
+func TestRoomVisibility_ShouldReturnAValidationErrorWhenValueIsInvalid(t *testing.T) {
+	testCases := []struct {
+		test  string
+		value string
+		err   error
+	}{
+		{
+			"empty value",
+			"",
+			ErrRequiredRoomVisibility,
+		},
+		{
+			"invalid value",
+			"hidden",
+			ErrInvalidRoomVisibility,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			visibility, err := NewRoomVisibilityWith(tc.value)
+			assert.Nil(t, visibility)
+			assert.NotNil(t, err)
+			assert.ErrorIs(t, err, tc.err)
+			assert.IsType(t, validation.ValidationError(""), err)
+		})
+	}
+}
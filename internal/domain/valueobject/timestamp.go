@@ -44,3 +44,8 @@ func (t *Timestamp) Value() string {
 func (t *Timestamp) Time() time.Time {
 	return t.value
 }
+
+func (t *Timestamp) Add(d time.Duration) *Timestamp {
+	timestamp, _ := NewTimestampWith(t.value.Add(d).Format(timestampLayout))
+	return timestamp
+}
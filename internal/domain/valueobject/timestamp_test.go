@@ -55,3 +55,11 @@ func TestTimestamp_ShouldReturnAValidationErrorWhenValueIsInvalid(t *testing.T)
 		})
 	}
 }
+
+func TestTimestamp_ShouldAddADuration(t *testing.T) {
+	timestamp, _ := NewTimestampWith("2023-08-01T10:00:00.5Z")
+
+	later := timestamp.Add(24 * time.Hour)
+	assert.Equal(t, "2023-08-02T10:00:00.5Z", later.Value())
+	assert.Equal(t, "2023-08-01T10:00:00.5Z", timestamp.Value())
+}
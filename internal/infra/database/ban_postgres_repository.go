@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type BanPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewBanPostgresRepository(db *sql.DB) *BanPostgresRepository {
+	return &BanPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("BanPostgresRepository"),
+	}
+}
+
+func (r *BanPostgresRepository) Save(ctx context.Context, ban *entity.Ban) error {
+	m := model.NewBanModel(ban)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO room_bans (room_id, user_id, banned_by, created_at, expires_at) 
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (room_id, user_id) DO UPDATE 
+		SET banned_by = EXCLUDED.banned_by, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.RoomId,
+		m.UserId,
+		m.BannedBy,
+		m.CreatedAt,
+		m.ExpiresAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *BanPostgresRepository) FindByRoomAndUser(
+	ctx context.Context,
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) (*entity.Ban, error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT room_id, user_id, banned_by, created_at, expires_at 
+		FROM room_bans 
+		WHERE room_id = $1 AND user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var m model.BanModel
+
+	err = stmt.QueryRowContext(ctx, roomId.Value(), userId.Value()).Scan(
+		&m.RoomId,
+		&m.UserId,
+		&m.BannedBy,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFoundBan
+		}
+
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	ban, err := m.ToEntity()
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return ban, nil
+}
+
+func (r *BanPostgresRepository) Delete(ctx context.Context, ban *entity.Ban) error {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		DELETE FROM room_bans 
+		WHERE room_id = $1 AND user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, ban.RoomId().Value(), ban.UserId().Value())
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresBanRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type BanPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	roomRepository repository.RoomRepository
+	banRepository  repository.BanRepository
+}
+
+func (s *BanPostgresRepositoryTestSuite) SetupSuite() {
+	postgresBanRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresBanRepository.Host,
+		Port:     postgresBanRepository.Port,
+		User:     postgresBanRepository.User,
+		Password: postgresBanRepository.Password,
+		Name:     postgresBanRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.banRepository = NewBanPostgresRepository(db)
+}
+
+func (s *BanPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresBanRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestBanPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(BanPostgresRepositoryTestSuite))
+}
+
+func (s *BanPostgresRepositoryTestSuite) TestShouldSaveFindAndDeleteABan() {
+	defer postgresBanRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Games")
+	category, _ := valueobject.NewRoomCategoryWith("General")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	ban, _ := entity.NewBan(room.Id(), userId, adminId, valueobject.NewTimestamp().Add(time.Hour))
+
+	_, err := s.banRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundBan)
+
+	err = s.banRepository.Save(s.ctx, ban)
+	assert.Nil(t, err)
+
+	result, err := s.banRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Equal(t, ban.RoomId().Value(), result.RoomId().Value())
+	assert.Equal(t, ban.UserId().Value(), result.UserId().Value())
+	assert.Equal(t, ban.BannedBy().Value(), result.BannedBy().Value())
+	assert.Equal(t, ban.CreatedAt().Value(), result.CreatedAt().Value())
+	assert.Equal(t, ban.ExpiresAt().Value(), result.ExpiresAt().Value())
+
+	ban, _ = entity.NewBan(room.Id(), userId, adminId, valueobject.NewTimestamp().Add(2*time.Hour))
+
+	err = s.banRepository.Save(s.ctx, ban)
+	assert.Nil(t, err)
+
+	result, err = s.banRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Equal(t, ban.ExpiresAt().Value(), result.ExpiresAt().Value())
+
+	ban, _ = entity.NewBan(room.Id(), userId, adminId, nil)
+
+	err = s.banRepository.Save(s.ctx, ban)
+	assert.Nil(t, err)
+
+	result, err = s.banRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Nil(t, result.ExpiresAt())
+	assert.True(t, result.IsActive())
+
+	err = s.banRepository.Delete(s.ctx, ban)
+	assert.Nil(t, err)
+
+	_, err = s.banRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundBan)
+}
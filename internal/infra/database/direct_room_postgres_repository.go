@@ -0,0 +1,208 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type DirectRoomPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewDirectRoomPostgresRepository(db *sql.DB) *DirectRoomPostgresRepository {
+	return &DirectRoomPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("DirectRoomPostgresRepository"),
+	}
+}
+
+func (r *DirectRoomPostgresRepository) Save(ctx context.Context, directRoom *entity.DirectRoom) error {
+	m := model.NewDirectRoomModel(directRoom)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO direct_rooms (room_id, first_user_id, second_user_id) 
+		VALUES ($1, $2, $3)
+		ON CONFLICT (first_user_id, second_user_id) DO NOTHING
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		m.Room.Id,
+		m.FirstUserId,
+		m.SecondUserId,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	if rows == 0 {
+		return repository.ErrDuplicateDirectRoom
+	}
+
+	return nil
+}
+
+func (r *DirectRoomPostgresRepository) FindByUsers(
+	ctx context.Context,
+	userId *valueobject.UserId,
+	otherUserId *valueobject.UserId,
+) (*entity.DirectRoom, error) {
+
+	firstUserId, secondUserId := userId.Value(), otherUserId.Value()
+	if firstUserId > secondUserId {
+		firstUserId, secondUserId = secondUserId, firstUserId
+	}
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT r.id, r.admin_id, r.name, r.category, r.visibility, r.created_at, r.updated_at, r.deleted_at, 
+			d.first_user_id, d.second_user_id
+		FROM direct_rooms d
+		JOIN rooms r ON r.id = d.room_id
+		WHERE d.first_user_id = $1 AND d.second_user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var m model.DirectRoomModel
+
+	err = stmt.QueryRowContext(ctx, firstUserId, secondUserId).Scan(
+		&m.Room.Id,
+		&m.Room.AdminId,
+		&m.Room.Name,
+		&m.Room.Category,
+		&m.Room.Visibility,
+		&m.Room.CreatedAt,
+		&m.Room.UpdatedAt,
+		&m.Room.DeletedAt,
+		&m.FirstUserId,
+		&m.SecondUserId,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFoundDirectRoom
+		}
+
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	directRoom, err := m.ToEntity()
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return directRoom, nil
+}
+
+func (r *DirectRoomPostgresRepository) ListByUser(
+	ctx context.Context,
+	userId *valueobject.UserId,
+	query *pagination.Query,
+) (*pagination.Page[*entity.DirectRoom], error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT r.id, r.admin_id, r.name, r.category, r.visibility, r.created_at, r.updated_at, r.deleted_at, 
+			d.first_user_id, d.second_user_id, 
+			m.id, m.sender_id, m.sender_name, m.text, m.created_at, m.edited_at, m.deleted_at, 
+			COUNT(*) OVER () AS total
+		FROM direct_rooms d
+		JOIN rooms r ON r.id = d.room_id
+		JOIN room_members rm ON rm.room_id = d.room_id AND rm.user_id = $1
+		LEFT JOIN LATERAL (
+			SELECT id, sender_id, sender_name, text, created_at, edited_at, deleted_at
+			FROM messages
+			WHERE messages.room_id = d.room_id
+			ORDER BY created_at DESC, id DESC
+			LIMIT 1
+		) m ON TRUE
+		WHERE r.deleted_at IS NULL
+		ORDER BY COALESCE(m.created_at, r.created_at) DESC, r.id DESC
+		LIMIT $2 
+		OFFSET $3
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userId.Value(), query.Size(), query.Size()*query.Page())
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entity.DirectRoom
+	var total int64
+
+	for rows.Next() {
+		var m model.DirectRoomModel
+
+		err := rows.Scan(
+			&m.Room.Id,
+			&m.Room.AdminId,
+			&m.Room.Name,
+			&m.Room.Category,
+			&m.Room.Visibility,
+			&m.Room.CreatedAt,
+			&m.Room.UpdatedAt,
+			&m.Room.DeletedAt,
+			&m.FirstUserId,
+			&m.SecondUserId,
+			&m.LastMessageId,
+			&m.LastMessageSenderId,
+			&m.LastMessageSenderName,
+			&m.LastMessageText,
+			&m.LastMessageCreatedAt,
+			&m.LastMessageEditedAt,
+			&m.LastMessageDeletedAt,
+			&total,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		directRoom, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		items = append(items, directRoom)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	page := pagination.NewPage[*entity.DirectRoom](query.Page(), query.Size(), total, items)
+	return page, nil
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresDirectRoomRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type DirectRoomPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	roomRepository       repository.RoomRepository
+	memberRepository     repository.MemberRepository
+	messageRepository    repository.MessageRepository
+	directRoomRepository repository.DirectRoomRepository
+}
+
+func (s *DirectRoomPostgresRepositoryTestSuite) SetupSuite() {
+	postgresDirectRoomRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresDirectRoomRepository.Host,
+		Port:     postgresDirectRoomRepository.Port,
+		User:     postgresDirectRoomRepository.User,
+		Password: postgresDirectRoomRepository.Password,
+		Name:     postgresDirectRoomRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.memberRepository = NewMemberPostgresRepository(db)
+	s.messageRepository = NewMessagePostgresRepository(db)
+	s.directRoomRepository = NewDirectRoomPostgresRepository(db)
+}
+
+func (s *DirectRoomPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresDirectRoomRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestDirectRoomPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DirectRoomPostgresRepositoryTestSuite))
+}
+
+func (s *DirectRoomPostgresRepositoryTestSuite) TestShouldSaveFindAndListDirectRooms() {
+	defer postgresDirectRoomRepository.Clear()
+	t := s.T()
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	recipientId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	otherRecipientId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+
+	_, err := s.directRoomRepository.FindByUsers(s.ctx, userId, recipientId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundDirectRoom)
+
+	save := func(directRoom *entity.DirectRoom) error {
+		s.roomRepository.Save(s.ctx, directRoom.Room())
+
+		for _, member := range directRoom.Members() {
+			s.memberRepository.Save(s.ctx, member)
+		}
+
+		return s.directRoomRepository.Save(s.ctx, directRoom)
+	}
+
+	directRoom, _ := entity.NewDirectRoom(userId, recipientId)
+	err = save(directRoom)
+	assert.Nil(t, err)
+
+	duplicated, _ := entity.NewDirectRoom(recipientId, userId)
+	err = s.directRoomRepository.Save(s.ctx, duplicated)
+	assert.ErrorIs(t, err, repository.ErrDuplicateDirectRoom)
+
+	result, err := s.directRoomRepository.FindByUsers(s.ctx, recipientId, userId)
+	assert.Nil(t, err)
+	assert.Equal(t, directRoom.Room().Id().Value(), result.Room().Id().Value())
+	assert.True(t, result.Room().IsDirect())
+	assert.Equal(t, directRoom.FirstUserId().Value(), result.FirstUserId().Value())
+	assert.Equal(t, directRoom.SecondUserId().Value(), result.SecondUserId().Value())
+
+	otherDirectRoom, _ := entity.NewDirectRoom(otherRecipientId, userId)
+	err = save(otherDirectRoom)
+	assert.Nil(t, err)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(directRoom.Room().Id(), recipientId, senderName, text)
+	s.messageRepository.Save(s.ctx, message)
+
+	query, _ := pagination.NewQuery("0", "10", "", "")
+
+	page, err := s.directRoomRepository.ListByUser(s.ctx, userId, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), page.Total)
+	assert.Equal(t, directRoom.Room().Id().Value(), page.Items[0].Room().Id().Value())
+	assert.Equal(t, message.Id().Value(), page.Items[0].LastMessage().Id().Value())
+	assert.Equal(t, message.Text().Value(), page.Items[0].LastMessage().Text().Value())
+	assert.Equal(t, otherDirectRoom.Room().Id().Value(), page.Items[1].Room().Id().Value())
+	assert.Nil(t, page.Items[1].LastMessage())
+
+	page, err = s.directRoomRepository.ListByUser(s.ctx, otherRecipientId, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), page.Total)
+
+	s.memberRepository.Delete(s.ctx, entity.NewMember(otherDirectRoom.Room().Id(), otherRecipientId))
+
+	page, err = s.directRoomRepository.ListByUser(s.ctx, otherRecipientId, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), page.Total)
+}
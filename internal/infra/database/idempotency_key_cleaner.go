@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+const idempotencyKeyCleanupInterval = time.Hour
+
+// IdempotencyKeyCleaner deletes the expired idempotency keys. Expired keys are
+// already ignored and replaced on use, so the cleanup only bounds the table.
+type IdempotencyKeyCleaner struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewIdempotencyKeyCleaner(db *sql.DB) *IdempotencyKeyCleaner {
+	return &IdempotencyKeyCleaner{
+		db:     db,
+		logger: log.NewLogger("IdempotencyKeyCleaner"),
+	}
+}
+
+// Start deletes the expired keys periodically until the context is done.
+func (c *IdempotencyKeyCleaner) Start(ctx context.Context) {
+	cleanup := time.NewTicker(idempotencyKeyCleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-cleanup.C:
+			if _, err := c.Cleanup(ctx); err != nil {
+				c.logger.Error(err)
+			}
+		}
+	}
+}
+
+// Cleanup deletes the expired keys and returns how many were deleted.
+func (c *IdempotencyKeyCleaner) Cleanup(ctx context.Context) (int64, error) {
+	stmt, err := c.db.PrepareContext(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE expires_at <= NOW()
+	`)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type IdempotentRequestPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewIdempotentRequestPostgresRepository(db *sql.DB) *IdempotentRequestPostgresRepository {
+	return &IdempotentRequestPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("IdempotentRequestPostgresRepository"),
+	}
+}
+
+func (r *IdempotentRequestPostgresRepository) Save(ctx context.Context, request *entity.IdempotentRequest) error {
+	m := model.NewIdempotentRequestModel(request)
+
+	// An expired request is replaced, a valid one is kept.
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO idempotency_keys (sender_id, idempotency_key, room_id, message_id, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (sender_id, idempotency_key) DO UPDATE
+		SET room_id = EXCLUDED.room_id, message_id = EXCLUDED.message_id, request_hash = EXCLUDED.request_hash,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		m.SenderId,
+		m.Key,
+		m.RoomId,
+		m.MessageId,
+		m.RequestHash,
+		m.CreatedAt,
+		m.ExpiresAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	if rows == 0 {
+		return repository.ErrDuplicateIdempotentRequest
+	}
+
+	return nil
+}
+
+func (r *IdempotentRequestPostgresRepository) FindByKey(
+	ctx context.Context,
+	senderId *valueobject.UserId,
+	key *valueobject.IdempotencyKey,
+) (*entity.IdempotentRequest, error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT sender_id, idempotency_key, room_id, message_id, request_hash, created_at, expires_at
+		FROM idempotency_keys
+		WHERE sender_id = $1 AND idempotency_key = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var m model.IdempotentRequestModel
+
+	err = stmt.QueryRowContext(ctx, senderId.Value(), key.Value()).Scan(
+		&m.SenderId,
+		&m.Key,
+		&m.RoomId,
+		&m.MessageId,
+		&m.RequestHash,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFoundIdempotentRequest
+		}
+
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	request, err := m.ToEntity()
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return request, nil
+}
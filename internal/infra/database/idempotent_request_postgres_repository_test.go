@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresIdempotentRequestRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type IdempotentRequestPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx                         context.Context
+	roomRepository              repository.RoomRepository
+	messageRepository           repository.MessageRepository
+	idempotentRequestRepository repository.IdempotentRequestRepository
+	idempotencyKeyCleaner       *IdempotencyKeyCleaner
+}
+
+func (s *IdempotentRequestPostgresRepositoryTestSuite) SetupSuite() {
+	postgresIdempotentRequestRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresIdempotentRequestRepository.Host,
+		Port:     postgresIdempotentRequestRepository.Port,
+		User:     postgresIdempotentRequestRepository.User,
+		Password: postgresIdempotentRequestRepository.Password,
+		Name:     postgresIdempotentRequestRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.messageRepository = NewMessagePostgresRepository(db)
+	s.idempotentRequestRepository = NewIdempotentRequestPostgresRepository(db)
+	s.idempotencyKeyCleaner = NewIdempotencyKeyCleaner(db)
+}
+
+func (s *IdempotentRequestPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresIdempotentRequestRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestIdempotentRequestPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotentRequestPostgresRepositoryTestSuite))
+}
+
+func (s *IdempotentRequestPostgresRepositoryTestSuite) createAMessage(text string) *entity.Message {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	messageText, _ := valueobject.NewMessageTextWith(text)
+	message := entity.NewMessage(room.Id(), adminId, senderName, messageText)
+	s.messageRepository.Save(s.ctx, message)
+
+	return message
+}
+
+func (s *IdempotentRequestPostgresRepositoryTestSuite) TestShouldSaveAndFindARequest() {
+	defer postgresIdempotentRequestRepository.Clear()
+	t := s.T()
+
+	key, _ := valueobject.NewIdempotencyKeyWith("a-key")
+	message := s.createAMessage("A text")
+	request := entity.NewIdempotentRequest(key, message)
+
+	_, err := s.idempotentRequestRepository.FindByKey(s.ctx, message.SenderId(), key)
+	assert.ErrorIs(t, err, repository.ErrNotFoundIdempotentRequest)
+
+	err = s.idempotentRequestRepository.Save(s.ctx, request)
+	assert.Nil(t, err)
+
+	result, err := s.idempotentRequestRepository.FindByKey(s.ctx, message.SenderId(), key)
+	assert.Nil(t, err)
+	assert.Equal(t, request.SenderId().Value(), result.SenderId().Value())
+	assert.Equal(t, request.Key().Value(), result.Key().Value())
+	assert.Equal(t, request.RoomId().Value(), result.RoomId().Value())
+	assert.Equal(t, request.MessageId().Value(), result.MessageId().Value())
+	assert.Equal(t, request.RequestHash(), result.RequestHash())
+	assert.True(t, result.Matches(message.RoomId(), message.Text(), message.ParentId()))
+	assert.Equal(t, request.CreatedAt().Value(), result.CreatedAt().Value())
+	assert.Equal(t, request.ExpiresAt().Value(), result.ExpiresAt().Value())
+
+	err = s.idempotentRequestRepository.Save(s.ctx, entity.NewIdempotentRequest(key, s.createAMessage("Another text")))
+	assert.ErrorIs(t, err, repository.ErrDuplicateIdempotentRequest)
+}
+
+func (s *IdempotentRequestPostgresRepositoryTestSuite) TestShouldReplaceAnExpiredRequest() {
+	defer postgresIdempotentRequestRepository.Clear()
+	t := s.T()
+
+	key, _ := valueobject.NewIdempotencyKeyWith("a-key")
+	message := s.createAMessage("A text")
+
+	createdAt := valueobject.NewTimestamp().Add(-2 * entity.IdempotentRequestTtl)
+	expired := entity.NewIdempotentRequestWith(
+		message.SenderId(),
+		key,
+		message.RoomId(),
+		message.Id(),
+		"",
+		createdAt,
+		createdAt.Add(entity.IdempotentRequestTtl),
+	)
+
+	err := s.idempotentRequestRepository.Save(s.ctx, expired)
+	assert.Nil(t, err)
+
+	other := s.createAMessage("Another text")
+
+	err = s.idempotentRequestRepository.Save(s.ctx, entity.NewIdempotentRequest(key, other))
+	assert.Nil(t, err)
+
+	result, err := s.idempotentRequestRepository.FindByKey(s.ctx, message.SenderId(), key)
+	assert.Nil(t, err)
+	assert.Equal(t, other.Id().Value(), result.MessageId().Value())
+	assert.False(t, result.IsExpired())
+}
+
+func (s *IdempotentRequestPostgresRepositoryTestSuite) TestShouldCleanupTheExpiredRequests() {
+	defer postgresIdempotentRequestRepository.Clear()
+	t := s.T()
+
+	expiredKey, _ := valueobject.NewIdempotencyKeyWith("an-expired-key")
+	message := s.createAMessage("A text")
+
+	createdAt := valueobject.NewTimestamp().Add(-2 * entity.IdempotentRequestTtl)
+	expired := entity.NewIdempotentRequestWith(
+		message.SenderId(),
+		expiredKey,
+		message.RoomId(),
+		message.Id(),
+		"",
+		createdAt,
+		createdAt.Add(entity.IdempotentRequestTtl),
+	)
+
+	err := s.idempotentRequestRepository.Save(s.ctx, expired)
+	assert.Nil(t, err)
+
+	key, _ := valueobject.NewIdempotencyKeyWith("a-key")
+	err = s.idempotentRequestRepository.Save(s.ctx, entity.NewIdempotentRequest(key, s.createAMessage("Another text")))
+	assert.Nil(t, err)
+
+	deleted, err := s.idempotencyKeyCleaner.Cleanup(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = s.idempotentRequestRepository.FindByKey(s.ctx, message.SenderId(), expiredKey)
+	assert.ErrorIs(t, err, repository.ErrNotFoundIdempotentRequest)
+
+	_, err = s.idempotentRequestRepository.FindByKey(s.ctx, message.SenderId(), key)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type InvitationPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewInvitationPostgresRepository(db *sql.DB) *InvitationPostgresRepository {
+	return &InvitationPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("InvitationPostgresRepository"),
+	}
+}
+
+func (r *InvitationPostgresRepository) Save(ctx context.Context, invitation *entity.Invitation) error {
+	m := model.NewInvitationModel(invitation)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO room_invitations (id, room_id, inviter_id, invitee_id, token, status, created_at, expires_at, answered_at) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.Id,
+		m.RoomId,
+		m.InviterId,
+		m.InviteeId,
+		m.Token,
+		m.Status,
+		m.CreatedAt,
+		m.ExpiresAt,
+		m.AnsweredAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *InvitationPostgresRepository) FindByToken(
+	ctx context.Context,
+	token *valueobject.InvitationToken,
+) (*entity.Invitation, error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT id, room_id, inviter_id, invitee_id, token, status, created_at, expires_at, answered_at
+		FROM room_invitations 
+		WHERE token = $1
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var m model.InvitationModel
+
+	err = stmt.QueryRowContext(ctx, token.Value()).Scan(
+		&m.Id,
+		&m.RoomId,
+		&m.InviterId,
+		&m.InviteeId,
+		&m.Token,
+		&m.Status,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+		&m.AnsweredAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFoundInvitation
+		}
+
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	invitation, err := m.ToEntity()
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (r *InvitationPostgresRepository) Update(ctx context.Context, invitation *entity.Invitation) error {
+	m := model.NewInvitationModel(invitation)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		UPDATE room_invitations 
+		SET status = $2, answered_at = $3
+		WHERE id = $1 AND status = 'pending'
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		m.Id,
+		m.Status,
+		m.AnsweredAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	if rows == 0 {
+		return repository.ErrAnsweredInvitation
+	}
+
+	return nil
+}
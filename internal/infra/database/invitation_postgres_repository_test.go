@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresInvitationRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type InvitationPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	roomRepository       repository.RoomRepository
+	invitationRepository repository.InvitationRepository
+}
+
+func (s *InvitationPostgresRepositoryTestSuite) SetupSuite() {
+	postgresInvitationRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresInvitationRepository.Host,
+		Port:     postgresInvitationRepository.Port,
+		User:     postgresInvitationRepository.User,
+		Password: postgresInvitationRepository.Password,
+		Name:     postgresInvitationRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.invitationRepository = NewInvitationPostgresRepository(db)
+}
+
+func (s *InvitationPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresInvitationRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestInvitationPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InvitationPostgresRepositoryTestSuite))
+}
+
+func (s *InvitationPostgresRepositoryTestSuite) TestShouldSaveFindAndUpdateAnInvitation() {
+	defer postgresInvitationRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Secret")
+	category, _ := valueobject.NewRoomCategoryWith("General")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(room.Id(), adminId, inviteeId)
+
+	_, err := s.invitationRepository.FindByToken(s.ctx, invitation.Token())
+	assert.ErrorIs(t, err, repository.ErrNotFoundInvitation)
+
+	err = s.invitationRepository.Save(s.ctx, invitation)
+	assert.Nil(t, err)
+
+	result, err := s.invitationRepository.FindByToken(s.ctx, invitation.Token())
+	assert.Nil(t, err)
+	assert.Equal(t, invitation.Id().Value(), result.Id().Value())
+	assert.Equal(t, invitation.RoomId().Value(), result.RoomId().Value())
+	assert.Equal(t, invitation.InviterId().Value(), result.InviterId().Value())
+	assert.Equal(t, invitation.InviteeId().Value(), result.InviteeId().Value())
+	assert.Equal(t, invitation.Token().Value(), result.Token().Value())
+	assert.Equal(t, entity.InvitationPending, result.Status())
+	assert.Equal(t, invitation.CreatedAt().Value(), result.CreatedAt().Value())
+	assert.Equal(t, invitation.ExpiresAt().Value(), result.ExpiresAt().Value())
+	assert.Nil(t, result.AnsweredAt())
+
+	_, err = invitation.Accept(inviteeId)
+	assert.Nil(t, err)
+
+	err = s.invitationRepository.Update(s.ctx, invitation)
+	assert.Nil(t, err)
+
+	result, err = s.invitationRepository.FindByToken(s.ctx, invitation.Token())
+	assert.Nil(t, err)
+	assert.Equal(t, entity.InvitationAccepted, result.Status())
+	assert.Equal(t, invitation.AnsweredAt().Value(), result.AnsweredAt().Value())
+}
+
+func (s *InvitationPostgresRepositoryTestSuite) TestShouldNotAnswerAnInvitationTwice() {
+	defer postgresInvitationRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Secret")
+	category, _ := valueobject.NewRoomCategoryWith("General")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(room.Id(), adminId, inviteeId)
+
+	err := s.invitationRepository.Save(s.ctx, invitation)
+	assert.Nil(t, err)
+
+	accepted, _ := s.invitationRepository.FindByToken(s.ctx, invitation.Token())
+	declined, _ := s.invitationRepository.FindByToken(s.ctx, invitation.Token())
+
+	_, err = accepted.Accept(inviteeId)
+	assert.Nil(t, err)
+
+	err = declined.Decline(inviteeId)
+	assert.Nil(t, err)
+
+	err = s.invitationRepository.Update(s.ctx, accepted)
+	assert.Nil(t, err)
+
+	err = s.invitationRepository.Update(s.ctx, declined)
+	assert.ErrorIs(t, err, repository.ErrAnsweredInvitation)
+
+	result, err := s.invitationRepository.FindByToken(s.ctx, invitation.Token())
+	assert.Nil(t, err)
+	assert.Equal(t, entity.InvitationAccepted, result.Status())
+}
@@ -0,0 +1,342 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type MemberPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewMemberPostgresRepository(db *sql.DB) *MemberPostgresRepository {
+	return &MemberPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("MemberPostgresRepository"),
+	}
+}
+
+func (r *MemberPostgresRepository) Save(ctx context.Context, member *entity.Member) error {
+	m := model.NewMemberModel(member)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO room_members (room_id, user_id, role, joined_at) 
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (room_id, user_id) DO NOTHING
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.RoomId,
+		m.UserId,
+		m.Role,
+		m.JoinedAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *MemberPostgresRepository) FindByRoomAndUser(
+	ctx context.Context,
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) (*entity.Member, error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT room_id, user_id, role, joined_at, last_read_message_id 
+		FROM room_members 
+		WHERE room_id = $1 AND user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var m model.MemberModel
+
+	err = stmt.QueryRowContext(ctx, roomId.Value(), userId.Value()).Scan(
+		&m.RoomId,
+		&m.UserId,
+		&m.Role,
+		&m.JoinedAt,
+		&m.LastReadMessageId,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFoundMember
+		}
+
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	member, err := m.ToEntity()
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (r *MemberPostgresRepository) ListByRoom(
+	ctx context.Context,
+	roomId *valueobject.Id,
+	query *pagination.Query,
+) (*pagination.Page[*entity.Member], error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT room_id, user_id, role, joined_at, last_read_message_id, COUNT(*) OVER () AS total
+		FROM room_members 
+		WHERE room_id = $1 AND ($2 = '' OR UPPER(user_id) LIKE '%' || $2 || '%')
+		ORDER BY joined_at `+query.Sort()+`, user_id `+query.Sort()+`
+		LIMIT $3 
+		OFFSET $4
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, roomId.Value(), query.Search(), query.Size(), query.Size()*query.Page())
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entity.Member
+	var total int64
+
+	for rows.Next() {
+		var m model.MemberModel
+
+		err := rows.Scan(
+			&m.RoomId,
+			&m.UserId,
+			&m.Role,
+			&m.JoinedAt,
+			&m.LastReadMessageId,
+			&total,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		member, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		items = append(items, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	page := pagination.NewPage[*entity.Member](query.Page(), query.Size(), total, items)
+	return page, nil
+}
+
+func (r *MemberPostgresRepository) ListReaders(
+	ctx context.Context,
+	message *entity.Message,
+	query *pagination.Query,
+) (*pagination.Page[*entity.Member], error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT rm.room_id, rm.user_id, rm.role, rm.joined_at, rm.last_read_message_id, COUNT(*) OVER () AS total
+		FROM room_members rm
+		JOIN messages lr ON lr.id = rm.last_read_message_id
+		JOIN messages m ON m.id = $2
+		WHERE rm.room_id = $1 AND rm.user_id <> m.sender_id 
+			AND (lr.created_at, lr.id) >= (m.created_at, m.id)
+		ORDER BY rm.joined_at `+query.Sort()+`, rm.user_id `+query.Sort()+`
+		LIMIT $3 
+		OFFSET $4
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, message.RoomId().Value(), message.Id().Value(), query.Size(), query.Size()*query.Page())
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entity.Member
+	var total int64
+
+	for rows.Next() {
+		var m model.MemberModel
+
+		err := rows.Scan(
+			&m.RoomId,
+			&m.UserId,
+			&m.Role,
+			&m.JoinedAt,
+			&m.LastReadMessageId,
+			&total,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		member, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		items = append(items, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	page := pagination.NewPage[*entity.Member](query.Page(), query.Size(), total, items)
+	return page, nil
+}
+
+func (r *MemberPostgresRepository) CountByRoom(ctx context.Context, roomId *valueobject.Id) (int64, error) {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT COUNT(*) 
+		FROM room_members 
+		WHERE room_id = $1
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+	defer stmt.Close()
+
+	var total int64
+
+	err = stmt.QueryRowContext(ctx, roomId.Value()).Scan(&total)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *MemberPostgresRepository) Update(ctx context.Context, member *entity.Member) error {
+	m := model.NewMemberModel(member)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		UPDATE room_members 
+		SET role = $3
+		WHERE room_id = $1 AND user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.RoomId,
+		m.UserId,
+		m.Role,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *MemberPostgresRepository) UpdateLastRead(ctx context.Context, member *entity.Member) (bool, error) {
+	m := model.NewMemberModel(member)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		UPDATE room_members 
+		SET last_read_message_id = n.id
+		FROM messages n
+		WHERE room_members.room_id = $1 AND room_members.user_id = $2 
+			AND n.id = $3 AND n.room_id = room_members.room_id
+			AND NOT EXISTS (
+				SELECT 1 
+				FROM messages o 
+				WHERE o.id = room_members.last_read_message_id AND (o.created_at, o.id) >= (n.created_at, n.id)
+			)
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return false, err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		m.RoomId,
+		m.UserId,
+		m.LastReadMessageId,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *MemberPostgresRepository) Delete(ctx context.Context, member *entity.Member) error {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		DELETE FROM room_members 
+		WHERE room_id = $1 AND user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, member.RoomId().Value(), member.UserId().Value())
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
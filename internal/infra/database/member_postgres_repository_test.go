@@ -0,0 +1,227 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresMemberRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type MemberPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	roomRepository    repository.RoomRepository
+	memberRepository  repository.MemberRepository
+	messageRepository repository.MessageRepository
+}
+
+func (s *MemberPostgresRepositoryTestSuite) SetupSuite() {
+	postgresMemberRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresMemberRepository.Host,
+		Port:     postgresMemberRepository.Port,
+		User:     postgresMemberRepository.User,
+		Password: postgresMemberRepository.Password,
+		Name:     postgresMemberRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.memberRepository = NewMemberPostgresRepository(db)
+	s.messageRepository = NewMessagePostgresRepository(db)
+}
+
+func (s *MemberPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresMemberRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestMemberPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemberPostgresRepositoryTestSuite))
+}
+
+func (s *MemberPostgresRepositoryTestSuite) createARoom() *entity.Room {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	return room
+}
+
+func (s *MemberPostgresRepositoryTestSuite) TestShouldSaveFindUpdateAndDeleteAMember() {
+	defer postgresMemberRepository.Clear()
+	t := s.T()
+
+	room := s.createARoom()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+	member := entity.NewMember(room.Id(), userId)
+
+	_, err := s.memberRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMember)
+
+	err = s.memberRepository.Save(s.ctx, member)
+	assert.Nil(t, err)
+
+	err = s.memberRepository.Save(s.ctx, member)
+	assert.Nil(t, err)
+
+	memberSaved, err := s.memberRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Equal(t, member.RoomId().Value(), memberSaved.RoomId().Value())
+	assert.Equal(t, member.UserId().Value(), memberSaved.UserId().Value())
+	assert.Equal(t, member.Role().Value(), memberSaved.Role().Value())
+	assert.Equal(t, member.JoinedAt().Value(), memberSaved.JoinedAt().Value())
+
+	err = member.Promote()
+	assert.Nil(t, err)
+
+	err = s.memberRepository.Update(s.ctx, member)
+	assert.Nil(t, err)
+
+	memberSaved, err = s.memberRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Equal(t, valueobject.MemberRoleModerator, memberSaved.Role().Value())
+
+	err = s.memberRepository.Delete(s.ctx, member)
+	assert.Nil(t, err)
+
+	_, err = s.memberRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMember)
+}
+
+func (s *MemberPostgresRepositoryTestSuite) TestShouldListTheMembersOfARoom() {
+	defer postgresMemberRepository.Clear()
+	t := s.T()
+
+	room := s.createARoom()
+	otherRoom := s.createARoom()
+
+	userIds := []string{
+		"auth0|64c8457bb160e37c8c34533d",
+		"auth0|64c8457bb160e37c8c34533e",
+		"auth0|64c8457bb160e37c8c34533f",
+	}
+
+	for _, id := range userIds {
+		userId, _ := valueobject.NewUserIdWith(id)
+		s.memberRepository.Save(s.ctx, entity.NewMember(room.Id(), userId))
+		s.memberRepository.Save(s.ctx, entity.NewMember(otherRoom.Id(), userId))
+	}
+
+	query, _ := pagination.NewQuery("0", "2", "asc", "")
+	page, err := s.memberRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), page.Total)
+	assert.Equal(t, 2, len(page.Items))
+	assert.Equal(t, userIds[0], page.Items[0].UserId().Value())
+	assert.Equal(t, userIds[1], page.Items[1].UserId().Value())
+
+	query, _ = pagination.NewQuery("0", "10", "desc", "533F")
+	page, err = s.memberRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, userIds[2], page.Items[0].UserId().Value())
+}
+
+func (s *MemberPostgresRepositoryTestSuite) TestShouldKeepTheNewestLastReadMessage() {
+	defer postgresMemberRepository.Clear()
+	t := s.T()
+
+	room := s.createARoom()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	member := entity.NewMember(room.Id(), userId)
+	s.memberRepository.Save(s.ctx, member)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	older := entity.NewMessage(room.Id(), room.AdminId(), senderName, text)
+	s.messageRepository.Save(s.ctx, older)
+
+	newer := entity.NewMessageWith(
+		valueobject.NewId(),
+		room.Id(),
+		room.AdminId(),
+		senderName,
+		text,
+		older.CreatedAt().Add(time.Second),
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+	)
+	s.messageRepository.Save(s.ctx, newer)
+
+	member.MarkAsRead(newer.Id())
+	changed, err := s.memberRepository.UpdateLastRead(s.ctx, member)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+
+	changed, err = s.memberRepository.UpdateLastRead(s.ctx, member)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	member.MarkAsRead(older.Id())
+	changed, err = s.memberRepository.UpdateLastRead(s.ctx, member)
+	assert.Nil(t, err)
+	assert.False(t, changed)
+
+	result, err := s.memberRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Equal(t, newer.Id().Value(), result.LastReadMessageId().Value())
+}
+
+func (s *MemberPostgresRepositoryTestSuite) TestShouldListTheReadersOfAMessage() {
+	defer postgresMemberRepository.Clear()
+	t := s.T()
+
+	room := s.createARoom()
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	readerId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	reader := entity.NewMember(room.Id(), readerId)
+	s.memberRepository.Save(s.ctx, reader)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+	s.memberRepository.Save(s.ctx, entity.NewMember(room.Id(), userId))
+
+	total, err := s.memberRepository.CountByRoom(s.ctx, room.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), total)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), room.AdminId(), senderName, text)
+	s.messageRepository.Save(s.ctx, message)
+
+	query, _ := pagination.NewQuery("0", "10", "", "")
+
+	page, err := s.memberRepository.ListReaders(s.ctx, message, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), page.Total)
+
+	reader.MarkAsRead(message.Id())
+	s.memberRepository.UpdateLastRead(s.ctx, reader)
+
+	page, err = s.memberRepository.ListReaders(s.ctx, message, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, readerId.Value(), page.Items[0].UserId().Value())
+}
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	infra_event "github.com/sesaquecruz/go-chat-api/internal/infra/event"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+// MessageEventOutboxGateway stores sent events in the outbox table, in the
+// transaction of the context when there is one, to be published later by the
+// OutboxRelay. Events are received straight from the broker.
+type MessageEventOutboxGateway struct {
+	db     *sql.DB
+	broker *infra_event.MessageEventRabbitMqGateway
+	logger *log.Logger
+}
+
+func NewMessageEventOutboxGateway(db *sql.DB, broker *infra_event.MessageEventRabbitMqGateway) *MessageEventOutboxGateway {
+	return &MessageEventOutboxGateway{
+		db:     db,
+		broker: broker,
+		logger: log.NewLogger("MessageEventOutboxGateway"),
+	}
+}
+
+func (g *MessageEventOutboxGateway) Send(ctx context.Context, messageEvent *event.MessageEvent) error {
+	payload, err := json.Marshal(messageEvent)
+	if err != nil {
+		g.logger.Error(err)
+		return err
+	}
+
+	stmt, err := executorFrom(ctx, g.db).PrepareContext(ctx, `
+		INSERT INTO outbox (id, payload, created_at, next_attempt_at)
+		VALUES ($1, $2, NOW(), NOW())
+	`)
+	if err != nil {
+		g.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, valueobject.NewId().Value(), payload)
+	if err != nil {
+		g.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (g *MessageEventOutboxGateway) Receive(
+	ctx context.Context,
+	roomId string,
+	messageEvents chan<- *event.MessageEvent,
+	ready func(),
+) error {
+
+	return g.broker.Receive(ctx, roomId, messageEvents, ready)
+}
@@ -6,12 +6,36 @@ import (
 	"errors"
 
 	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
 	"github.com/sesaquecruz/go-chat-api/pkg/log"
 )
 
+// messageSelect selects the messages with the reply count and the last reply
+// of their threads, and the count of each emoji they were reacted with in the
+// order the emojis were first used.
+const messageSelect = `
+	SELECT m.id, m.room_id, m.sender_id, m.sender_name, m.text, m.created_at, m.edited_at, m.deleted_at, m.parent_id,
+		t.reply_count, t.last_reply_at, e.reactions
+	FROM messages m
+	CROSS JOIN LATERAL (
+		SELECT count(*) AS reply_count, max(r.created_at) AS last_reply_at
+		FROM messages r
+		WHERE r.parent_id = m.id
+	) t
+	CROSS JOIN LATERAL (
+		SELECT coalesce(json_agg(json_build_object('emoji', g.emoji, 'count', g.count) ORDER BY g.first_at, g.emoji), '[]') AS reactions
+		FROM (
+			SELECT emoji, count(*) AS count, min(created_at) AS first_at
+			FROM message_reactions
+			WHERE message_id = m.id
+			GROUP BY emoji
+		) g
+	) e
+`
+
 type MessagePostgresRepository struct {
 	db     *sql.DB
 	logger *log.Logger
@@ -27,9 +51,9 @@ func NewMessagePostgresRepository(db *sql.DB) *MessagePostgresRepository {
 func (r *MessagePostgresRepository) Save(ctx context.Context, message *entity.Message) error {
 	m := model.NewMessageModel(message)
 
-	stmt, err := r.db.PrepareContext(ctx, `
-		INSERT INTO messages (id, room_id, sender_id, sender_name, text, created_at) 
-		VALUES ($1, $2, $3, $4, $5, $6)
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO messages (id, room_id, sender_id, sender_name, text, created_at, edited_at, deleted_at, parent_id) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`)
 	if err != nil {
 		r.logger.Error(err)
@@ -45,6 +69,9 @@ func (r *MessagePostgresRepository) Save(ctx context.Context, message *entity.Me
 		m.SenderName,
 		m.Text,
 		m.CreatedAt,
+		m.EditedAt,
+		m.DeletedAt,
+		m.ParentId,
 	)
 	if err != nil {
 		r.logger.Error(err)
@@ -55,10 +82,8 @@ func (r *MessagePostgresRepository) Save(ctx context.Context, message *entity.Me
 }
 
 func (r *MessagePostgresRepository) FindById(ctx context.Context, id *valueobject.Id) (*entity.Message, error) {
-	stmt, err := r.db.PrepareContext(ctx, `
-		SELECT id, room_id, sender_id, sender_name, text, created_at 
-		FROM messages 
-		WHERE id = $1
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, messageSelect+`
+		WHERE m.id = $1
 	`)
 	if err != nil {
 		r.logger.Error(err)
@@ -75,6 +100,12 @@ func (r *MessagePostgresRepository) FindById(ctx context.Context, id *valueobjec
 		&m.SenderName,
 		&m.Text,
 		&m.CreatedAt,
+		&m.EditedAt,
+		&m.DeletedAt,
+		&m.ParentId,
+		&m.ReplyCount,
+		&m.LastReplyAt,
+		&m.Reactions,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -93,3 +124,150 @@ func (r *MessagePostgresRepository) FindById(ctx context.Context, id *valueobjec
 
 	return message, nil
 }
+
+func (r *MessagePostgresRepository) Update(ctx context.Context, message *entity.Message) error {
+	m := model.NewMessageModel(message)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		UPDATE messages 
+		SET room_id = $2, sender_id = $3, sender_name = $4, text = $5, created_at = $6, edited_at = $7, deleted_at = $8
+		WHERE id = $1
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.Id,
+		m.RoomId,
+		m.SenderId,
+		m.SenderName,
+		m.Text,
+		m.CreatedAt,
+		m.EditedAt,
+		m.DeletedAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *MessagePostgresRepository) ListByRoom(
+	ctx context.Context,
+	roomId *valueobject.Id,
+	query *pagination.CursorQuery,
+) (*pagination.CursorPage[*entity.Message], error) {
+
+	return r.list(ctx, "m.room_id", roomId, query)
+}
+
+func (r *MessagePostgresRepository) ListReplies(
+	ctx context.Context,
+	parentId *valueobject.Id,
+	query *pagination.CursorQuery,
+) (*pagination.CursorPage[*entity.Message], error) {
+
+	return r.list(ctx, "m.parent_id", parentId, query)
+}
+
+// list pages the messages with the column equal to the id, ordered from the
+// newest to the oldest.
+func (r *MessagePostgresRepository) list(
+	ctx context.Context,
+	column string,
+	id *valueobject.Id,
+	query *pagination.CursorQuery,
+) (*pagination.CursorPage[*entity.Message], error) {
+
+	comparison, order := "<", "DESC"
+	if query.Direction() == pagination.DirectionAfter {
+		comparison, order = ">", "ASC"
+	}
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, messageSelect+`
+		WHERE `+column+` = $1 AND ($2::timestamptz IS NULL OR (m.created_at, m.id) `+comparison+` ($2::timestamptz, $3::varchar))
+		ORDER BY m.created_at `+order+`, m.id `+order+`
+		LIMIT $4
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var cursorCreatedAt, cursorId *string
+
+	if cursor := query.Cursor(); cursor != nil {
+		c, i := cursor.CreatedAt().Value(), cursor.Id().Value()
+		cursorCreatedAt, cursorId = &c, &i
+	}
+
+	rows, err := stmt.QueryContext(ctx, id.Value(), cursorCreatedAt, cursorId, query.Size()+1)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entity.Message
+
+	for rows.Next() {
+		var m model.MessageModel
+
+		err := rows.Scan(
+			&m.Id,
+			&m.RoomId,
+			&m.SenderId,
+			&m.SenderName,
+			&m.Text,
+			&m.CreatedAt,
+			&m.EditedAt,
+			&m.DeletedAt,
+			&m.ParentId,
+			&m.ReplyCount,
+			&m.LastReplyAt,
+			&m.Reactions,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		message, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		items = append(items, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	more := len(items) > query.Size()
+	if more {
+		items = items[:query.Size()]
+	}
+
+	if query.Direction() == pagination.DirectionAfter {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	cursorOf := func(m *entity.Message) *pagination.Cursor {
+		return pagination.NewCursor(m.CreatedAt(), m.Id())
+	}
+
+	page := pagination.NewCursorPage[*entity.Message](query, items, more, cursorOf)
+	return page, nil
+}
@@ -2,10 +2,12 @@ package database
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/sesaquecruz/go-chat-api/config"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/test/services"
@@ -18,9 +20,10 @@ var postgresMessageRepository, _ = services.NewPostgresContainer(context.Backgro
 
 type MessagePostgresRepositoryTestSuite struct {
 	suite.Suite
-	ctx               context.Context
-	roomRepository    repository.RoomRepository
-	messageRepository repository.MessageRepository
+	ctx                       context.Context
+	roomRepository            repository.RoomRepository
+	messageRepository         repository.MessageRepository
+	messageRevisionRepository repository.MessageRevisionRepository
 }
 
 func (s *MessagePostgresRepositoryTestSuite) SetupSuite() {
@@ -37,6 +40,7 @@ func (s *MessagePostgresRepositoryTestSuite) SetupSuite() {
 	s.ctx = context.Background()
 	s.roomRepository = NewRoomPostgresRepository(db)
 	s.messageRepository = NewMessagePostgresRepository(db)
+	s.messageRevisionRepository = NewMessageRevisionPostgresRepository(db)
 }
 
 func (s *MessagePostgresRepositoryTestSuite) TearDownSuite() {
@@ -78,4 +82,171 @@ func (s *MessagePostgresRepositoryTestSuite) TestShouldSaveAndFindAMessage() {
 	assert.Equal(t, message.SenderName().Value(), result.SenderName().Value())
 	assert.Equal(t, message.Text().Value(), result.Text().Value())
 	assert.Equal(t, message.CreatedAt().Value(), result.CreatedAt().Value())
+	assert.Nil(t, result.EditedAt())
+}
+
+func (s *MessagePostgresRepositoryTestSuite) TestShouldEditAndDeleteAMessage() {
+	defer postgresMessageRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+	s.messageRepository.Save(s.ctx, message)
+
+	for i := 1; i <= 2; i++ {
+		newText, _ := valueobject.NewMessageTextWith(fmt.Sprintf("An edited text %d", i))
+		revision := message.Edit(newText)
+
+		err := s.messageRepository.Update(s.ctx, message)
+		assert.Nil(t, err)
+
+		err = s.messageRevisionRepository.Save(s.ctx, revision)
+		assert.Nil(t, err)
+	}
+
+	result, err := s.messageRepository.FindById(s.ctx, message.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, "An edited text 2", result.Text().Value())
+	assert.Equal(t, message.EditedAt().Value(), result.EditedAt().Value())
+	assert.Nil(t, result.DeletedAt())
+
+	message.Delete()
+
+	err = s.messageRepository.Update(s.ctx, message)
+	assert.Nil(t, err)
+
+	result, err = s.messageRepository.FindById(s.ctx, message.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, message.DeletedAt().Value(), result.DeletedAt().Value())
+
+	revisions, err := s.messageRevisionRepository.ListByMessage(s.ctx, message.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(revisions))
+	assert.Equal(t, "A text", revisions[0].Text().Value())
+	assert.Equal(t, "An edited text 1", revisions[1].Text().Value())
+}
+
+func (s *MessagePostgresRepositoryTestSuite) TestShouldListMessagesOfARoomByCursor() {
+	defer postgresMessageRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	total := 5
+	messages := make([]*entity.Message, total)
+
+	for i := 0; i < total; i++ {
+		senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+		senderName, _ := valueobject.NewUserNameWith("An username")
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("A text %d", i))
+		messages[i] = entity.NewMessage(room.Id(), senderId, senderName, text)
+		s.messageRepository.Save(s.ctx, messages[i])
+	}
+
+	query, _ := pagination.NewCursorQuery("", "2", "before")
+	page, err := s.messageRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page.Items))
+	assert.Equal(t, messages[4].Id().Value(), page.Items[0].Id().Value())
+	assert.Equal(t, messages[3].Id().Value(), page.Items[1].Id().Value())
+	assert.NotEmpty(t, page.Before)
+	assert.NotEmpty(t, page.After)
+
+	query, _ = pagination.NewCursorQuery(page.Before, "2", "before")
+	page, err = s.messageRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page.Items))
+	assert.Equal(t, messages[2].Id().Value(), page.Items[0].Id().Value())
+	assert.Equal(t, messages[1].Id().Value(), page.Items[1].Id().Value())
+
+	query, _ = pagination.NewCursorQuery(page.Before, "2", "before")
+	page, err = s.messageRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Items))
+	assert.Equal(t, messages[0].Id().Value(), page.Items[0].Id().Value())
+	assert.Empty(t, page.Before)
+
+	query, _ = pagination.NewCursorQuery(page.After, "3", "after")
+	page, err = s.messageRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(page.Items))
+	assert.Equal(t, messages[3].Id().Value(), page.Items[0].Id().Value())
+	assert.Equal(t, messages[1].Id().Value(), page.Items[2].Id().Value())
+	assert.Equal(t, pagination.NewCursor(messages[3].CreatedAt(), messages[3].Id()).Value(), page.After)
+	assert.True(t, page.More)
+
+	query, _ = pagination.NewCursorQuery(page.After, "3", "after")
+	page, err = s.messageRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Items))
+	assert.Equal(t, messages[4].Id().Value(), page.Items[0].Id().Value())
+	assert.False(t, page.More)
+}
+
+func (s *MessagePostgresRepositoryTestSuite) TestShouldListTheRepliesOfAMessage() {
+	defer postgresMessageRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	parent := entity.NewMessage(room.Id(), adminId, senderName, text)
+	s.messageRepository.Save(s.ctx, parent)
+
+	total := 3
+	replies := make([]*entity.Message, total)
+
+	for i := 0; i < total; i++ {
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("A reply %d", i))
+		replies[i] = entity.NewMessage(room.Id(), adminId, senderName, text)
+		replies[i].ReplyTo(parent)
+		s.messageRepository.Save(s.ctx, replies[i])
+	}
+
+	message, err := s.messageRepository.FindById(s.ctx, parent.Id())
+	assert.Nil(t, err)
+	assert.Nil(t, message.ParentId())
+	assert.Equal(t, total, message.ReplyCount())
+	assert.Equal(t, replies[2].CreatedAt().Value(), message.LastReplyAt().Value())
+
+	message, err = s.messageRepository.FindById(s.ctx, replies[0].Id())
+	assert.Nil(t, err)
+	assert.Equal(t, parent.Id().Value(), message.ParentId().Value())
+	assert.Equal(t, 0, message.ReplyCount())
+	assert.Nil(t, message.LastReplyAt())
+
+	query, _ := pagination.NewCursorQuery("", "2", "before")
+	page, err := s.messageRepository.ListReplies(s.ctx, parent.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page.Items))
+	assert.Equal(t, replies[2].Id().Value(), page.Items[0].Id().Value())
+	assert.Equal(t, replies[1].Id().Value(), page.Items[1].Id().Value())
+
+	query, _ = pagination.NewCursorQuery(page.Before, "2", "before")
+	page, err = s.messageRepository.ListReplies(s.ctx, parent.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Items))
+	assert.Equal(t, replies[0].Id().Value(), page.Items[0].Id().Value())
+	assert.Empty(t, page.Before)
+
+	query, _ = pagination.NewCursorQuery("", "10", "before")
+	page, err = s.messageRepository.ListByRoom(s.ctx, room.Id(), query)
+	assert.Nil(t, err)
+	assert.Equal(t, total+1, len(page.Items))
 }
@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type MessageRevisionPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewMessageRevisionPostgresRepository(db *sql.DB) *MessageRevisionPostgresRepository {
+	return &MessageRevisionPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("MessageRevisionPostgresRepository"),
+	}
+}
+
+func (r *MessageRevisionPostgresRepository) Save(ctx context.Context, revision *entity.MessageRevision) error {
+	m := model.NewMessageRevisionModel(revision)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO message_revisions (id, message_id, text, created_at) 
+		VALUES ($1, $2, $3, $4)
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.Id,
+		m.MessageId,
+		m.Text,
+		m.CreatedAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *MessageRevisionPostgresRepository) ListByMessage(
+	ctx context.Context,
+	messageId *valueobject.Id,
+) ([]*entity.MessageRevision, error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT id, message_id, text, created_at
+		FROM message_revisions
+		WHERE message_id = $1
+		ORDER BY created_at, id
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, messageId.Value())
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*entity.MessageRevision
+
+	for rows.Next() {
+		var m model.MessageRevisionModel
+
+		err := rows.Scan(
+			&m.Id,
+			&m.MessageId,
+			&m.Text,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		revision, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type BanModel struct {
+	RoomId    string
+	UserId    string
+	BannedBy  string
+	CreatedAt string
+	ExpiresAt *string
+}
+
+func NewBanModel(ban *entity.Ban) *BanModel {
+	model := BanModel{}
+
+	model.RoomId = ban.RoomId().Value()
+	model.UserId = ban.UserId().Value()
+	model.BannedBy = ban.BannedBy().Value()
+	model.CreatedAt = ban.CreatedAt().Value()
+
+	if ban.ExpiresAt() != nil {
+		expiresAt := ban.ExpiresAt().Value()
+		model.ExpiresAt = &expiresAt
+	}
+
+	return &model
+}
+
+func (m *BanModel) ToEntity() (*entity.Ban, error) {
+	roomId, err := valueobject.NewIdWith(m.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(m.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	bannedBy, err := valueobject.NewUserIdWith(m.BannedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := valueobject.NewTimestampWith(m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *valueobject.Timestamp = nil
+
+	if m.ExpiresAt != nil {
+		expiresAt, err = valueobject.NewTimestampWith(*m.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ban := entity.NewBanWith(roomId, userId, bannedBy, createdAt, expiresAt)
+
+	return ban, nil
+}
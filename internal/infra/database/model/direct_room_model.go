@@ -0,0 +1,70 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type DirectRoomModel struct {
+	Room                  RoomModel
+	FirstUserId           string
+	SecondUserId          string
+	LastMessageId         *string
+	LastMessageSenderId   *string
+	LastMessageSenderName *string
+	LastMessageText       *string
+	LastMessageCreatedAt  *string
+	LastMessageEditedAt   *string
+	LastMessageDeletedAt  *string
+}
+
+func NewDirectRoomModel(directRoom *entity.DirectRoom) *DirectRoomModel {
+	model := DirectRoomModel{}
+
+	model.Room = *NewRoomModel(directRoom.Room())
+	model.FirstUserId = directRoom.FirstUserId().Value()
+	model.SecondUserId = directRoom.SecondUserId().Value()
+
+	return &model
+}
+
+func (m *DirectRoomModel) ToEntity() (*entity.DirectRoom, error) {
+	room, err := m.Room.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	firstUserId, err := valueobject.NewUserIdWith(m.FirstUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	secondUserId, err := valueobject.NewUserIdWith(m.SecondUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastMessage *entity.Message = nil
+
+	if m.LastMessageId != nil {
+		messageModel := MessageModel{
+			Id:         *m.LastMessageId,
+			RoomId:     m.Room.Id,
+			SenderId:   *m.LastMessageSenderId,
+			SenderName: *m.LastMessageSenderName,
+			Text:       *m.LastMessageText,
+			CreatedAt:  *m.LastMessageCreatedAt,
+			EditedAt:   m.LastMessageEditedAt,
+			DeletedAt:  m.LastMessageDeletedAt,
+		}
+
+		lastMessage, err = messageModel.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	directRoom := entity.NewDirectRoomWith(room, firstUserId, secondUserId, lastMessage)
+
+	return directRoom, nil
+}
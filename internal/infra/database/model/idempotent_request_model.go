@@ -0,0 +1,66 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type IdempotentRequestModel struct {
+	SenderId    string
+	Key         string
+	RoomId      string
+	MessageId   string
+	RequestHash string
+	CreatedAt   string
+	ExpiresAt   string
+}
+
+func NewIdempotentRequestModel(request *entity.IdempotentRequest) *IdempotentRequestModel {
+	model := IdempotentRequestModel{}
+
+	model.SenderId = request.SenderId().Value()
+	model.Key = request.Key().Value()
+	model.RoomId = request.RoomId().Value()
+	model.MessageId = request.MessageId().Value()
+	model.RequestHash = request.RequestHash()
+	model.CreatedAt = request.CreatedAt().Value()
+	model.ExpiresAt = request.ExpiresAt().Value()
+
+	return &model
+}
+
+func (m *IdempotentRequestModel) ToEntity() (*entity.IdempotentRequest, error) {
+	senderId, err := valueobject.NewUserIdWith(m.SenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := valueobject.NewIdempotencyKeyWith(m.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	roomId, err := valueobject.NewIdWith(m.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(m.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := valueobject.NewTimestampWith(m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := valueobject.NewTimestampWith(m.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	request := entity.NewIdempotentRequestWith(senderId, key, roomId, messageId, m.RequestHash, createdAt, expiresAt)
+
+	return request, nil
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type InvitationModel struct {
+	Id         string
+	RoomId     string
+	InviterId  string
+	InviteeId  string
+	Token      string
+	Status     string
+	CreatedAt  string
+	ExpiresAt  string
+	AnsweredAt *string
+}
+
+func NewInvitationModel(invitation *entity.Invitation) *InvitationModel {
+	model := InvitationModel{}
+
+	model.Id = invitation.Id().Value()
+	model.RoomId = invitation.RoomId().Value()
+	model.InviterId = invitation.InviterId().Value()
+	model.InviteeId = invitation.InviteeId().Value()
+	model.Token = invitation.Token().Value()
+	model.Status = invitation.Status()
+	model.CreatedAt = invitation.CreatedAt().Value()
+	model.ExpiresAt = invitation.ExpiresAt().Value()
+
+	if invitation.AnsweredAt() != nil {
+		answeredAt := invitation.AnsweredAt().Value()
+		model.AnsweredAt = &answeredAt
+	}
+
+	return &model
+}
+
+func (m *InvitationModel) ToEntity() (*entity.Invitation, error) {
+	id, err := valueobject.NewIdWith(m.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	roomId, err := valueobject.NewIdWith(m.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	inviterId, err := valueobject.NewUserIdWith(m.InviterId)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteeId, err := valueobject.NewUserIdWith(m.InviteeId)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := valueobject.NewInvitationTokenWith(m.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := valueobject.NewTimestampWith(m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := valueobject.NewTimestampWith(m.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var answeredAt *valueobject.Timestamp = nil
+
+	if m.AnsweredAt != nil {
+		answeredAt, err = valueobject.NewTimestampWith(*m.AnsweredAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	invitation := entity.NewInvitationWith(id, roomId, inviterId, inviteeId, token, m.Status, createdAt, expiresAt, answeredAt)
+
+	return invitation, nil
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type MemberModel struct {
+	RoomId   string
+	UserId   string
+	Role     string
+	JoinedAt string
+
+	LastReadMessageId *string
+}
+
+func NewMemberModel(member *entity.Member) *MemberModel {
+	model := MemberModel{}
+
+	model.RoomId = member.RoomId().Value()
+	model.UserId = member.UserId().Value()
+	model.Role = member.Role().Value()
+	model.JoinedAt = member.JoinedAt().Value()
+
+	if member.LastReadMessageId() != nil {
+		lastReadMessageId := member.LastReadMessageId().Value()
+		model.LastReadMessageId = &lastReadMessageId
+	}
+
+	return &model
+}
+
+func (m *MemberModel) ToEntity() (*entity.Member, error) {
+	roomId, err := valueobject.NewIdWith(m.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(m.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := valueobject.NewMemberRoleWith(m.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	joinedAt, err := valueobject.NewTimestampWith(m.JoinedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastReadMessageId *valueobject.Id = nil
+
+	if m.LastReadMessageId != nil {
+		lastReadMessageId, err = valueobject.NewIdWith(*m.LastReadMessageId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	member := entity.NewMemberWith(roomId, userId, role, joinedAt, lastReadMessageId)
+
+	return member, nil
+}
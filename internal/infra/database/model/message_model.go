@@ -12,6 +12,13 @@ type MessageModel struct {
 	SenderName string
 	Text       string
 	CreatedAt  string
+	EditedAt   *string
+	DeletedAt  *string
+	ParentId   *string
+
+	ReplyCount  int
+	LastReplyAt *string
+	Reactions   ReactionCountModels
 }
 
 func NewMessageModel(message *entity.Message) *MessageModel {
@@ -24,6 +31,35 @@ func NewMessageModel(message *entity.Message) *MessageModel {
 	model.Text = message.Text().Value()
 	model.CreatedAt = message.CreatedAt().Value()
 
+	if message.EditedAt() != nil {
+		editedAt := message.EditedAt().Value()
+		model.EditedAt = &editedAt
+	}
+
+	if message.DeletedAt() != nil {
+		deletedAt := message.DeletedAt().Value()
+		model.DeletedAt = &deletedAt
+	}
+
+	if message.ParentId() != nil {
+		parentId := message.ParentId().Value()
+		model.ParentId = &parentId
+	}
+
+	model.ReplyCount = message.ReplyCount()
+
+	if message.LastReplyAt() != nil {
+		lastReplyAt := message.LastReplyAt().Value()
+		model.LastReplyAt = &lastReplyAt
+	}
+
+	for _, r := range message.Reactions() {
+		model.Reactions = append(model.Reactions, ReactionCountModel{
+			Emoji: r.Emoji().Value(),
+			Count: r.Count(),
+		})
+	}
+
 	return &model
 }
 
@@ -58,7 +94,61 @@ func (m *MessageModel) ToEntity() (*entity.Message, error) {
 		return nil, err
 	}
 
-	message := entity.NewMessageWith(id, roomId, senderId, senderName, text, createdAt)
+	var editedAt *valueobject.Timestamp
+
+	if m.EditedAt != nil {
+		editedAt, err = valueobject.NewTimestampWith(*m.EditedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var deletedAt *valueobject.Timestamp
+
+	if m.DeletedAt != nil {
+		deletedAt, err = valueobject.NewTimestampWith(*m.DeletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var parentId *valueobject.Id
+
+	if m.ParentId != nil {
+		parentId, err = valueobject.NewIdWith(*m.ParentId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var lastReplyAt *valueobject.Timestamp
+
+	if m.LastReplyAt != nil {
+		lastReplyAt, err = valueobject.NewTimestampWith(*m.LastReplyAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reactions, err := m.Reactions.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	message := entity.NewMessageWith(
+		id,
+		roomId,
+		senderId,
+		senderName,
+		text,
+		createdAt,
+		editedAt,
+		deletedAt,
+		parentId,
+		m.ReplyCount,
+		lastReplyAt,
+		reactions,
+	)
 
 	return message, nil
 }
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type MessageRevisionModel struct {
+	Id        string
+	MessageId string
+	Text      string
+	CreatedAt string
+}
+
+func NewMessageRevisionModel(revision *entity.MessageRevision) *MessageRevisionModel {
+	model := MessageRevisionModel{}
+
+	model.Id = revision.Id().Value()
+	model.MessageId = revision.MessageId().Value()
+	model.Text = revision.Text().Value()
+	model.CreatedAt = revision.CreatedAt().Value()
+
+	return &model
+}
+
+func (m *MessageRevisionModel) ToEntity() (*entity.MessageRevision, error) {
+	id, err := valueobject.NewIdWith(m.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(m.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := valueobject.NewMessageTextWith(m.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := valueobject.NewTimestampWith(m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := entity.NewMessageRevisionWith(id, messageId, text, createdAt)
+
+	return revision, nil
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type MuteModel struct {
+	RoomId    string
+	UserId    string
+	MutedBy   string
+	CreatedAt string
+	ExpiresAt string
+}
+
+func NewMuteModel(mute *entity.Mute) *MuteModel {
+	model := MuteModel{}
+
+	model.RoomId = mute.RoomId().Value()
+	model.UserId = mute.UserId().Value()
+	model.MutedBy = mute.MutedBy().Value()
+	model.CreatedAt = mute.CreatedAt().Value()
+	model.ExpiresAt = mute.ExpiresAt().Value()
+
+	return &model
+}
+
+func (m *MuteModel) ToEntity() (*entity.Mute, error) {
+	roomId, err := valueobject.NewIdWith(m.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(m.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	mutedBy, err := valueobject.NewUserIdWith(m.MutedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := valueobject.NewTimestampWith(m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := valueobject.NewTimestampWith(m.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	mute := entity.NewMuteWith(roomId, userId, mutedBy, createdAt, expiresAt)
+
+	return mute, nil
+}
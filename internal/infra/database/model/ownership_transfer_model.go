@@ -0,0 +1,71 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type OwnershipTransferModel struct {
+	Id              string
+	RoomId          string
+	PreviousAdminId string
+	AdminId         string
+	TransferredBy   string
+	TransferredAt   string
+}
+
+func NewOwnershipTransferModel(transfer *entity.OwnershipTransfer) *OwnershipTransferModel {
+	model := OwnershipTransferModel{}
+
+	model.Id = transfer.Id().Value()
+	model.RoomId = transfer.RoomId().Value()
+	model.PreviousAdminId = transfer.PreviousAdminId().Value()
+	model.AdminId = transfer.AdminId().Value()
+	model.TransferredBy = transfer.TransferredBy().Value()
+	model.TransferredAt = transfer.TransferredAt().Value()
+
+	return &model
+}
+
+func (m *OwnershipTransferModel) ToEntity() (*entity.OwnershipTransfer, error) {
+	id, err := valueobject.NewIdWith(m.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	roomId, err := valueobject.NewIdWith(m.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	previousAdminId, err := valueobject.NewUserIdWith(m.PreviousAdminId)
+	if err != nil {
+		return nil, err
+	}
+
+	adminId, err := valueobject.NewUserIdWith(m.AdminId)
+	if err != nil {
+		return nil, err
+	}
+
+	transferredBy, err := valueobject.NewUserIdWith(m.TransferredBy)
+	if err != nil {
+		return nil, err
+	}
+
+	transferredAt, err := valueobject.NewTimestampWith(m.TransferredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := entity.NewOwnershipTransferWith(
+		id,
+		roomId,
+		previousAdminId,
+		adminId,
+		transferredBy,
+		transferredAt,
+	)
+
+	return transfer, nil
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type PinModel struct {
+	RoomId    string
+	MessageId string
+	PinnedBy  string
+	CreatedAt string
+}
+
+func NewPinModel(pin *entity.Pin) *PinModel {
+	model := PinModel{}
+
+	model.RoomId = pin.RoomId().Value()
+	model.MessageId = pin.MessageId().Value()
+	model.PinnedBy = pin.PinnedBy().Value()
+	model.CreatedAt = pin.CreatedAt().Value()
+
+	return &model
+}
+
+func (m *PinModel) ToEntity() (*entity.Pin, error) {
+	roomId, err := valueobject.NewIdWith(m.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(m.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	pinnedBy, err := valueobject.NewUserIdWith(m.PinnedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := valueobject.NewTimestampWith(m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pin := entity.NewPinWith(
+		roomId,
+		messageId,
+		pinnedBy,
+		createdAt,
+	)
+
+	return pin, nil
+}
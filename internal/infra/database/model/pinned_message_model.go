@@ -0,0 +1,29 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+)
+
+type PinnedMessageModel struct {
+	Pin     PinModel
+	Message MessageModel
+}
+
+func (m *PinnedMessageModel) ToEntity() (*entity.PinnedMessage, error) {
+	pin, err := m.Pin.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	m.Message.Id = m.Pin.MessageId
+	m.Message.RoomId = m.Pin.RoomId
+
+	message, err := m.Message.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	pinnedMessage := entity.NewPinnedMessageWith(pin, message)
+
+	return pinnedMessage, nil
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+type ReactionModel struct {
+	MessageId string
+	UserId    string
+	Emoji     string
+	CreatedAt string
+}
+
+func NewReactionModel(reaction *entity.Reaction) *ReactionModel {
+	model := ReactionModel{}
+
+	model.MessageId = reaction.MessageId().Value()
+	model.UserId = reaction.UserId().Value()
+	model.Emoji = reaction.Emoji().Value()
+	model.CreatedAt = reaction.CreatedAt().Value()
+
+	return &model
+}
+
+type ReactionCountModel struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+// ReactionCountModels is scanned from the reaction counts of a message
+// aggregated in a json array.
+type ReactionCountModels []ReactionCountModel
+
+func (m *ReactionCountModels) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, m)
+	case string:
+		return json.Unmarshal([]byte(value), m)
+	default:
+		return fmt.Errorf("cannot scan %T into reaction counts", src)
+	}
+}
+
+func (m ReactionCountModels) ToEntity() ([]*entity.ReactionCount, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	counts := make([]*entity.ReactionCount, len(m))
+
+	for i, c := range m {
+		emoji, err := valueobject.NewEmojiWith(c.Emoji)
+		if err != nil {
+			return nil, err
+		}
+
+		counts[i] = entity.NewReactionCountWith(emoji, c.Count)
+	}
+
+	return counts, nil
+}
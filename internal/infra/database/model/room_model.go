@@ -6,13 +6,14 @@ import (
 )
 
 type RoomModel struct {
-	Id        string
-	AdminId   string
-	Name      string
-	Category  string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt *string
+	Id         string
+	AdminId    string
+	Name       string
+	Category   string
+	Visibility string
+	CreatedAt  string
+	UpdatedAt  string
+	DeletedAt  *string
 }
 
 func NewRoomModel(room *entity.Room) *RoomModel {
@@ -22,6 +23,7 @@ func NewRoomModel(room *entity.Room) *RoomModel {
 	model.AdminId = room.AdminId().Value()
 	model.Name = room.Name().Value()
 	model.Category = room.Category().Value()
+	model.Visibility = room.Visibility().Value()
 	model.CreatedAt = room.CreatedAt().Value()
 	model.UpdatedAt = room.UpdatedAt().Value()
 
@@ -54,6 +56,11 @@ func (m *RoomModel) ToEntity() (*entity.Room, error) {
 		return nil, err
 	}
 
+	visibility, err := valueobject.NewRoomVisibilityWith(m.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
 	createdAt, err := valueobject.NewTimestampWith(m.CreatedAt)
 	if err != nil {
 		return nil, err
@@ -73,7 +80,7 @@ func (m *RoomModel) ToEntity() (*entity.Room, error) {
 		}
 	}
 
-	room := entity.NewRoomWith(id, adminId, name, category, createdAt, updatedAt, deletedAt)
+	room := entity.NewRoomWith(id, adminId, name, category, visibility, createdAt, updatedAt, deletedAt)
 
 	return room, nil
 }
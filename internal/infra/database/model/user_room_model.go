@@ -0,0 +1,56 @@
+package model
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+)
+
+type UserRoomModel struct {
+	Room                  RoomModel
+	Member                MemberModel
+	LastMessageId         *string
+	LastMessageSenderId   *string
+	LastMessageSenderName *string
+	LastMessageText       *string
+	LastMessageCreatedAt  *string
+	LastMessageEditedAt   *string
+	LastMessageDeletedAt  *string
+	UnreadCount           int64
+}
+
+func (m *UserRoomModel) ToEntity() (*entity.UserRoom, error) {
+	room, err := m.Room.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	m.Member.RoomId = m.Room.Id
+
+	member, err := m.Member.ToEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	var lastMessage *entity.Message = nil
+
+	if m.LastMessageId != nil {
+		messageModel := MessageModel{
+			Id:         *m.LastMessageId,
+			RoomId:     m.Room.Id,
+			SenderId:   *m.LastMessageSenderId,
+			SenderName: *m.LastMessageSenderName,
+			Text:       *m.LastMessageText,
+			CreatedAt:  *m.LastMessageCreatedAt,
+			EditedAt:   m.LastMessageEditedAt,
+			DeletedAt:  m.LastMessageDeletedAt,
+		}
+
+		lastMessage, err = messageModel.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	userRoom := entity.NewUserRoomWith(room, member, lastMessage, m.UnreadCount)
+
+	return userRoom, nil
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type MutePostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewMutePostgresRepository(db *sql.DB) *MutePostgresRepository {
+	return &MutePostgresRepository{
+		db:     db,
+		logger: log.NewLogger("MutePostgresRepository"),
+	}
+}
+
+func (r *MutePostgresRepository) Save(ctx context.Context, mute *entity.Mute) error {
+	m := model.NewMuteModel(mute)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO room_mutes (room_id, user_id, muted_by, created_at, expires_at) 
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (room_id, user_id) DO UPDATE 
+		SET muted_by = EXCLUDED.muted_by, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.RoomId,
+		m.UserId,
+		m.MutedBy,
+		m.CreatedAt,
+		m.ExpiresAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *MutePostgresRepository) FindByRoomAndUser(
+	ctx context.Context,
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) (*entity.Mute, error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT room_id, user_id, muted_by, created_at, expires_at 
+		FROM room_mutes 
+		WHERE room_id = $1 AND user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var m model.MuteModel
+
+	err = stmt.QueryRowContext(ctx, roomId.Value(), userId.Value()).Scan(
+		&m.RoomId,
+		&m.UserId,
+		&m.MutedBy,
+		&m.CreatedAt,
+		&m.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFoundMute
+		}
+
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	mute, err := m.ToEntity()
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return mute, nil
+}
+
+func (r *MutePostgresRepository) Delete(ctx context.Context, mute *entity.Mute) error {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		DELETE FROM room_mutes 
+		WHERE room_id = $1 AND user_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, mute.RoomId().Value(), mute.UserId().Value())
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
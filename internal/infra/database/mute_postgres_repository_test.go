@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresMuteRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type MutePostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	roomRepository repository.RoomRepository
+	muteRepository repository.MuteRepository
+}
+
+func (s *MutePostgresRepositoryTestSuite) SetupSuite() {
+	postgresMuteRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresMuteRepository.Host,
+		Port:     postgresMuteRepository.Port,
+		User:     postgresMuteRepository.User,
+		Password: postgresMuteRepository.Password,
+		Name:     postgresMuteRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.muteRepository = NewMutePostgresRepository(db)
+}
+
+func (s *MutePostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresMuteRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestMutePostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MutePostgresRepositoryTestSuite))
+}
+
+func (s *MutePostgresRepositoryTestSuite) TestShouldSaveFindAndDeleteAMute() {
+	defer postgresMuteRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Games")
+	category, _ := valueobject.NewRoomCategoryWith("General")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	mute, _ := entity.NewMute(room.Id(), userId, adminId, valueobject.NewTimestamp().Add(time.Hour))
+
+	_, err := s.muteRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMute)
+
+	err = s.muteRepository.Save(s.ctx, mute)
+	assert.Nil(t, err)
+
+	result, err := s.muteRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Equal(t, mute.RoomId().Value(), result.RoomId().Value())
+	assert.Equal(t, mute.UserId().Value(), result.UserId().Value())
+	assert.Equal(t, mute.MutedBy().Value(), result.MutedBy().Value())
+	assert.Equal(t, mute.CreatedAt().Value(), result.CreatedAt().Value())
+	assert.Equal(t, mute.ExpiresAt().Value(), result.ExpiresAt().Value())
+
+	mute, _ = entity.NewMute(room.Id(), userId, adminId, valueobject.NewTimestamp().Add(2*time.Hour))
+
+	err = s.muteRepository.Save(s.ctx, mute)
+	assert.Nil(t, err)
+
+	result, err = s.muteRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.Nil(t, err)
+	assert.Equal(t, mute.ExpiresAt().Value(), result.ExpiresAt().Value())
+
+	err = s.muteRepository.Delete(s.ctx, mute)
+	assert.Nil(t, err)
+
+	_, err = s.muteRepository.FindByRoomAndUser(s.ctx, room.Id(), userId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMute)
+}
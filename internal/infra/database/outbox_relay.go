@@ -0,0 +1,307 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+
+	"github.com/lib/pq"
+)
+
+const (
+	outboxBatchSize       = 100
+	outboxMaxAttempts     = 10
+	outboxPollInterval    = 500 * time.Millisecond
+	outboxPublishTimeout  = 5 * time.Second
+	outboxClaimTimeout    = outboxBatchSize * outboxPublishTimeout
+	outboxMinRetryDelay   = time.Second
+	outboxMaxRetryDelay   = 5 * time.Minute
+	outboxCleanupInterval = time.Minute
+	outboxRetention       = 24 * time.Hour
+)
+
+type OutboxPublisher interface {
+	Send(ctx context.Context, messageEvent *event.MessageEvent) error
+}
+
+type outboxEntry struct {
+	id        string
+	payload   []byte
+	attempts  int
+	createdAt time.Time
+}
+
+// OutboxRelay publishes the pending outbox events and marks them as sent.
+// Rows are claimed with SKIP LOCKED, so several instances can run relays over
+// the same table. A failed event is retried with exponential backoff while the
+// events after it are still published, so the order is only kept between the
+// events that do not fail. After outboxMaxAttempts failures, or when the
+// payload can not be decoded, the event is marked as dead and left for
+// inspection.
+type OutboxRelay struct {
+	db        *sql.DB
+	publisher OutboxPublisher
+	logger    *log.Logger
+}
+
+func NewOutboxRelay(db *sql.DB, publisher OutboxPublisher) *OutboxRelay {
+	return &OutboxRelay{
+		db:        db,
+		publisher: publisher,
+		logger:    log.NewLogger("OutboxRelay"),
+	}
+}
+
+// Start relays events until the context is done.
+func (r *OutboxRelay) Start(ctx context.Context) {
+	poll := time.NewTicker(outboxPollInterval)
+	defer poll.Stop()
+
+	cleanup := time.NewTicker(outboxCleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+			for ctx.Err() == nil {
+				relayed, err := r.Relay(ctx)
+				if err != nil {
+					r.logger.Error(err)
+				}
+
+				if err != nil || relayed < outboxBatchSize {
+					break
+				}
+			}
+		case <-cleanup.C:
+			if err := r.cleanup(ctx); err != nil {
+				r.logger.Error(err)
+			}
+		}
+	}
+}
+
+// Relay publishes one batch of pending events and returns how many were sent.
+// The batch is claimed by moving its next attempt past the time needed to
+// publish it, so no row is locked while publishing and the rows of a relay
+// that stopped midway are claimed again later. The batch stops at the first
+// failure and its remaining events are released for the next pass.
+func (r *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	entries, err := r.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// The publishing results are stored even when the relay is stopping.
+	markCtx := context.WithoutCancel(ctx)
+	sent := 0
+
+	for i, entry := range entries {
+		var messageEvent event.MessageEvent
+
+		err := json.Unmarshal(entry.payload, &messageEvent)
+		if err != nil {
+			r.logger.Errorf("outbox event %s is dead: %s\n", entry.id, err)
+
+			if err := r.markFailed(markCtx, entry, err, true); err != nil {
+				return sent, err
+			}
+
+			continue
+		}
+
+		// An unroutable event has no instance subscribed to its room, there
+		// is nobody to deliver it to, so it is not published again.
+		err = r.publish(ctx, &messageEvent)
+		if errors.Is(err, gateway.ErrEventUnroutable) {
+			err = nil
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return sent, r.release(markCtx, entries[i:])
+			}
+
+			dead := entry.attempts+1 >= outboxMaxAttempts
+			if dead {
+				r.logger.Errorf("outbox event %s is dead: %s\n", entry.id, err)
+			} else {
+				r.logger.Errorf("outbox event %s not published: %s\n", entry.id, err)
+			}
+
+			if err := r.markFailed(markCtx, entry, err, dead); err != nil {
+				return sent, err
+			}
+
+			return sent, r.release(markCtx, entries[i+1:])
+		}
+
+		if err := r.markSent(markCtx, entry); err != nil {
+			return sent, err
+		}
+
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (r *OutboxRelay) claim(ctx context.Context) ([]*outboxEntry, error) {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE outbox
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id
+			FROM outbox
+			WHERE sent_at IS NULL AND dead_at IS NULL AND next_attempt_at <= NOW()
+			ORDER BY created_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, payload, attempts, created_at
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, outboxBatchSize, time.Now().Add(outboxClaimTimeout))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*outboxEntry
+
+	for rows.Next() {
+		var entry outboxEntry
+
+		err := rows.Scan(&entry.id, &entry.payload, &entry.attempts, &entry.createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery.
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].createdAt.Equal(entries[j].createdAt) {
+			return entries[i].id < entries[j].id
+		}
+
+		return entries[i].createdAt.Before(entries[j].createdAt)
+	})
+
+	return entries, nil
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, messageEvent *event.MessageEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	defer cancel()
+
+	return r.publisher.Send(ctx, messageEvent)
+}
+
+func (r *OutboxRelay) markSent(ctx context.Context, entry *outboxEntry) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE outbox
+		SET sent_at = NOW()
+		WHERE id = $1
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, entry.id)
+	return err
+}
+
+func (r *OutboxRelay) markFailed(ctx context.Context, entry *outboxEntry, cause error, dead bool) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE outbox
+		SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3, dead_at = $4
+		WHERE id = $1
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	nextAttemptAt := time.Now().Add(outboxRetryDelay(entry.attempts + 1))
+
+	var deadAt sql.NullTime
+	if dead {
+		deadAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	_, err = stmt.ExecContext(ctx, entry.id, cause.Error(), nextAttemptAt, deadAt)
+	return err
+}
+
+// release makes the claimed entries pending again without counting an attempt.
+func (r *OutboxRelay) release(ctx context.Context, entries []*outboxEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.id)
+	}
+
+	stmt, err := r.db.PrepareContext(ctx, `
+		UPDATE outbox
+		SET next_attempt_at = NOW()
+		WHERE id = ANY($1)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, pq.Array(ids))
+	return err
+}
+
+func (r *OutboxRelay) cleanup(ctx context.Context) error {
+	stmt, err := r.db.PrepareContext(ctx, `
+		DELETE FROM outbox
+		WHERE sent_at < $1
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, time.Now().Add(-outboxRetention))
+	return err
+}
+
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxMinRetryDelay
+
+	for i := 1; i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > outboxMaxRetryDelay {
+		delay = outboxMaxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,242 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresOutbox, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type fakeOutboxPublisher struct {
+	err  error
+	sent []*event.MessageEvent
+}
+
+func (p *fakeOutboxPublisher) Send(ctx context.Context, messageEvent *event.MessageEvent) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.sent = append(p.sent, messageEvent)
+	return nil
+}
+
+type OutboxRelayTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	db                  *sql.DB
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	messageRepository   repository.MessageRepository
+	messageEventGateway gateway.MessageEventGateway
+}
+
+func (s *OutboxRelayTestSuite) SetupSuite() {
+	postgresOutbox.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresOutbox.Host,
+		Port:     postgresOutbox.Port,
+		User:     postgresOutbox.User,
+		Password: postgresOutbox.Password,
+		Name:     postgresOutbox.Name,
+	})
+
+	s.ctx = context.Background()
+	s.db = db
+	s.transactionManager = NewPostgresTransactionManager(db)
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.messageRepository = NewMessagePostgresRepository(db)
+	s.messageEventGateway = NewMessageEventOutboxGateway(db, nil)
+}
+
+func (s *OutboxRelayTestSuite) TearDownSuite() {
+	if err := postgresOutbox.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestOutboxRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRelayTestSuite))
+}
+
+func (s *OutboxRelayTestSuite) createAMessage() *entity.Message {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	return entity.NewMessage(room.Id(), adminId, senderName, text)
+}
+
+func (s *OutboxRelayTestSuite) saveWithEvent(message *entity.Message, err error) error {
+	return s.transactionManager.Execute(s.ctx, func(ctx context.Context) error {
+		if err := s.messageRepository.Save(ctx, message); err != nil {
+			return err
+		}
+
+		if err := s.messageEventGateway.Send(ctx, event.NewMessageEvent(message)); err != nil {
+			return err
+		}
+
+		// The error, when given, makes the transaction roll back after the writes.
+		return err
+	})
+}
+
+func (s *OutboxRelayTestSuite) countOutbox(where string) int {
+	var count int
+	s.db.QueryRowContext(s.ctx, "SELECT COUNT(*) FROM outbox WHERE "+where).Scan(&count)
+	return count
+}
+
+func (s *OutboxRelayTestSuite) TestShouldRollbackTheMessageAndTheEventTogether() {
+	defer postgresOutbox.Clear()
+	t := s.T()
+
+	message := s.createAMessage()
+
+	err := s.saveWithEvent(message, errors.New("an error"))
+	assert.NotNil(t, err)
+
+	_, err = s.messageRepository.FindById(s.ctx, message.Id())
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+	assert.Equal(t, 0, s.countOutbox("TRUE"))
+
+	err = s.saveWithEvent(message, nil)
+	assert.Nil(t, err)
+
+	_, err = s.messageRepository.FindById(s.ctx, message.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, s.countOutbox("sent_at IS NULL"))
+}
+
+func (s *OutboxRelayTestSuite) TestShouldRelayThePendingEvents() {
+	defer postgresOutbox.Clear()
+	t := s.T()
+
+	messages := []*entity.Message{s.createAMessage(), s.createAMessage()}
+	for _, message := range messages {
+		s.saveWithEvent(message, nil)
+	}
+
+	publisher := &fakeOutboxPublisher{}
+	relay := NewOutboxRelay(s.db, publisher)
+
+	sent, err := relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, 2, len(publisher.sent))
+	assert.Equal(t, messages[0].Id().Value(), publisher.sent[0].Id)
+	assert.Equal(t, messages[1].Id().Value(), publisher.sent[1].Id)
+	assert.Equal(t, 2, s.countOutbox("sent_at IS NOT NULL"))
+
+	sent, err = relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 2, len(publisher.sent))
+}
+
+func (s *OutboxRelayTestSuite) TestShouldRetryTheFailedEventsLater() {
+	defer postgresOutbox.Clear()
+	t := s.T()
+
+	s.saveWithEvent(s.createAMessage(), nil)
+	s.saveWithEvent(s.createAMessage(), nil)
+
+	publisher := &fakeOutboxPublisher{err: gateway.ErrEventNotConfirmed}
+	relay := NewOutboxRelay(s.db, publisher)
+
+	sent, err := relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 1, s.countOutbox("attempts = 1 AND next_attempt_at > NOW() AND last_error IS NOT NULL"))
+	assert.Equal(t, 1, s.countOutbox("attempts = 0"))
+
+	publisher.err = nil
+
+	sent, err = relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, s.countOutbox("sent_at IS NULL AND attempts = 1"))
+}
+
+func (s *OutboxRelayTestSuite) TestShouldMarkTheUnroutableEventsAsSent() {
+	defer postgresOutbox.Clear()
+	t := s.T()
+
+	s.saveWithEvent(s.createAMessage(), nil)
+
+	publisher := &fakeOutboxPublisher{err: gateway.ErrEventUnroutable}
+	relay := NewOutboxRelay(s.db, publisher)
+
+	sent, err := relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, s.countOutbox("sent_at IS NOT NULL AND attempts = 0"))
+}
+
+func (s *OutboxRelayTestSuite) TestShouldMarkTheEventsAsDeadAfterTheMaxAttempts() {
+	defer postgresOutbox.Clear()
+	t := s.T()
+
+	s.saveWithEvent(s.createAMessage(), nil)
+	s.db.ExecContext(s.ctx, "UPDATE outbox SET attempts = $1", outboxMaxAttempts-1)
+
+	publisher := &fakeOutboxPublisher{err: gateway.ErrEventNotConfirmed}
+	relay := NewOutboxRelay(s.db, publisher)
+
+	sent, err := relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 1, s.countOutbox("dead_at IS NOT NULL AND sent_at IS NULL"))
+
+	s.db.ExecContext(s.ctx, "UPDATE outbox SET next_attempt_at = NOW()")
+	publisher.err = nil
+
+	sent, err = relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Empty(t, publisher.sent)
+}
+
+func (s *OutboxRelayTestSuite) TestShouldMarkTheEventsThatCanNotBeDecodedAsDead() {
+	defer postgresOutbox.Clear()
+	t := s.T()
+
+	s.saveWithEvent(s.createAMessage(), nil)
+	s.db.ExecContext(s.ctx, `UPDATE outbox SET payload = '{"id": 1}'`)
+	s.saveWithEvent(s.createAMessage(), nil)
+
+	publisher := &fakeOutboxPublisher{}
+	relay := NewOutboxRelay(s.db, publisher)
+
+	sent, err := relay.Relay(s.ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, len(publisher.sent))
+	assert.Equal(t, 1, s.countOutbox("dead_at IS NOT NULL AND attempts = 1"))
+}
+
+func TestOutboxRetryDelay_ShouldGrowExponentiallyUpToTheLimit(t *testing.T) {
+	assert.Equal(t, outboxMinRetryDelay, outboxRetryDelay(1))
+	assert.Equal(t, 2*outboxMinRetryDelay, outboxRetryDelay(2))
+	assert.Equal(t, 8*outboxMinRetryDelay, outboxRetryDelay(4))
+	assert.Equal(t, outboxMaxRetryDelay, outboxRetryDelay(30))
+}
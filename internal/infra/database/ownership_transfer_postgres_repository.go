@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type OwnershipTransferPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewOwnershipTransferPostgresRepository(db *sql.DB) *OwnershipTransferPostgresRepository {
+	return &OwnershipTransferPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("OwnershipTransferPostgresRepository"),
+	}
+}
+
+func (r *OwnershipTransferPostgresRepository) Save(ctx context.Context, transfer *entity.OwnershipTransfer) error {
+	m := model.NewOwnershipTransferModel(transfer)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO room_ownership_transfers (id, room_id, previous_admin_id, admin_id, transferred_by, transferred_at) 
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		m.Id,
+		m.RoomId,
+		m.PreviousAdminId,
+		m.AdminId,
+		m.TransferredBy,
+		m.TransferredAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OwnershipTransferPostgresRepository) ListByRoom(
+	ctx context.Context,
+	roomId *valueobject.Id,
+) ([]*entity.OwnershipTransfer, error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT id, room_id, previous_admin_id, admin_id, transferred_by, transferred_at
+		FROM room_ownership_transfers
+		WHERE room_id = $1
+		ORDER BY transferred_at, id
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, roomId.Value())
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []*entity.OwnershipTransfer
+
+	for rows.Next() {
+		var m model.OwnershipTransferModel
+
+		err := rows.Scan(
+			&m.Id,
+			&m.RoomId,
+			&m.PreviousAdminId,
+			&m.AdminId,
+			&m.TransferredBy,
+			&m.TransferredAt,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		transfer, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return transfers, nil
+}
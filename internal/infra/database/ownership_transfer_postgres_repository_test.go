@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresOwnershipTransferRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type OwnershipTransferPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx                         context.Context
+	roomRepository              repository.RoomRepository
+	ownershipTransferRepository repository.OwnershipTransferRepository
+}
+
+func (s *OwnershipTransferPostgresRepositoryTestSuite) SetupSuite() {
+	postgresOwnershipTransferRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresOwnershipTransferRepository.Host,
+		Port:     postgresOwnershipTransferRepository.Port,
+		User:     postgresOwnershipTransferRepository.User,
+		Password: postgresOwnershipTransferRepository.Password,
+		Name:     postgresOwnershipTransferRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.ownershipTransferRepository = NewOwnershipTransferPostgresRepository(db)
+}
+
+func (s *OwnershipTransferPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresOwnershipTransferRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestOwnershipTransferPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OwnershipTransferPostgresRepositoryTestSuite))
+}
+
+func (s *OwnershipTransferPostgresRepositoryTestSuite) TestShouldSaveAndListTheOwnershipTransfersOfARoom() {
+	defer postgresOwnershipTransferRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	transfers, err := s.ownershipTransferRepository.ListByRoom(s.ctx, room.Id())
+	assert.Nil(t, err)
+	assert.Empty(t, transfers)
+
+	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	transfer := entity.NewOwnershipTransfer(room.Id(), adminId, newAdminId, adminId)
+
+	err = s.ownershipTransferRepository.Save(s.ctx, transfer)
+	assert.Nil(t, err)
+
+	transfers, err = s.ownershipTransferRepository.ListByRoom(s.ctx, room.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(transfers))
+	assert.Equal(t, transfer.Id().Value(), transfers[0].Id().Value())
+	assert.Equal(t, transfer.RoomId().Value(), transfers[0].RoomId().Value())
+	assert.Equal(t, transfer.PreviousAdminId().Value(), transfers[0].PreviousAdminId().Value())
+	assert.Equal(t, transfer.AdminId().Value(), transfers[0].AdminId().Value())
+	assert.Equal(t, transfer.TransferredBy().Value(), transfers[0].TransferredBy().Value())
+	assert.Equal(t, transfer.TransferredAt().Value(), transfers[0].TransferredAt().Value())
+}
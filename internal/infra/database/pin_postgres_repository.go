@@ -0,0 +1,180 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type PinPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewPinPostgresRepository(db *sql.DB) *PinPostgresRepository {
+	return &PinPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("PinPostgresRepository"),
+	}
+}
+
+func (r *PinPostgresRepository) Save(ctx context.Context, pin *entity.Pin) error {
+	m := model.NewPinModel(pin)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO room_pins (room_id, message_id, pinned_by, created_at) 
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (room_id, message_id) DO NOTHING
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		m.RoomId,
+		m.MessageId,
+		m.PinnedBy,
+		m.CreatedAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	if rows == 0 {
+		return repository.ErrDuplicatePin
+	}
+
+	return nil
+}
+
+func (r *PinPostgresRepository) Delete(ctx context.Context, pin *entity.Pin) error {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		DELETE FROM room_pins 
+		WHERE room_id = $1 AND message_id = $2
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, pin.RoomId().Value(), pin.MessageId().Value())
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	if rows == 0 {
+		return repository.ErrNotFoundPin
+	}
+
+	return nil
+}
+
+func (r *PinPostgresRepository) CountByRoom(ctx context.Context, roomId *valueobject.Id) (int64, error) {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT COUNT(*) 
+		FROM room_pins 
+		WHERE room_id = $1
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+	defer stmt.Close()
+
+	var total int64
+
+	err = stmt.QueryRowContext(ctx, roomId.Value()).Scan(&total)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// ListByRoom reads the messages with the pins, the messages are only soft
+// deleted so every pin has its message.
+func (r *PinPostgresRepository) ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.PinnedMessage, error) {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT p.room_id, p.message_id, p.pinned_by, p.created_at, 
+			m.sender_id, m.sender_name, m.text, m.created_at, m.edited_at, m.deleted_at, m.parent_id
+		FROM room_pins p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.room_id = $1
+		ORDER BY p.created_at DESC, p.message_id DESC
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, roomId.Value())
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	pinnedMessages := make([]*entity.PinnedMessage, 0)
+
+	for rows.Next() {
+		var m model.PinnedMessageModel
+
+		err := rows.Scan(
+			&m.Pin.RoomId,
+			&m.Pin.MessageId,
+			&m.Pin.PinnedBy,
+			&m.Pin.CreatedAt,
+			&m.Message.SenderId,
+			&m.Message.SenderName,
+			&m.Message.Text,
+			&m.Message.CreatedAt,
+			&m.Message.EditedAt,
+			&m.Message.DeletedAt,
+			&m.Message.ParentId,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		pinnedMessage, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		pinnedMessages = append(pinnedMessages, pinnedMessage)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	return pinnedMessages, nil
+}
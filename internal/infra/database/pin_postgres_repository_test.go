@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresPinRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type PinPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	transactionManager repository.TransactionManager
+	roomRepository     repository.RoomRepository
+	messageRepository  repository.MessageRepository
+	pinRepository      repository.PinRepository
+}
+
+func (s *PinPostgresRepositoryTestSuite) SetupSuite() {
+	postgresPinRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresPinRepository.Host,
+		Port:     postgresPinRepository.Port,
+		User:     postgresPinRepository.User,
+		Password: postgresPinRepository.Password,
+		Name:     postgresPinRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.transactionManager = NewPostgresTransactionManager(db)
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.messageRepository = NewMessagePostgresRepository(db)
+	s.pinRepository = NewPinPostgresRepository(db)
+}
+
+func (s *PinPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresPinRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestPinPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PinPostgresRepositoryTestSuite))
+}
+
+func (s *PinPostgresRepositoryTestSuite) TestShouldSaveListAndDeletePins() {
+	defer postgresPinRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Games")
+	category, _ := valueobject.NewRoomCategoryWith("General")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+
+	pins := make([]*entity.Pin, 2)
+	for i := 0; i < len(pins); i++ {
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("An announcement %d", i))
+		message := entity.NewMessage(room.Id(), adminId, senderName, text)
+		s.messageRepository.Save(s.ctx, message)
+
+		pins[i] = entity.NewPin(room.Id(), message.Id(), adminId)
+		err := s.pinRepository.Save(s.ctx, pins[i])
+		assert.Nil(t, err)
+	}
+
+	err := s.pinRepository.Save(s.ctx, pins[0])
+	assert.ErrorIs(t, err, repository.ErrDuplicatePin)
+
+	total, err := s.pinRepository.CountByRoom(s.ctx, room.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), total)
+
+	result, err := s.pinRepository.ListByRoom(s.ctx, room.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, pins[1].MessageId().Value(), result[0].Pin().MessageId().Value())
+	assert.Equal(t, pins[1].PinnedBy().Value(), result[0].Pin().PinnedBy().Value())
+	assert.Equal(t, pins[1].CreatedAt().Value(), result[0].Pin().CreatedAt().Value())
+	assert.Equal(t, pins[1].MessageId().Value(), result[0].Message().Id().Value())
+	assert.Equal(t, "An announcement 1", result[0].Message().Text().Value())
+	assert.Equal(t, pins[0].MessageId().Value(), result[1].Pin().MessageId().Value())
+
+	err = s.pinRepository.Delete(s.ctx, pins[0])
+	assert.Nil(t, err)
+
+	err = s.pinRepository.Delete(s.ctx, pins[0])
+	assert.ErrorIs(t, err, repository.ErrNotFoundPin)
+
+	result, err = s.pinRepository.ListByRoom(s.ctx, room.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+}
+
+func (s *PinPostgresRepositoryTestSuite) TestShouldNotExceedThePinLimitWhenTheRoomIsLocked() {
+	defer postgresPinRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Games")
+	category, _ := valueobject.NewRoomCategoryWith("General")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+
+	pins := make([]*entity.Pin, entity.MaxRoomPins+5)
+	for i := 0; i < len(pins); i++ {
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("An announcement %d", i))
+		message := entity.NewMessage(room.Id(), adminId, senderName, text)
+		s.messageRepository.Save(s.ctx, message)
+
+		pins[i] = entity.NewPin(room.Id(), message.Id(), adminId)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(pins))
+
+	for _, pin := range pins {
+		wg.Add(1)
+
+		go func(pin *entity.Pin) {
+			defer wg.Done()
+
+			errs <- s.transactionManager.Execute(s.ctx, func(ctx context.Context) error {
+				err := s.roomRepository.Lock(ctx, room.Id())
+				if err != nil {
+					return err
+				}
+
+				err = s.pinRepository.Save(ctx, pin)
+				if err != nil {
+					return err
+				}
+
+				total, err := s.pinRepository.CountByRoom(ctx, room.Id())
+				if err != nil {
+					return err
+				}
+
+				return entity.ValidatePinLimit(total)
+			})
+		}(pin)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	rejected := 0
+	for err := range errs {
+		if errors.Is(err, entity.ErrRoomPinLimit) {
+			rejected++
+			continue
+		}
+
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, len(pins)-entity.MaxRoomPins, rejected)
+
+	total, err := s.pinRepository.CountByRoom(s.ctx, room.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(entity.MaxRoomPins), total)
+}
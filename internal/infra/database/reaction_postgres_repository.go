@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ReactionPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewReactionPostgresRepository(db *sql.DB) *ReactionPostgresRepository {
+	return &ReactionPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("ReactionPostgresRepository"),
+	}
+}
+
+func (r *ReactionPostgresRepository) Save(ctx context.Context, reaction *entity.Reaction) error {
+	m := model.NewReactionModel(reaction)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO message_reactions (message_id, user_id, emoji, created_at) 
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (message_id, user_id, emoji) DO NOTHING
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		m.MessageId,
+		m.UserId,
+		m.Emoji,
+		m.CreatedAt,
+	)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	if rows == 0 {
+		return repository.ErrDuplicateReaction
+	}
+
+	return nil
+}
+
+func (r *ReactionPostgresRepository) Delete(ctx context.Context, reaction *entity.Reaction) error {
+	m := model.NewReactionModel(reaction)
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		DELETE FROM message_reactions 
+		WHERE message_id = $1 AND user_id = $2 AND emoji = $3
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, m.MessageId, m.UserId, m.Emoji)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	if rows == 0 {
+		return repository.ErrNotFoundReaction
+	}
+
+	return nil
+}
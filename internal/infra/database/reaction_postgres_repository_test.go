@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresReactionRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type ReactionPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	roomRepository     repository.RoomRepository
+	messageRepository  repository.MessageRepository
+	reactionRepository repository.ReactionRepository
+}
+
+func (s *ReactionPostgresRepositoryTestSuite) SetupSuite() {
+	postgresReactionRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresReactionRepository.Host,
+		Port:     postgresReactionRepository.Port,
+		User:     postgresReactionRepository.User,
+		Password: postgresReactionRepository.Password,
+		Name:     postgresReactionRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.messageRepository = NewMessagePostgresRepository(db)
+	s.reactionRepository = NewReactionPostgresRepository(db)
+}
+
+func (s *ReactionPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresReactionRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestReactionPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReactionPostgresRepositoryTestSuite))
+}
+
+func (s *ReactionPostgresRepositoryTestSuite) TestShouldSaveAndDeleteReactionsAndCountThemOnTheMessage() {
+	defer postgresReactionRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("Games")
+	category, _ := valueobject.NewRoomCategoryWith("General")
+	room := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, room)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+	s.messageRepository.Save(s.ctx, message)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	like, _ := valueobject.NewEmojiWith("👍")
+	heart, _ := valueobject.NewEmojiWith("❤️")
+
+	err := s.reactionRepository.Save(s.ctx, entity.NewReaction(message.Id(), adminId, like))
+	assert.Nil(t, err)
+
+	err = s.reactionRepository.Save(s.ctx, entity.NewReaction(message.Id(), userId, like))
+	assert.Nil(t, err)
+
+	err = s.reactionRepository.Save(s.ctx, entity.NewReaction(message.Id(), userId, heart))
+	assert.Nil(t, err)
+
+	err = s.reactionRepository.Save(s.ctx, entity.NewReaction(message.Id(), userId, heart))
+	assert.ErrorIs(t, err, repository.ErrDuplicateReaction)
+
+	result, err := s.messageRepository.FindById(s.ctx, message.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.Reactions()))
+	assert.Equal(t, like.Value(), result.Reactions()[0].Emoji().Value())
+	assert.Equal(t, 2, result.Reactions()[0].Count())
+	assert.Equal(t, heart.Value(), result.Reactions()[1].Emoji().Value())
+	assert.Equal(t, 1, result.Reactions()[1].Count())
+
+	err = s.reactionRepository.Delete(s.ctx, entity.NewReaction(message.Id(), userId, heart))
+	assert.Nil(t, err)
+
+	err = s.reactionRepository.Delete(s.ctx, entity.NewReaction(message.Id(), userId, heart))
+	assert.ErrorIs(t, err, repository.ErrNotFoundReaction)
+
+	result, err = s.messageRepository.FindById(s.ctx, message.Id())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.Reactions()))
+	assert.Equal(t, like.Value(), result.Reactions()[0].Emoji().Value())
+}
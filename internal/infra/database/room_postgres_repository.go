@@ -28,9 +28,9 @@ func NewRoomPostgresRepository(db *sql.DB) *RoomPostgresRepository {
 func (r *RoomPostgresRepository) Save(ctx context.Context, room *entity.Room) error {
 	m := model.NewRoomModel(room)
 
-	stmt, err := r.db.PrepareContext(ctx, `
-		INSERT INTO rooms (id, admin_id, name, category, created_at, updated_at, deleted_at) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		INSERT INTO rooms (id, admin_id, name, category, visibility, created_at, updated_at, deleted_at) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`)
 	if err != nil {
 		r.logger.Error(err)
@@ -44,6 +44,7 @@ func (r *RoomPostgresRepository) Save(ctx context.Context, room *entity.Room) er
 		m.AdminId,
 		m.Name,
 		m.Category,
+		m.Visibility,
 		m.CreatedAt,
 		m.UpdatedAt,
 		m.DeletedAt,
@@ -57,8 +58,8 @@ func (r *RoomPostgresRepository) Save(ctx context.Context, room *entity.Room) er
 }
 
 func (r *RoomPostgresRepository) FindById(ctx context.Context, id *valueobject.Id) (*entity.Room, error) {
-	stmt, err := r.db.PrepareContext(ctx, `
-		SELECT id, admin_id, name, category, created_at, updated_at, deleted_at
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT id, admin_id, name, category, visibility, created_at, updated_at, deleted_at
 		FROM rooms 
 		WHERE id = $1
 	`)
@@ -75,6 +76,7 @@ func (r *RoomPostgresRepository) FindById(ctx context.Context, id *valueobject.I
 		&m.AdminId,
 		&m.Name,
 		&m.Category,
+		&m.Visibility,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 		&m.DeletedAt,
@@ -97,11 +99,21 @@ func (r *RoomPostgresRepository) FindById(ctx context.Context, id *valueobject.I
 	return room, nil
 }
 
-func (r *RoomPostgresRepository) Search(ctx context.Context, query *pagination.Query) (*pagination.Page[*entity.Room], error) {
-	stmt1, err := r.db.PrepareContext(ctx, `
-		SELECT id, admin_id, name, category, created_at, updated_at, deleted_at, COUNT(*) OVER () AS total
+func (r *RoomPostgresRepository) Search(
+	ctx context.Context,
+	userId *valueobject.UserId,
+	query *pagination.Query,
+) (*pagination.Page[*entity.Room], error) {
+
+	stmt1, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT id, admin_id, name, category, visibility, created_at, updated_at, deleted_at, COUNT(*) OVER () AS total
 		FROM rooms 
-		WHERE deleted_at IS NOT NULL AND ($1 = '') OR (UPPER(name) LIKE '%' || $1 || '%') OR (UPPER(category::text) LIKE '%' || $1 || '%') 
+		WHERE deleted_at IS NULL 
+			AND visibility <> 'direct' 
+			AND (($1 = '') OR (UPPER(name) LIKE '%' || $1 || '%') OR (UPPER(category::text) LIKE '%' || $1 || '%')) 
+			AND (visibility = 'public' OR EXISTS (
+				SELECT 1 FROM room_members WHERE room_members.room_id = rooms.id AND room_members.user_id = $4
+			))
 		ORDER BY name `+query.Sort()+`
 		LIMIT $2 
 		OFFSET $3
@@ -112,7 +124,7 @@ func (r *RoomPostgresRepository) Search(ctx context.Context, query *pagination.Q
 	}
 	defer stmt1.Close()
 
-	rows, err := stmt1.QueryContext(ctx, query.Search(), query.Size(), query.Size()*query.Page())
+	rows, err := stmt1.QueryContext(ctx, query.Search(), query.Size(), query.Size()*query.Page(), userId.Value())
 	if err != nil {
 		r.logger.Error(err)
 		return nil, err
@@ -130,6 +142,7 @@ func (r *RoomPostgresRepository) Search(ctx context.Context, query *pagination.Q
 			&m.AdminId,
 			&m.Name,
 			&m.Category,
+			&m.Visibility,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 			&m.DeletedAt,
@@ -156,9 +169,9 @@ func (r *RoomPostgresRepository) Search(ctx context.Context, query *pagination.Q
 func (r *RoomPostgresRepository) Update(ctx context.Context, room *entity.Room) error {
 	m := model.NewRoomModel(room)
 
-	stmt, err := r.db.PrepareContext(ctx, `
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
 		UPDATE rooms 
-		SET admin_id = $2, name = $3, category = $4, created_at = $5, updated_at = $6, deleted_at = $7
+		SET admin_id = $2, name = $3, category = $4, visibility = $5, created_at = $6, updated_at = $7, deleted_at = $8
 		WHERE id = $1
 	`)
 	if err != nil {
@@ -173,6 +186,7 @@ func (r *RoomPostgresRepository) Update(ctx context.Context, room *entity.Room)
 		m.AdminId,
 		m.Name,
 		m.Category,
+		m.Visibility,
 		m.CreatedAt,
 		m.UpdatedAt,
 		m.DeletedAt,
@@ -184,3 +198,33 @@ func (r *RoomPostgresRepository) Update(ctx context.Context, room *entity.Room)
 
 	return nil
 }
+
+// Lock does not block the rows that reference the room, such as its messages,
+// which only need the key of the room to be kept.
+func (r *RoomPostgresRepository) Lock(ctx context.Context, id *valueobject.Id) error {
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		SELECT id 
+		FROM rooms 
+		WHERE id = $1
+		FOR NO KEY UPDATE
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer stmt.Close()
+
+	var roomId string
+
+	err = stmt.QueryRowContext(ctx, id.Value()).Scan(&roomId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrNotFoundRoom
+		}
+
+		r.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
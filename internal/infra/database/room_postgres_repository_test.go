@@ -21,8 +21,10 @@ var postgresRoomRepository, _ = services.NewPostgresContainer(context.Background
 
 type RoomPostgresRepositoryTestSuite struct {
 	suite.Suite
-	ctx        context.Context
-	repository *RoomPostgresRepository
+	ctx              context.Context
+	repository       *RoomPostgresRepository
+	memberRepository *MemberPostgresRepository
+	userId           *valueobject.UserId
 }
 
 func (s *RoomPostgresRepositoryTestSuite) SetupSuite() {
@@ -38,6 +40,8 @@ func (s *RoomPostgresRepositoryTestSuite) SetupSuite() {
 
 	s.ctx = context.Background()
 	s.repository = NewRoomPostgresRepository(db)
+	s.memberRepository = NewMemberPostgresRepository(db)
+	s.userId, _ = valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533a")
 }
 
 func (s *RoomPostgresRepositoryTestSuite) TearDownSuite() {
@@ -105,7 +109,7 @@ func (s *RoomPostgresRepositoryTestSuite) TestShouldReturnARoomPageInAscendingOr
 		assert.NotNil(t, query)
 		assert.Nil(t, err)
 
-		result, err := s.repository.Search(s.ctx, query)
+		result, err := s.repository.Search(s.ctx, s.userId, query)
 		assert.NotNil(t, result)
 		assert.Nil(t, err)
 		assert.Equal(t, page, result.Page)
@@ -141,7 +145,7 @@ func (s *RoomPostgresRepositoryTestSuite) TestShouldReturnARoomPageInDescendingO
 		assert.NotNil(t, query)
 		assert.Nil(t, err)
 
-		result, err := s.repository.Search(s.ctx, query)
+		result, err := s.repository.Search(s.ctx, s.userId, query)
 		assert.NotNil(t, result)
 		assert.Nil(t, err)
 		assert.Equal(t, page, result.Page)
@@ -207,7 +211,7 @@ func (s *RoomPostgresRepositoryTestSuite) TestShouldReturnARoomPageFilteredBySea
 	}
 
 	query, _ := pagination.NewQuery("0", "10", "desc", "for Speed")
-	page, _ := s.repository.Search(s.ctx, query)
+	page, _ := s.repository.Search(s.ctx, s.userId, query)
 	assert.Equal(t, 0, page.Page)
 	assert.Equal(t, 10, page.Size)
 	assert.Equal(t, int64(2), page.Total)
@@ -216,7 +220,7 @@ func (s *RoomPostgresRepositoryTestSuite) TestShouldReturnARoomPageFilteredBySea
 	assert.Equal(t, "Need for Speed Most Wanted", page.Items[1].Name().Value())
 
 	query, _ = pagination.NewQuery("1", "2", "asc", "Tech")
-	page, _ = s.repository.Search(s.ctx, query)
+	page, _ = s.repository.Search(s.ctx, s.userId, query)
 	assert.Equal(t, 1, page.Page)
 	assert.Equal(t, 2, page.Size)
 	assert.Equal(t, int64(4), page.Total)
@@ -225,6 +229,44 @@ func (s *RoomPostgresRepositoryTestSuite) TestShouldReturnARoomPageFilteredBySea
 	assert.Equal(t, "Rust", page.Items[1].Name().Value())
 }
 
+func (s *RoomPostgresRepositoryTestSuite) TestShouldReturnOnlyThePrivateRoomsOfTheUser() {
+	defer postgresRoomRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	category, _ := valueobject.NewRoomCategoryWith("Tech")
+	private, _ := valueobject.NewRoomVisibilityWith("private")
+
+	names := []string{"Go", "Java", "Rust"}
+	rooms := make([]*entity.Room, len(names))
+
+	for i, value := range names {
+		name, _ := valueobject.NewRoomNameWith(value)
+		rooms[i] = entity.NewRoom(adminId, name, category)
+		s.repository.Save(s.ctx, rooms[i])
+	}
+
+	rooms[1].UpdateVisibility(private)
+	s.repository.Update(s.ctx, rooms[1])
+
+	rooms[2].UpdateVisibility(private)
+	s.repository.Update(s.ctx, rooms[2])
+	s.memberRepository.Save(s.ctx, entity.NewMember(rooms[2].Id(), s.userId))
+
+	query, _ := pagination.NewQuery("0", "10", "asc", "")
+	page, err := s.repository.Search(s.ctx, s.userId, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), page.Total)
+	assert.Equal(t, "Go", page.Items[0].Name().Value())
+	assert.Equal(t, "Rust", page.Items[1].Name().Value())
+	assert.True(t, page.Items[1].IsPrivate())
+
+	page, err = s.repository.Search(s.ctx, adminId, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, "Go", page.Items[0].Name().Value())
+}
+
 func (s *RoomPostgresRepositoryTestSuite) TestShouldUpdateARoom() {
 	defer postgresRoomRepository.Clear()
 	t := s.T()
@@ -241,11 +283,12 @@ func (s *RoomPostgresRepositoryTestSuite) TestShouldUpdateARoom() {
 	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
 	newName, _ := valueobject.NewRoomNameWith("English")
 	newCategory, _ := valueobject.NewRoomCategoryWith("Language")
+	newVisibility, _ := valueobject.NewRoomVisibilityWith("private")
 	newCreatedAt := valueobject.NewTimestamp()
 	newUpdatedAt := valueobject.NewTimestamp()
 	newDeletedAt := valueobject.NewTimestamp()
 
-	newRoom := entity.NewRoomWith(id, newAdminId, newName, newCategory, newCreatedAt, newUpdatedAt, newDeletedAt)
+	newRoom := entity.NewRoomWith(id, newAdminId, newName, newCategory, newVisibility, newCreatedAt, newUpdatedAt, newDeletedAt)
 
 	err = s.repository.Update(s.ctx, newRoom)
 	assert.Nil(t, err)
@@ -257,8 +300,16 @@ func (s *RoomPostgresRepositoryTestSuite) TestShouldUpdateARoom() {
 	assert.Equal(t, newRoom.AdminId().Value(), result.AdminId().Value())
 	assert.Equal(t, newRoom.Name().Value(), result.Name().Value())
 	assert.Equal(t, newRoom.Category().Value(), result.Category().Value())
+	assert.Equal(t, newRoom.Visibility().Value(), result.Visibility().Value())
 	assert.Equal(t, newRoom.CreatedAt().Value(), result.CreatedAt().Value())
 	assert.Equal(t, newRoom.UpdatedAt().Value(), result.UpdatedAt().Value())
 	assert.Equal(t, newRoom.UpdatedAt().Value(), result.UpdatedAt().Value())
 	assert.Equal(t, newRoom.DeletedAt().Value(), result.DeletedAt().Value())
 }
+
+func (s *RoomPostgresRepositoryTestSuite) TestShouldReturnAnErrorWhenLockingARoomThatDoesNotExist() {
+	t := s.T()
+
+	err := s.repository.Lock(s.ctx, valueobject.NewId())
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
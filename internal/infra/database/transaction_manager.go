@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type txKey struct{}
+
+type executor interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// executorFrom returns the transaction in the context, or the database when
+// the call is not part of a transaction.
+func executorFrom(ctx context.Context, db *sql.DB) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+type PostgresTransactionManager struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewPostgresTransactionManager(db *sql.DB) *PostgresTransactionManager {
+	return &PostgresTransactionManager{
+		db:     db,
+		logger: log.NewLogger("PostgresTransactionManager"),
+	}
+}
+
+func (m *PostgresTransactionManager) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		m.logger.Error(err)
+		return err
+	}
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			m.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		m.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/database/model"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type UserRoomPostgresRepository struct {
+	db     *sql.DB
+	logger *log.Logger
+}
+
+func NewUserRoomPostgresRepository(db *sql.DB) *UserRoomPostgresRepository {
+	return &UserRoomPostgresRepository{
+		db:     db,
+		logger: log.NewLogger("UserRoomPostgresRepository"),
+	}
+}
+
+// ListByUser pages the memberships of the user before counting the unread
+// messages, so only the rooms of the page are counted. The last messages and
+// the unread messages are found with the messages (room_id, created_at, id)
+// index, their rows are still read from the table.
+func (r *UserRoomPostgresRepository) ListByUser(
+	ctx context.Context,
+	userId *valueobject.UserId,
+	query *pagination.Query,
+) (*pagination.Page[*entity.UserRoom], error) {
+
+	stmt, err := executorFrom(ctx, r.db).PrepareContext(ctx, `
+		WITH page AS (
+			SELECT r.id, r.admin_id, r.name, r.category, r.visibility, r.created_at, r.updated_at, r.deleted_at, 
+				rm.user_id, rm.role, rm.joined_at, rm.last_read_message_id, 
+				m.id AS message_id, m.sender_id, m.sender_name, m.text, 
+				m.created_at AS message_created_at, m.edited_at, m.deleted_at AS message_deleted_at, 
+				COALESCE(m.created_at, r.updated_at) AS last_activity_at, 
+				COUNT(*) OVER () AS total
+			FROM room_members rm
+			JOIN rooms r ON r.id = rm.room_id
+			LEFT JOIN LATERAL (
+				SELECT id, sender_id, sender_name, text, created_at, edited_at, deleted_at
+				FROM messages
+				WHERE messages.room_id = rm.room_id
+				ORDER BY created_at DESC, id DESC
+				LIMIT 1
+			) m ON TRUE
+			WHERE rm.user_id = $1 AND r.deleted_at IS NULL
+			ORDER BY last_activity_at DESC, r.id DESC
+			LIMIT $2 
+			OFFSET $3
+		)
+		SELECT page.id, page.admin_id, page.name, page.category, page.visibility, 
+			page.created_at, page.updated_at, page.deleted_at, 
+			page.user_id, page.role, page.joined_at, page.last_read_message_id, 
+			page.message_id, page.sender_id, page.sender_name, page.text, 
+			page.message_created_at, page.edited_at, page.message_deleted_at, 
+			u.unread_count, page.total
+		FROM page
+		LEFT JOIN messages lr ON lr.id = page.last_read_message_id
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS unread_count
+			FROM messages
+			WHERE messages.room_id = page.id 
+				AND (messages.created_at, messages.id) > (COALESCE(lr.created_at, page.joined_at), COALESCE(lr.id, ''))
+				AND messages.sender_id <> page.user_id 
+				AND messages.deleted_at IS NULL
+		) u
+		ORDER BY page.last_activity_at DESC, page.id DESC
+	`)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userId.Value(), query.Size(), query.Size()*query.Page())
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entity.UserRoom
+	var total int64
+
+	for rows.Next() {
+		var m model.UserRoomModel
+
+		err := rows.Scan(
+			&m.Room.Id,
+			&m.Room.AdminId,
+			&m.Room.Name,
+			&m.Room.Category,
+			&m.Room.Visibility,
+			&m.Room.CreatedAt,
+			&m.Room.UpdatedAt,
+			&m.Room.DeletedAt,
+			&m.Member.UserId,
+			&m.Member.Role,
+			&m.Member.JoinedAt,
+			&m.Member.LastReadMessageId,
+			&m.LastMessageId,
+			&m.LastMessageSenderId,
+			&m.LastMessageSenderName,
+			&m.LastMessageText,
+			&m.LastMessageCreatedAt,
+			&m.LastMessageEditedAt,
+			&m.LastMessageDeletedAt,
+			&m.UnreadCount,
+			&total,
+		)
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		userRoom, err := m.ToEntity()
+		if err != nil {
+			r.logger.Error(err)
+			return nil, err
+		}
+
+		items = append(items, userRoom)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+
+	page := pagination.NewPage[*entity.UserRoom](query.Page(), query.Size(), total, items)
+	return page, nil
+}
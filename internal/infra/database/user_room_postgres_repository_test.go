@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var postgresUserRoomRepository, _ = services.NewPostgresContainer(context.Background(), "file://../../../")
+
+type UserRoomPostgresRepositoryTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	roomRepository     repository.RoomRepository
+	memberRepository   repository.MemberRepository
+	messageRepository  repository.MessageRepository
+	userRoomRepository repository.UserRoomRepository
+}
+
+func (s *UserRoomPostgresRepositoryTestSuite) SetupSuite() {
+	postgresUserRoomRepository.Clear()
+
+	db := PostgresConnection(&config.DatabaseConfig{
+		Host:     postgresUserRoomRepository.Host,
+		Port:     postgresUserRoomRepository.Port,
+		User:     postgresUserRoomRepository.User,
+		Password: postgresUserRoomRepository.Password,
+		Name:     postgresUserRoomRepository.Name,
+	})
+
+	s.ctx = context.Background()
+	s.roomRepository = NewRoomPostgresRepository(db)
+	s.memberRepository = NewMemberPostgresRepository(db)
+	s.messageRepository = NewMessagePostgresRepository(db)
+	s.userRoomRepository = NewUserRoomPostgresRepository(db)
+}
+
+func (s *UserRoomPostgresRepositoryTestSuite) TearDownSuite() {
+	if err := postgresUserRoomRepository.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating postgres container: %s", err)
+	}
+}
+
+func TestUserRoomPostgresRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserRoomPostgresRepositoryTestSuite))
+}
+
+func (s *UserRoomPostgresRepositoryTestSuite) TestShouldListTheRoomsOfTheUserWithTheUnreadCount() {
+	defer postgresUserRoomRepository.Clear()
+	t := s.T()
+
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	emptyRoom := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, emptyRoom)
+	s.memberRepository.Save(s.ctx, entity.NewMember(emptyRoom.Id(), userId))
+
+	activeRoom := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, activeRoom)
+	member := entity.NewMember(activeRoom.Id(), userId)
+	s.memberRepository.Save(s.ctx, member)
+
+	otherRoom := entity.NewRoom(adminId, name, category)
+	s.roomRepository.Save(s.ctx, otherRoom)
+
+	var messages []*entity.Message
+	createdAt := member.JoinedAt().Add(time.Second)
+
+	for i := 0; i < 3; i++ {
+		message := entity.NewMessageWith(
+			valueobject.NewId(),
+			activeRoom.Id(),
+			adminId,
+			senderName,
+			text,
+			createdAt.Add(time.Duration(i)*time.Second),
+			nil,
+			nil,
+			nil,
+			0,
+			nil,
+			nil,
+		)
+		s.messageRepository.Save(s.ctx, message)
+		messages = append(messages, message)
+	}
+
+	ownMessage := entity.NewMessageWith(
+		valueobject.NewId(),
+		activeRoom.Id(),
+		userId,
+		senderName,
+		text,
+		createdAt.Add(3*time.Second),
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+	)
+	s.messageRepository.Save(s.ctx, ownMessage)
+
+	query, _ := pagination.NewQuery("0", "10", "", "")
+
+	page, err := s.userRoomRepository.ListByUser(s.ctx, userId, query)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), page.Total)
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, activeRoom.Id().Value(), page.Items[0].Room().Id().Value())
+	assert.Equal(t, ownMessage.Id().Value(), page.Items[0].LastMessage().Id().Value())
+	assert.Equal(t, int64(3), page.Items[0].UnreadCount())
+	assert.Equal(t, emptyRoom.Id().Value(), page.Items[1].Room().Id().Value())
+	assert.Nil(t, page.Items[1].LastMessage())
+	assert.Equal(t, int64(0), page.Items[1].UnreadCount())
+
+	member.MarkAsRead(messages[1].Id())
+	s.memberRepository.UpdateLastRead(s.ctx, member)
+
+	page, err = s.userRoomRepository.ListByUser(s.ctx, userId, query)
+	assert.Nil(t, err)
+	assert.Equal(t, messages[1].Id().Value(), page.Items[0].Member().LastReadMessageId().Value())
+	assert.Equal(t, int64(1), page.Items[0].UnreadCount())
+}
@@ -1,7 +1,10 @@
 package event
 
 import (
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/sesaquecruz/go-chat-api/config"
 	"github.com/sesaquecruz/go-chat-api/pkg/log"
@@ -9,18 +12,170 @@ import (
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
-func RabbitMqConnection(cfg *config.BrokerConfig) *amqp.Connection {
+const reconnectMinDelay = time.Second
+const reconnectMaxDelay = 30 * time.Second
+
+var ErrConnectionReconnecting = errors.New("broker connection is reconnecting")
+
+// RabbitMqConnection keeps a broker connection open. When the connection is
+// lost it reconnects with exponential backoff and declares the topology again.
+type RabbitMqConnection struct {
+	cfg          *config.BrokerConfig
+	url          string
+	mu           sync.RWMutex
+	conn         *amqp.Connection
+	reconnecting bool
+	reconnected  chan struct{}
+	done         chan struct{}
+	closeOnce    sync.Once
+	logger       *log.Logger
+}
+
+func NewRabbitMqConnection(cfg *config.BrokerConfig) *RabbitMqConnection {
 	logger := log.NewLogger("RabbitMqConnection")
 
-	url := fmt.Sprintf("amqp://%s:%s@%s:%s",
-		cfg.User, cfg.Password, cfg.Host, cfg.Port,
-	)
+	c := &RabbitMqConnection{
+		cfg: cfg,
+		url: fmt.Sprintf("amqp://%s:%s@%s:%s",
+			cfg.User, cfg.Password, cfg.Host, cfg.Port,
+		),
+		reconnected: make(chan struct{}),
+		done:        make(chan struct{}),
+		logger:      logger,
+	}
 
-	conn, err := amqp.Dial(url)
+	conn, err := c.dial()
 	if err != nil {
 		logger.Fatal(err)
 		return nil
 	}
 
-	return conn
+	c.conn = conn
+	go c.watch(conn)
+
+	return c
+}
+
+func (c *RabbitMqConnection) Channel() (*amqp.Channel, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.reconnecting {
+		return nil, ErrConnectionReconnecting
+	}
+
+	return c.conn.Channel()
+}
+
+func (c *RabbitMqConnection) IsClosed() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.conn.IsClosed()
+}
+
+func (c *RabbitMqConnection) IsReconnecting() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.reconnecting
+}
+
+// Reconnected returns a channel that is closed after the next reconnection.
+func (c *RabbitMqConnection) Reconnected() <-chan struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.reconnected
+}
+
+func (c *RabbitMqConnection) Close() error {
+	var err error
+
+	c.closeOnce.Do(func() {
+		close(c.done)
+
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		err = c.conn.Close()
+	})
+
+	return err
+}
+
+func (c *RabbitMqConnection) dial() (*amqp.Connection, error) {
+	conn, err := amqp.Dial(c.url)
+	if err != nil {
+		return nil, err
+	}
+
+	err = DeclareRabbitMqTopology(conn, c.cfg)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func (c *RabbitMqConnection) watch(conn *amqp.Connection) {
+	for {
+		closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+
+		select {
+		case <-c.done:
+			return
+		case err := <-closed:
+			select {
+			case <-c.done:
+				return
+			default:
+			}
+
+			c.logger.Errorf("broker connection closed: %v\n", err)
+		}
+
+		c.mu.Lock()
+		c.reconnecting = true
+		c.mu.Unlock()
+
+		conn = c.reconnect()
+		if conn == nil {
+			return
+		}
+
+		c.mu.Lock()
+		c.conn = conn
+		c.reconnecting = false
+		close(c.reconnected)
+		c.reconnected = make(chan struct{})
+		c.mu.Unlock()
+
+		c.logger.Info("broker connection reestablished")
+	}
+}
+
+func (c *RabbitMqConnection) reconnect() *amqp.Connection {
+	delay := reconnectMinDelay
+
+	for {
+		select {
+		case <-c.done:
+			return nil
+		case <-time.After(delay):
+		}
+
+		conn, err := c.dial()
+		if err == nil {
+			return conn
+		}
+
+		c.logger.Error(err)
+
+		delay *= 2
+		if delay > reconnectMaxDelay {
+			delay = reconnectMaxDelay
+		}
+	}
 }
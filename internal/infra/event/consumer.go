@@ -0,0 +1,157 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const consumeRetryDelay = time.Second
+
+var ErrConsumerClosed = errors.New("broker consumer closed")
+
+// consumerQueue is the queue a consumer declares for itself. The queue is
+// exclusive to the consumer and deleted when the consumer is gone.
+type consumerQueue struct {
+	exchange   string
+	routingKey string
+	// messageTtl expires the messages waiting in the queue, zero keeps them.
+	messageTtl time.Duration
+	// ack acknowledges a message only after it was delivered, so a message
+	// that was not delivered is requeued.
+	ack bool
+}
+
+// receive delivers the events of the queue until the context is done. When
+// the consumer is lost it is started again after the connection is back.
+func receive[T any](
+	ctx context.Context,
+	conn *RabbitMqConnection,
+	queue consumerQueue,
+	events chan<- *T,
+	ready func(),
+	logger *log.Logger,
+) error {
+
+	for {
+		reconnected := conn.Reconnected()
+
+		err := consume(ctx, conn, queue, events, ready, logger)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		logger.Error(err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-reconnected:
+		case <-time.After(consumeRetryDelay):
+		}
+	}
+}
+
+func consume[T any](
+	ctx context.Context,
+	conn *RabbitMqConnection,
+	queue consumerQueue,
+	events chan<- *T,
+	ready func(),
+	logger *log.Logger,
+) error {
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	var args amqp.Table
+
+	if queue.messageTtl > 0 {
+		args = amqp.Table{
+			"x-message-ttl": queue.messageTtl.Milliseconds(),
+		}
+	}
+
+	declared, err := ch.QueueDeclare(
+		"",
+		false,
+		true,
+		true,
+		false,
+		args,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = ch.QueueBind(
+		declared.Name,
+		queue.routingKey,
+		queue.exchange,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := ch.Consume(
+		declared.Name,
+		"",
+		!queue.ack,
+		true,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	ready()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-msgs:
+			if !ok {
+				return ErrConsumerClosed
+			}
+
+			event := new(T)
+
+			err = json.Unmarshal(msg.Body, event)
+			if err != nil {
+				logger.Error(err)
+
+				if queue.ack {
+					msg.Ack(false)
+				}
+
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				if queue.ack {
+					msg.Nack(false, true)
+				}
+
+				return nil
+			case events <- event:
+				if queue.ack {
+					msg.Ack(false)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// ephemeralPublisher publishes events to an ephemeral exchange. Events are
+// transient, not confirmed and expire after their ttl, so a lost event is only
+// a short gap until the next one.
+type ephemeralPublisher struct {
+	conn       *RabbitMqConnection
+	mu         sync.Mutex
+	ch         *amqp.Channel
+	exchange   string
+	expiration string
+	logger     *log.Logger
+}
+
+func newEphemeralPublisher(
+	conn *RabbitMqConnection,
+	exchange string,
+	ttl time.Duration,
+	logger *log.Logger,
+) *ephemeralPublisher {
+	return &ephemeralPublisher{
+		conn:       conn,
+		exchange:   exchange,
+		expiration: strconv.FormatInt(ttl.Milliseconds(), 10),
+		logger:     logger,
+	}
+}
+
+// channel returns the publish channel, opening a new one when the previous was
+// closed. It must be called with the lock held.
+func (p *ephemeralPublisher) channel() (*amqp.Channel, error) {
+	if p.ch != nil && !p.ch.IsClosed() {
+		return p.ch, nil
+	}
+
+	ch, err := p.conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	p.ch = ch
+
+	return ch, nil
+}
+
+func (p *ephemeralPublisher) publish(ctx context.Context, routingKey string, event any) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Error(err)
+		return err
+	}
+
+	msg := amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Transient,
+		Expiration:   p.expiration,
+		Body:         body,
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch, err := p.channel()
+	if err != nil {
+		p.logger.Error(err)
+		return err
+	}
+
+	err = ch.PublishWithContext(
+		ctx,
+		p.exchange,
+		routingKey,
+		false,
+		false,
+		msg,
+	)
+	if err != nil {
+		p.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -3,29 +3,191 @@ package event
 import (
 	"context"
 	"encoding/json"
+	"sync"
 
+	"github.com/sesaquecruz/go-chat-api/config"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/pkg/log"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 type MessageEventRabbitMqGateway struct {
-	conn   *amqp.Connection
-	ch     *amqp.Channel
-	logger *log.Logger
+	conn     *RabbitMqConnection
+	mu       sync.Mutex
+	ch       *publishChannel
+	exchange string
+	logger   *log.Logger
 }
 
-func NewMessageEventRabbitMqGateway(conn *amqp.Connection) *MessageEventRabbitMqGateway {
-	ch, _ := conn.Channel()
-
+func NewMessageEventRabbitMqGateway(conn *RabbitMqConnection, cfg *config.BrokerConfig) *MessageEventRabbitMqGateway {
 	return &MessageEventRabbitMqGateway{
-		conn:   conn,
-		ch:     ch,
-		logger: log.NewLogger("MessageRabbitMqGateway"),
+		conn:     conn,
+		exchange: cfg.Exchange,
+		logger:   log.NewLogger("MessageRabbitMqGateway"),
+	}
+}
+
+// publishResult is the outcome of a publishing reported by the broker.
+type publishResult struct {
+	acked    bool
+	returned bool
+	closed   bool
+}
+
+// publishChannel is a channel in confirm mode that matches the returns and
+// the confirmations of the broker with the publishings waiting for them. The
+// broker sends the return of an unroutable message before its confirmation
+// and both are read by a single goroutine, so a publishing is always marked as
+// returned before it is confirmed.
+type publishChannel struct {
+	ch       *amqp.Channel
+	mu       sync.Mutex
+	pending  map[uint64]*pendingPublishing
+	returned map[string]bool
+	closed   bool
+}
+
+type pendingPublishing struct {
+	messageId string
+	result    chan publishResult
+}
+
+func newPublishChannel(ch *amqp.Channel) *publishChannel {
+	c := &publishChannel{
+		ch:       ch,
+		pending:  make(map[uint64]*pendingPublishing),
+		returned: make(map[string]bool),
+	}
+
+	returns := ch.NotifyReturn(make(chan amqp.Return))
+	confirms := ch.NotifyPublish(make(chan amqp.Confirmation))
+
+	go c.dispatch(returns, confirms)
+
+	return c
+}
+
+func (c *publishChannel) dispatch(returns <-chan amqp.Return, confirms <-chan amqp.Confirmation) {
+	for {
+		select {
+		case r, ok := <-returns:
+			if !ok {
+				returns = nil
+				continue
+			}
+
+			c.mu.Lock()
+			c.returned[r.MessageId] = true
+			c.mu.Unlock()
+		case confirmation, ok := <-confirms:
+			if !ok {
+				c.close()
+				return
+			}
+
+			c.mu.Lock()
+			p, found := c.pending[confirmation.DeliveryTag]
+			delete(c.pending, confirmation.DeliveryTag)
+
+			var returned bool
+			if found {
+				returned = c.returned[p.messageId]
+				delete(c.returned, p.messageId)
+			}
+			c.mu.Unlock()
+
+			if found {
+				p.result <- publishResult{acked: confirmation.Ack, returned: returned}
+			}
+		}
+	}
+}
+
+// close reports the publishings that were not confirmed before the channel
+// was closed.
+func (c *publishChannel) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+
+	for tag, p := range c.pending {
+		p.result <- publishResult{closed: true}
+		delete(c.pending, tag)
+	}
+}
+
+// publish registers the publishing before sending it, so its confirmation is
+// never read before it is waited for. It must be called with the lock of the
+// gateway held, so the publishings get the sequence numbers in order.
+func (c *publishChannel) publish(
+	ctx context.Context,
+	exchange string,
+	key string,
+	msg amqp.Publishing,
+) (*pendingPublishing, error) {
+
+	p := &pendingPublishing{
+		messageId: msg.MessageId,
+		result:    make(chan publishResult, 1),
+	}
+
+	// The sequence number is read without the lock, the client holds its own
+	// lock while it hands a confirmation to the dispatching goroutine.
+	tag := c.ch.GetNextPublishSeqNo()
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, amqp.ErrClosed
+	}
+
+	c.pending[tag] = p
+	c.mu.Unlock()
+
+	err := c.ch.PublishWithContext(ctx, exchange, key, true, false, msg)
+	if err != nil {
+		c.mu.Lock()
+		delete(c.pending, tag)
+		c.mu.Unlock()
+
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// channel returns the publish channel, opening a new one when the previous was
+// closed by a broker error or a reconnection. It must be called with the lock
+// held.
+func (g *MessageEventRabbitMqGateway) channel() (*publishChannel, error) {
+	if g.ch != nil && !g.ch.ch.IsClosed() {
+		return g.ch, nil
+	}
+
+	ch, err := g.conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ch.Confirm(false)
+	if err != nil {
+		ch.Close()
+		return nil, err
 	}
+
+	g.ch = newPublishChannel(ch)
+
+	return g.ch, nil
 }
 
+// Send publishes the event as mandatory and waits for the broker to confirm
+// it. The lock is only held to publish, each publishing waits for its own
+// confirmation and is matched with its return by its message id. An event is
+// unroutable when no instance is subscribed to its room.
 func (g *MessageEventRabbitMqGateway) Send(ctx context.Context, messageEvent *event.MessageEvent) error {
 	body, err := json.Marshal(messageEvent)
 	if err != nil {
@@ -35,62 +197,80 @@ func (g *MessageEventRabbitMqGateway) Send(ctx context.Context, messageEvent *ev
 
 	msg := amqp.Publishing{
 		ContentType: "application/json",
+		MessageId:   valueobject.NewId().Value(),
 		Body:        body,
 	}
 
-	err = g.ch.PublishWithContext(
-		ctx,
-		"messages",
-		"",
-		false,
-		false,
-		msg,
-	)
+	p, err := g.publish(ctx, messageEvent.RoomId, msg)
 	if err != nil {
 		g.logger.Error(err)
 		return err
 	}
 
+	var result publishResult
+
+	select {
+	case <-ctx.Done():
+		g.logger.Error(ctx.Err())
+		return gateway.ErrEventNotConfirmed
+	case result = <-p.result:
+	}
+
+	if result.closed {
+		return gateway.ErrEventNotConfirmed
+	}
+
+	if !result.acked {
+		return gateway.ErrEventRejected
+	}
+
+	if result.returned {
+		return gateway.ErrEventUnroutable
+	}
+
 	return nil
 }
 
-func (g *MessageEventRabbitMqGateway) Receive(ctx context.Context, messageEvents chan<- *event.MessageEvent) error {
-	ch, err := g.conn.Channel()
+func (g *MessageEventRabbitMqGateway) publish(
+	ctx context.Context,
+	roomId string,
+	msg amqp.Publishing,
+) (*pendingPublishing, error) {
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ch, err := g.channel()
 	if err != nil {
-		g.logger.Error(err)
-		return err
+		return nil, err
 	}
-	defer ch.Close()
-
-	msgs, err := ch.Consume(
-		"messages.queue",
-		"message-rabbitmq-gateway",
-		false,
-		false,
-		false,
-		false,
-		nil,
-	)
+
+	p, err := ch.publish(ctx, g.exchange, roomId, msg)
 	if err != nil {
-		g.logger.Error(err)
-		return nil
+		ch.ch.Close()
+		g.ch = nil
+		return nil, err
 	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return nil
-		case msg := <-msgs:
-			messageEvent := &event.MessageEvent{}
-
-			err = json.Unmarshal(msg.Body, messageEvent)
-			if err != nil {
-				g.logger.Error(err)
-			} else {
-				messageEvents <- messageEvent
-			}
+	return p, nil
+}
 
-			msg.Ack(true)
-		}
+// Receive consumes the events of the room until the context is done. When the
+// consumer is lost it is started again after the connection is back. Each
+// subscribed room gets its own queue on this instance, so every instance
+// receives the events of the rooms its clients are in.
+func (g *MessageEventRabbitMqGateway) Receive(
+	ctx context.Context,
+	roomId string,
+	messageEvents chan<- *event.MessageEvent,
+	ready func(),
+) error {
+
+	queue := consumerQueue{
+		exchange:   g.exchange,
+		routingKey: roomId,
+		ack:        true,
 	}
+
+	return receive(ctx, g.conn, queue, messageEvents, ready, g.logger)
 }
@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,28 +13,38 @@ import (
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/test/services"
 
+	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
-var rabbitmqMessageEventGateway, _ = services.NewRabbitmqContainer(context.Background(), "../../../")
+var rabbitmqMessageEventGateway, _ = services.NewRabbitmqContainer(context.Background())
 
 type MessageEventRabbitMqGatewayTestSuite struct {
 	suite.Suite
 	ctx                 context.Context
+	cfg                 *config.BrokerConfig
+	conn                *RabbitMqConnection
 	messageEventGateway gateway.MessageEventGateway
 }
 
 func (s *MessageEventRabbitMqGatewayTestSuite) SetupSuite() {
-	conn := RabbitMqConnection(&config.BrokerConfig{
-		Host:     rabbitmqMessageEventGateway.Host,
-		Port:     rabbitmqMessageEventGateway.Port,
-		User:     rabbitmqMessageEventGateway.User,
-		Password: rabbitmqMessageEventGateway.Password,
-	})
+	cfg := &config.BrokerConfig{
+		Host:             rabbitmqMessageEventGateway.Host,
+		Port:             rabbitmqMessageEventGateway.Port,
+		User:             rabbitmqMessageEventGateway.User,
+		Password:         rabbitmqMessageEventGateway.Password,
+		Exchange:         "messages",
+		TypingExchange:   "typing",
+		PresenceExchange: "presence",
+	}
+
+	conn := NewRabbitMqConnection(cfg)
 
 	s.ctx = context.Background()
-	s.messageEventGateway = NewMessageEventRabbitMqGateway(conn)
+	s.cfg = cfg
+	s.conn = conn
+	s.messageEventGateway = NewMessageEventRabbitMqGateway(conn, cfg)
 }
 
 func (s *MessageEventRabbitMqGatewayTestSuite) TearDownSuite() {
@@ -56,19 +67,28 @@ func (s *MessageEventRabbitMqGatewayTestSuite) TestShouldSendAndReceiveAMessage(
 	message := entity.NewMessage(roomId, senderId, senderName, text)
 	messageEvent := event.NewMessageEvent(message)
 
-	err := s.messageEventGateway.Send(s.ctx, messageEvent)
-	assert.Nil(t, err)
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
 
 	msgs := make(chan *event.MessageEvent)
-	defer close(msgs)
+	ready := make(chan struct{})
 
 	go func() {
-		err = s.messageEventGateway.Receive(s.ctx, msgs)
+		err := s.messageEventGateway.Receive(ctx, roomId.Value(), msgs, func() { close(ready) })
 		if err != nil {
 			t.Error(err)
 		}
 	}()
 
+	select {
+	case <-ready:
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+
+	err := s.messageEventGateway.Send(s.ctx, messageEvent)
+	assert.Nil(t, err)
+
 	select {
 	case msg := <-msgs:
 		assert.Equal(t, message.Id().Value(), msg.Id)
@@ -81,3 +101,206 @@ func (s *MessageEventRabbitMqGatewayTestSuite) TestShouldSendAndReceiveAMessage(
 		t.Fail()
 	}
 }
+
+func (s *MessageEventRabbitMqGatewayTestSuite) TestShouldReceiveOnlyTheMessagesOfTheRoom() {
+	t := s.T()
+
+	roomId := valueobject.NewId()
+	otherRoomId := valueobject.NewId()
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533g")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	msgs := make(chan *event.MessageEvent)
+	ready := make(chan struct{})
+
+	go func() {
+		err := s.messageEventGateway.Receive(ctx, roomId.Value(), msgs, func() { close(ready) })
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+
+	otherMessage := entity.NewMessage(otherRoomId, senderId, senderName, text)
+	err := s.messageEventGateway.Send(s.ctx, event.NewMessageEvent(otherMessage))
+	assert.Nil(t, err)
+
+	message := entity.NewMessage(roomId, senderId, senderName, text)
+	err = s.messageEventGateway.Send(s.ctx, event.NewMessageEvent(message))
+	assert.Nil(t, err)
+
+	select {
+	case msg := <-msgs:
+		assert.Equal(t, message.Id().Value(), msg.Id)
+	case <-time.After(10 * time.Second):
+		t.Fail()
+	}
+}
+
+func (s *MessageEventRabbitMqGatewayTestSuite) TestShouldDeclareTheTopologyIdempotently() {
+	t := s.T()
+
+	err := DeclareRabbitMqTopology(s.conn.conn, s.cfg)
+	assert.Nil(t, err)
+
+	ch, err := s.conn.Channel()
+	assert.Nil(t, err)
+	defer ch.Close()
+
+	err = ch.ExchangeDeclarePassive(s.cfg.Exchange, amqp.ExchangeTopic, true, false, false, false, nil)
+	assert.Nil(t, err)
+
+	err = ch.ExchangeDeclarePassive(s.cfg.TypingExchange, amqp.ExchangeTopic, false, false, false, false, nil)
+	assert.Nil(t, err)
+
+	err = ch.ExchangeDeclarePassive(s.cfg.PresenceExchange, amqp.ExchangeTopic, false, false, false, false, nil)
+	assert.Nil(t, err)
+}
+
+func (s *MessageEventRabbitMqGatewayTestSuite) TestShouldReplaceTheLegacyDirectExchange() {
+	t := s.T()
+
+	ch, err := s.conn.Channel()
+	assert.Nil(t, err)
+	defer ch.Close()
+
+	cfg := *s.cfg
+	cfg.Exchange = "messages.legacy"
+
+	err = ch.ExchangeDeclare(cfg.Exchange, amqp.ExchangeDirect, true, false, false, false, nil)
+	assert.Nil(t, err)
+
+	err = DeclareRabbitMqTopology(s.conn.conn, &cfg)
+	assert.Nil(t, err)
+
+	// A declaration with another type than the existing exchange closes the
+	// channel, so it only succeeds when the exchange was replaced.
+	err = ch.ExchangeDeclare(cfg.Exchange, amqp.ExchangeTopic, true, false, false, false, nil)
+	assert.Nil(t, err)
+}
+
+func (s *MessageEventRabbitMqGatewayTestSuite) TestShouldResumeReceivingAfterReconnecting() {
+	t := s.T()
+
+	roomId := valueobject.NewId()
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533g")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(roomId, senderId, senderName, text)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	msgs := make(chan *event.MessageEvent)
+	ready := make(chan struct{})
+
+	go func() {
+		err := s.messageEventGateway.Receive(ctx, roomId.Value(), msgs, func() { close(ready) })
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+
+	reconnected := s.conn.Reconnected()
+
+	s.conn.mu.RLock()
+	conn := s.conn.conn
+	s.conn.mu.RUnlock()
+
+	conn.Close()
+
+	select {
+	case <-reconnected:
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	assert.False(t, s.conn.IsClosed())
+	assert.False(t, s.conn.IsReconnecting())
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	timeout := time.After(30 * time.Second)
+
+	// The consumer is resumed asynchronously, so send until it receives.
+	for {
+		select {
+		case msg := <-msgs:
+			assert.Equal(t, message.Id().Value(), msg.Id)
+			return
+		case <-ticker.C:
+			err := s.messageEventGateway.Send(s.ctx, event.NewMessageEvent(message))
+			assert.Nil(t, err)
+		case <-timeout:
+			t.FailNow()
+		}
+	}
+}
+
+func (s *MessageEventRabbitMqGatewayTestSuite) TestShouldReturnAnErrorWhenTheMessageIsUnroutable() {
+	t := s.T()
+
+	roomId := valueobject.NewId()
+	unroutableRoomId := valueobject.NewId()
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533g")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	msgs := make(chan *event.MessageEvent, 10)
+	ready := make(chan struct{})
+
+	go s.messageEventGateway.Receive(ctx, roomId.Value(), msgs, func() { close(ready) })
+
+	select {
+	case <-ready:
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+
+	// The returns are matched with their own publishings, so the routed
+	// messages sent along with the unroutable ones are not reported.
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		routed := i%2 == 0
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			id := unroutableRoomId
+			if routed {
+				id = roomId
+			}
+
+			err := s.messageEventGateway.Send(s.ctx, event.NewMessageEvent(entity.NewMessage(id, senderId, senderName, text)))
+			if routed {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorIs(t, err, gateway.ErrEventUnroutable)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,52 @@
+package event
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+// PresenceEventRabbitMqGateway shares the presence of the sessions between the
+// instances through an ephemeral exchange. A lost event is replaced by the
+// next heartbeat of the session.
+type PresenceEventRabbitMqGateway struct {
+	conn      *RabbitMqConnection
+	publisher *ephemeralPublisher
+	exchange  string
+	logger    *log.Logger
+}
+
+func NewPresenceEventRabbitMqGateway(conn *RabbitMqConnection, cfg *config.BrokerConfig) *PresenceEventRabbitMqGateway {
+	logger := log.NewLogger("PresenceRabbitMqGateway")
+
+	return &PresenceEventRabbitMqGateway{
+		conn:      conn,
+		publisher: newEphemeralPublisher(conn, cfg.PresenceExchange, entity.PresenceTimeout, logger),
+		exchange:  cfg.PresenceExchange,
+		logger:    logger,
+	}
+}
+
+func (g *PresenceEventRabbitMqGateway) Send(ctx context.Context, presenceEvent *event.PresenceEvent) error {
+	return g.publisher.publish(ctx, presenceEvent.RoomId, presenceEvent)
+}
+
+// Receive consumes the presence events of every room until the context is done.
+// When the consumer is lost it is started again after the connection is back.
+func (g *PresenceEventRabbitMqGateway) Receive(
+	ctx context.Context,
+	presenceEvents chan<- *event.PresenceEvent,
+	ready func(),
+) error {
+
+	queue := consumerQueue{
+		exchange:   g.exchange,
+		routingKey: "#",
+		messageTtl: entity.PresenceTimeout,
+	}
+
+	return receive(ctx, g.conn, queue, presenceEvents, ready, g.logger)
+}
@@ -0,0 +1,100 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var rabbitmqPresenceEventGateway, _ = services.NewRabbitmqContainer(context.Background())
+
+type PresenceEventRabbitMqGatewayTestSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	presenceEventGateway gateway.PresenceEventGateway
+}
+
+func (s *PresenceEventRabbitMqGatewayTestSuite) SetupSuite() {
+	cfg := &config.BrokerConfig{
+		Host:             rabbitmqPresenceEventGateway.Host,
+		Port:             rabbitmqPresenceEventGateway.Port,
+		User:             rabbitmqPresenceEventGateway.User,
+		Password:         rabbitmqPresenceEventGateway.Password,
+		Exchange:         "messages",
+		TypingExchange:   "typing",
+		PresenceExchange: "presence",
+	}
+
+	conn := NewRabbitMqConnection(cfg)
+
+	s.ctx = context.Background()
+	s.presenceEventGateway = NewPresenceEventRabbitMqGateway(conn, cfg)
+}
+
+func (s *PresenceEventRabbitMqGatewayTestSuite) TearDownSuite() {
+	if err := rabbitmqPresenceEventGateway.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating rabbitmq container: %s", err)
+	}
+}
+
+func TestPresenceEventRabbitMqGatewayTestSuite(t *testing.T) {
+	suite.Run(t, new(PresenceEventRabbitMqGatewayTestSuite))
+}
+
+func (s *PresenceEventRabbitMqGatewayTestSuite) TestShouldSendAndReceivePresenceEventsOfEveryRoom() {
+	t := s.T()
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533g")
+	presenceEvent := event.NewPresenceEvent(entity.NewPresence(valueobject.NewId(), userId, "a session", valueobject.NewPresenceStatus()))
+	anotherPresenceEvent := event.NewPresenceEvent(entity.NewPresence(valueobject.NewId(), userId, "a session", valueobject.NewPresenceStatus()))
+
+	err := s.presenceEventGateway.Send(s.ctx, presenceEvent)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	presenceEvents := make(chan *event.PresenceEvent)
+	ready := make(chan struct{})
+
+	go func() {
+		err := s.presenceEventGateway.Receive(ctx, presenceEvents, func() { close(ready) })
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	for _, sent := range []*event.PresenceEvent{presenceEvent, anotherPresenceEvent} {
+		err = s.presenceEventGateway.Send(s.ctx, sent)
+		assert.Nil(t, err)
+
+		select {
+		case received := <-presenceEvents:
+			assert.Equal(t, sent, received)
+		case <-time.After(30 * time.Second):
+			t.FailNow()
+		}
+	}
+
+	select {
+	case <-presenceEvents:
+		t.Fatal("presence event sent without consumers was delivered")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,104 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// DeclareRabbitMqTopology declares the exchanges used by the gateways. The
+// queues are declared by each instance when it subscribes to a room, so there
+// is no durable queue to fill up while nobody consumes it. Declarations are
+// idempotent, so it runs on every startup. Policies, such as the queue
+// mirroring, stay in the broker definitions of rabbitmq.json.
+func DeclareRabbitMqTopology(conn *amqp.Connection, cfg *config.BrokerConfig) error {
+	err := declareMessageExchange(conn, cfg.Exchange)
+	if err != nil {
+		return err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	// Typing events are ephemeral, the exchange is not durable and has no
+	// durable queue, so they are only delivered to the connected instances.
+	err = ch.ExchangeDeclare(
+		cfg.TypingExchange,
+		amqp.ExchangeTopic,
+		false,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Presence events are ephemeral as well, every instance consumes all of
+	// them to keep the presence of the users in memory.
+	err = ch.ExchangeDeclare(
+		cfg.PresenceExchange,
+		amqp.ExchangeTopic,
+		false,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// declareMessageExchange declares the topic exchange of the message events.
+// Brokers set up by the former rabbitmq.json have a direct exchange with the
+// same name, which can not be declared again with another type, so it is
+// deleted and declared again as a topic exchange.
+func declareMessageExchange(conn *amqp.Connection, exchange string) error {
+	err := declareTopicExchange(conn, exchange)
+
+	var amqpErr *amqp.Error
+	if !errors.As(err, &amqpErr) || amqpErr.Code != amqp.PreconditionFailed {
+		return err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	err = ch.ExchangeDelete(exchange, false, false)
+	if err != nil {
+		return err
+	}
+
+	return declareTopicExchange(conn, exchange)
+}
+
+// declareTopicExchange uses its own channel, a failed declaration closes it.
+func declareTopicExchange(conn *amqp.Connection, exchange string) error {
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	return ch.ExchangeDeclare(
+		exchange,
+		amqp.ExchangeTopic,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+}
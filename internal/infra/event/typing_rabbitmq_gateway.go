@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+// TypingEventRabbitMqGateway publishes the typing events to an ephemeral
+// exchange. Events expire with the typing, losing one only hides a typing
+// indicator for a moment.
+type TypingEventRabbitMqGateway struct {
+	conn      *RabbitMqConnection
+	publisher *ephemeralPublisher
+	exchange  string
+	logger    *log.Logger
+}
+
+func NewTypingEventRabbitMqGateway(conn *RabbitMqConnection, cfg *config.BrokerConfig) *TypingEventRabbitMqGateway {
+	logger := log.NewLogger("TypingRabbitMqGateway")
+
+	return &TypingEventRabbitMqGateway{
+		conn:      conn,
+		publisher: newEphemeralPublisher(conn, cfg.TypingExchange, entity.TypingDuration, logger),
+		exchange:  cfg.TypingExchange,
+		logger:    logger,
+	}
+}
+
+func (g *TypingEventRabbitMqGateway) Send(ctx context.Context, typingEvent *event.TypingEvent) error {
+	return g.publisher.publish(ctx, typingEvent.RoomId, typingEvent)
+}
+
+// Receive consumes the typing events of the room until the context is done.
+// When the consumer is lost it is started again after the connection is back.
+func (g *TypingEventRabbitMqGateway) Receive(
+	ctx context.Context,
+	roomId string,
+	typingEvents chan<- *event.TypingEvent,
+	ready func(),
+) error {
+
+	queue := consumerQueue{
+		exchange:   g.exchange,
+		routingKey: roomId,
+		messageTtl: entity.TypingDuration,
+	}
+
+	return receive(ctx, g.conn, queue, typingEvents, ready, g.logger)
+}
@@ -0,0 +1,99 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/config"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/test/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var rabbitmqTypingEventGateway, _ = services.NewRabbitmqContainer(context.Background())
+
+type TypingEventRabbitMqGatewayTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	typingEventGateway gateway.TypingEventGateway
+}
+
+func (s *TypingEventRabbitMqGatewayTestSuite) SetupSuite() {
+	cfg := &config.BrokerConfig{
+		Host:             rabbitmqTypingEventGateway.Host,
+		Port:             rabbitmqTypingEventGateway.Port,
+		User:             rabbitmqTypingEventGateway.User,
+		Password:         rabbitmqTypingEventGateway.Password,
+		Exchange:         "messages",
+		TypingExchange:   "typing",
+		PresenceExchange: "presence",
+	}
+
+	conn := NewRabbitMqConnection(cfg)
+
+	s.ctx = context.Background()
+	s.typingEventGateway = NewTypingEventRabbitMqGateway(conn, cfg)
+}
+
+func (s *TypingEventRabbitMqGatewayTestSuite) TearDownSuite() {
+	if err := rabbitmqTypingEventGateway.Terminate(s.ctx); err != nil {
+		s.T().Fatalf("error terminating rabbitmq container: %s", err)
+	}
+}
+
+func TestTypingEventRabbitMqGatewayTestSuite(t *testing.T) {
+	suite.Run(t, new(TypingEventRabbitMqGatewayTestSuite))
+}
+
+func (s *TypingEventRabbitMqGatewayTestSuite) TestShouldSendAndReceiveATypingEvent() {
+	t := s.T()
+
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533g")
+	userName, _ := valueobject.NewUserNameWith("An username")
+	typingEvent := event.NewTypingEvent(entity.NewTyping(roomId, userId, userName))
+
+	err := s.typingEventGateway.Send(s.ctx, typingEvent)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	typingEvents := make(chan *event.TypingEvent)
+	ready := make(chan struct{})
+
+	go func() {
+		err := s.typingEventGateway.Receive(ctx, roomId.Value(), typingEvents, func() { close(ready) })
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	err = s.typingEventGateway.Send(s.ctx, typingEvent)
+	assert.Nil(t, err)
+
+	select {
+	case received := <-typingEvents:
+		assert.Equal(t, typingEvent, received)
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	select {
+	case <-typingEvents:
+		t.Fatal("typing event sent without consumers was delivered")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
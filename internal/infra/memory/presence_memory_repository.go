@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// sessions keeps the presences of a user in a room by session id.
+type sessions map[string]*entity.Presence
+
+// PresenceMemoryRepository keeps the presences of the sessions in the memory
+// of the instance. It is enough for a single instance, and every instance
+// fills its own copy from the presence events shared through the broker.
+type PresenceMemoryRepository struct {
+	mu    sync.RWMutex
+	rooms map[string]map[string]sessions
+}
+
+func NewPresenceMemoryRepository() *PresenceMemoryRepository {
+	return &PresenceMemoryRepository{
+		rooms: make(map[string]map[string]sessions),
+	}
+}
+
+func (r *PresenceMemoryRepository) Save(ctx context.Context, presence *entity.Presence) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users, ok := r.rooms[presence.RoomId().Value()]
+	if !ok {
+		users = make(map[string]sessions)
+		r.rooms[presence.RoomId().Value()] = users
+	}
+
+	userSessions, ok := users[presence.UserId().Value()]
+	if !ok {
+		userSessions = make(sessions)
+		users[presence.UserId().Value()] = userSessions
+	}
+
+	userSessions[presence.SessionId()] = presence
+
+	return nil
+}
+
+func (r *PresenceMemoryRepository) Delete(ctx context.Context, presence *entity.Presence) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users, ok := r.rooms[presence.RoomId().Value()]
+	if !ok {
+		return nil
+	}
+
+	userSessions, ok := users[presence.UserId().Value()]
+	if !ok {
+		return nil
+	}
+
+	delete(userSessions, presence.SessionId())
+
+	if len(userSessions) == 0 {
+		delete(users, presence.UserId().Value())
+	}
+
+	if len(users) == 0 {
+		delete(r.rooms, presence.RoomId().Value())
+	}
+
+	return nil
+}
+
+func (r *PresenceMemoryRepository) FindByRoomAndUser(
+	ctx context.Context,
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) (*entity.Presence, error) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	userSessions, ok := r.rooms[roomId.Value()][userId.Value()]
+	if !ok {
+		return nil, repository.ErrNotFoundPresence
+	}
+
+	return userSessions.merge(), nil
+}
+
+func (r *PresenceMemoryRepository) ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.Presence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := r.rooms[roomId.Value()]
+	presences := make([]*entity.Presence, 0, len(users))
+
+	for _, userSessions := range users {
+		presences = append(presences, userSessions.merge())
+	}
+
+	sort.Slice(presences, func(i, j int) bool {
+		return presences[i].UserId().Value() < presences[j].UserId().Value()
+	})
+
+	return presences, nil
+}
+
+func (r *PresenceMemoryRepository) ListExpired(ctx context.Context) ([]*entity.Presence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	presences := make([]*entity.Presence, 0)
+
+	for _, users := range r.rooms {
+		for _, userSessions := range users {
+			for _, presence := range userSessions {
+				if presence.IsExpired() {
+					presences = append(presences, presence)
+				}
+			}
+		}
+	}
+
+	return presences, nil
+}
+
+func (s sessions) merge() *entity.Presence {
+	presences := make([]*entity.Presence, 0, len(s))
+
+	for _, presence := range s {
+		presences = append(presences, presence)
+	}
+
+	return entity.MergePresences(presences)
+}
@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresenceMemoryRepository_ShouldMergeTheSessionsOfTheUsers(t *testing.T) {
+	ctx := context.Background()
+	repo := NewPresenceMemoryRepository()
+
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	anotherUserId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	online := valueobject.NewPresenceStatus()
+	away, _ := valueobject.NewPresenceStatusWith(valueobject.PresenceStatusAway)
+
+	onlineSession := entity.NewPresence(roomId, userId, "a session", online)
+	awaySession := entity.NewPresence(roomId, userId, "another session", away)
+
+	assert.Nil(t, repo.Save(ctx, onlineSession))
+	assert.Nil(t, repo.Save(ctx, awaySession))
+	assert.Nil(t, repo.Save(ctx, entity.NewPresence(roomId, anotherUserId, "a session", away)))
+
+	presence, err := repo.FindByRoomAndUser(ctx, roomId, userId)
+	assert.Nil(t, err)
+	assert.True(t, presence.Status().IsOnline())
+
+	presences, err := repo.ListByRoom(ctx, roomId)
+	assert.Nil(t, err)
+	assert.Len(t, presences, 2)
+	assert.Equal(t, userId.Value(), presences[0].UserId().Value())
+	assert.True(t, presences[0].Status().IsOnline())
+	assert.Equal(t, anotherUserId.Value(), presences[1].UserId().Value())
+	assert.True(t, presences[1].Status().IsAway())
+
+	assert.Nil(t, repo.Delete(ctx, onlineSession))
+
+	presence, err = repo.FindByRoomAndUser(ctx, roomId, userId)
+	assert.Nil(t, err)
+	assert.True(t, presence.Status().IsAway())
+
+	presences, err = repo.ListByRoom(ctx, valueobject.NewId())
+	assert.Nil(t, err)
+	assert.Empty(t, presences)
+}
+
+func TestPresenceMemoryRepository_ShouldReturnAnErrorWhenTheUserHasNoSessions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewPresenceMemoryRepository()
+
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+
+	presence := entity.NewPresence(roomId, userId, "a session", valueobject.NewPresenceStatus())
+
+	assert.Nil(t, repo.Save(ctx, presence))
+	assert.Nil(t, repo.Delete(ctx, presence))
+	assert.Nil(t, repo.Delete(ctx, presence))
+
+	_, err := repo.FindByRoomAndUser(ctx, roomId, userId)
+	assert.ErrorIs(t, err, repository.ErrNotFoundPresence)
+}
+
+func TestPresenceMemoryRepository_ShouldListTheExpiredSessions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewPresenceMemoryRepository()
+
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	status := valueobject.NewPresenceStatus()
+
+	seenAt := valueobject.NewTimestamp().Add(-entity.PresenceTimeout)
+	expired := entity.NewPresenceWith(roomId, userId, "a session", status, seenAt)
+
+	repo.Save(ctx, expired)
+	repo.Save(ctx, entity.NewPresence(roomId, userId, "another session", status))
+
+	presences, err := repo.ListExpired(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, presences, 1)
+	assert.Equal(t, expired.SessionId(), presences[0].SessionId())
+}
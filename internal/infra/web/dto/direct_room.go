@@ -0,0 +1,19 @@
+package dto
+
+type DirectRoomRequest struct {
+	UserId string `json:"user_id"`
+}
+
+type DirectRoomResponse struct {
+	RoomId      string           `json:"room_id"`
+	UserId      string           `json:"user_id"`
+	CreatedAt   string           `json:"created_at"`
+	LastMessage *MessageResponse `json:"last_message,omitempty"`
+}
+
+type DirectRoomPage struct {
+	Page  int                   `json:"page"`
+	Size  int                   `json:"size"`
+	Total int64                 `json:"total"`
+	Rooms []*DirectRoomResponse `json:"rooms"`
+}
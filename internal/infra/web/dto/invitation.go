@@ -0,0 +1,13 @@
+package dto
+
+type InvitationRequest struct {
+	InviteeId string `json:"invitee_id"`
+}
+
+type InvitationResponse struct {
+	Id        string `json:"id"`
+	RoomId    string `json:"room_id"`
+	InviteeId string `json:"invitee_id"`
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+type MemberResponse struct {
+	UserId   string `json:"user_id"`
+	Role     string `json:"role"`
+	JoinedAt string `json:"joined_at"`
+}
+
+type MemberPage struct {
+	Page    int               `json:"page"`
+	Size    int               `json:"size"`
+	Total   int64             `json:"total"`
+	Members []*MemberResponse `json:"members"`
+}
+
+type BanRequest struct {
+	UserId    string `json:"user_id"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+type MuteRequest struct {
+	UserId    string `json:"user_id"`
+	ExpiresAt string `json:"expires_at"`
+}
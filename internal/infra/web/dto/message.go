@@ -1,5 +1,67 @@
 package dto
 
 type MessageRequest struct {
-	Text string `json:"text"`
+	Text     string `json:"text"`
+	ParentId string `json:"parent_id"`
+}
+
+type MessageResponse struct {
+	Id         string `json:"id"`
+	RoomId     string `json:"room_id"`
+	SenderId   string `json:"sender_id"`
+	SenderName string `json:"sender_name"`
+	Text       string `json:"text"`
+	CreatedAt  string `json:"created_at"`
+	EditedAt   string `json:"edited_at,omitempty"`
+	DeletedAt  string `json:"deleted_at,omitempty"`
+
+	ParentId    string `json:"parent_id,omitempty"`
+	ReplyCount  int    `json:"reply_count,omitempty"`
+	LastReplyAt string `json:"last_reply_at,omitempty"`
+
+	Reactions []*ReactionResponse `json:"reactions,omitempty"`
+}
+
+type ReactionResponse struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+type MessageRevisionResponse struct {
+	Id        string `json:"id"`
+	Text      string `json:"text"`
+	CreatedAt string `json:"created_at"`
+}
+
+type MessagePage struct {
+	Size     int                `json:"size"`
+	Before   string             `json:"before"`
+	After    string             `json:"after"`
+	Messages []*MessageResponse `json:"messages"`
+}
+
+type ReadRequest struct {
+	MessageId string `json:"message_id"`
+}
+
+type ReaderResponse struct {
+	UserId string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+type ReaderPage struct {
+	Page    int               `json:"page"`
+	Size    int               `json:"size"`
+	Total   int64             `json:"total"`
+	Readers []*ReaderResponse `json:"readers"`
+}
+
+const (
+	TypingFrame   = "typing"
+	PresenceFrame = "presence"
+)
+
+type Frame struct {
+	Type   string `json:"type"`
+	Status string `json:"status,omitempty"`
 }
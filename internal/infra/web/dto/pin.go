@@ -0,0 +1,7 @@
+package dto
+
+type PinResponse struct {
+	Message  *MessageResponse `json:"message"`
+	PinnedBy string           `json:"pinned_by"`
+	PinnedAt string           `json:"pinned_at"`
+}
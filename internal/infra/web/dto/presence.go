@@ -0,0 +1,11 @@
+package dto
+
+type PresenceRequest struct {
+	Status string `json:"status"`
+}
+
+type PresenceResponse struct {
+	UserId string `json:"user_id"`
+	Status string `json:"status"`
+	SeenAt string `json:"seen_at"`
+}
@@ -1,14 +1,16 @@
 package dto
 
 type RoomRequest struct {
-	Name     string `json:"name"`
-	Category string `json:"category"`
+	Name       string `json:"name"`
+	Category   string `json:"category"`
+	Visibility string `json:"visibility,omitempty"`
 }
 
 type RoomResponse struct {
-	Id       string `json:"id"`
-	Name     string `json:"name"`
-	Category string `json:"category"`
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Category   string `json:"category"`
+	Visibility string `json:"visibility"`
 }
 
 type RoomPage struct {
@@ -17,3 +19,15 @@ type RoomPage struct {
 	Total int64           `json:"total"`
 	Rooms []*RoomResponse `json:"rooms"`
 }
+
+type TransferOwnershipRequest struct {
+	AdminId string `json:"admin_id"`
+}
+
+type OwnershipTransferResponse struct {
+	Id              string `json:"id"`
+	PreviousAdminId string `json:"previous_admin_id"`
+	AdminId         string `json:"admin_id"`
+	TransferredBy   string `json:"transferred_by"`
+	TransferredAt   string `json:"transferred_at"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+type UserRoomResponse struct {
+	Id             string           `json:"id"`
+	Name           string           `json:"name"`
+	Category       string           `json:"category"`
+	Visibility     string           `json:"visibility"`
+	Role           string           `json:"role"`
+	LastActivityAt string           `json:"last_activity_at"`
+	UnreadCount    int64            `json:"unread_count"`
+	LastMessage    *MessageResponse `json:"last_message,omitempty"`
+}
+
+type UserRoomPage struct {
+	Page  int                 `json:"page"`
+	Size  int                 `json:"size"`
+	Total int64               `json:"total"`
+	Rooms []*UserRoomResponse `json:"rooms"`
+}
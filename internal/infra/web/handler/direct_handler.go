@@ -0,0 +1,10 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type DirectHandler interface {
+	OpenDirectRoom(c *gin.Context)
+	ListDirectRooms(c *gin.Context)
+}
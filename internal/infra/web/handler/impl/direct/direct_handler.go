@@ -0,0 +1,24 @@
+package direct
+
+import (
+	usecase "github.com/sesaquecruz/go-chat-api/internal/usecase"
+
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type DirectHandler struct {
+	openDirectRoomUseCase  usecase.OpenDirectRoomUseCase
+	listDirectRoomsUseCase usecase.ListDirectRoomsUseCase
+	logger                 *log.Logger
+}
+
+func NewDirectHandler(
+	openDirectRoomUseCase usecase.OpenDirectRoomUseCase,
+	listDirectRoomsUseCase usecase.ListDirectRoomsUseCase,
+) *DirectHandler {
+	return &DirectHandler{
+		openDirectRoomUseCase:  openDirectRoomUseCase,
+		listDirectRoomsUseCase: listDirectRoomsUseCase,
+		logger:                 log.NewLogger("DirectHandler"),
+	}
+}
@@ -0,0 +1,88 @@
+package direct
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListDirectRooms godoc
+//
+// @Summary		List direct rooms
+// @Description	List the direct rooms of the user with a preview of their last message, the most recently active first.
+// @Tags		dms
+// @Accept		json
+// @Produce		json
+// @Param		page				query				string	false	"Page"			default(0)
+// @Param		size				query				string	false	"Size"			default(10)
+// @Success		200	{object}		dto.DirectRoomPage
+// @Failure		400 {object}		dto.HttpError
+// @Failure		401
+// @Failure		500
+// @Security	Bearer token
+// @Router		/dms				[get]
+func (h *DirectHandler) ListDirectRooms(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListDirectRoomsUseCaseInput{
+		UserId: jwtClaims.Subject,
+		Page:   c.Query("page"),
+		Size:   c.Query("size"),
+	}
+
+	output, err := h.listDirectRoomsUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	mapper := func(d *usecase.ListDirectRoomsUseCaseOutput) *dto.DirectRoomResponse {
+		response := &dto.DirectRoomResponse{
+			RoomId:    d.RoomId,
+			UserId:    d.RecipientId,
+			CreatedAt: d.CreatedAt,
+		}
+
+		if m := d.LastMessage; m != nil {
+			response.LastMessage = &dto.MessageResponse{
+				Id:         m.MessageId,
+				RoomId:     d.RoomId,
+				SenderId:   m.SenderId,
+				SenderName: m.SenderName,
+				Text:       m.Text,
+				CreatedAt:  m.CreatedAt,
+				EditedAt:   m.EditedAt,
+				DeletedAt:  m.DeletedAt,
+			}
+		}
+
+		return response
+	}
+
+	result := pagination.MapPage[*usecase.ListDirectRoomsUseCaseOutput, *dto.DirectRoomResponse](output, mapper)
+
+	page := &dto.DirectRoomPage{
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+		Rooms: result.Items,
+	}
+
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,79 @@
+package direct
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenDirectRoom godoc
+//
+// @Summary		Open a direct room
+// @Description	Find or create the direct room between the user and another user. A direct room is a private room with the two users as members,
+// @Description	so its messages are sent, listed and streamed by the room endpoints.
+// @Tags		dms
+// @Accept		json
+// @Produce		json
+// @Param		dm					body			dto.DirectRoomRequest	true	"Recipient"
+// @Success		200	{object}		dto.DirectRoomResponse
+// @Success		201	{object}		dto.DirectRoomResponse
+// @Failure		400
+// @Failure		401
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/dms 				[post]
+func (h *DirectHandler) OpenDirectRoom(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var requestBody dto.DirectRoomRequest
+
+	err = c.BindJSON(&requestBody)
+	if err != nil {
+		return
+	}
+
+	input := &usecase.OpenDirectRoomUseCaseInput{
+		UserId:      jwtClaims.Subject,
+		RecipientId: requestBody.UserId,
+	}
+
+	output, err := h.openDirectRoomUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := &dto.DirectRoomResponse{
+		RoomId:    output.RoomId,
+		UserId:    output.RecipientId,
+		CreatedAt: output.CreatedAt,
+	}
+
+	if !output.Created {
+		c.JSON(http.StatusOK, responseBody)
+		return
+	}
+
+	location := fmt.Sprintf("%s/rooms/%s", strings.TrimSuffix(c.Request.URL.Path, "/dms"), output.RoomId)
+
+	c.Header("Location", location)
+	c.JSON(http.StatusCreated, responseBody)
+}
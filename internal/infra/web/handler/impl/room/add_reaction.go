@@ -0,0 +1,76 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AddReaction godoc
+//
+// @Summary		Add a reaction
+// @Description	Add the reaction of the user to a message of the chat room and notify the room members.
+// @Description	Adding a reaction the user already added has no effect.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Param		messageId			path			string	true	"Message Id"
+// @Param		emoji				path			string	true	"Emoji"
+// @Success		204
+// @Failure		400
+// @Failure		401	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages/{messageId}/reactions/{emoji}	[put]
+func (h *RoomHandler) AddReaction(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.AddReactionUseCaseInput{
+		RoomId:    c.Param("id"),
+		MessageId: c.Param("messageId"),
+		UserId:    jwtClaims.Subject,
+		Emoji:     c.Param("emoji"),
+	}
+
+	err = h.addReactionUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnauthorized, err)
+			return
+		}
+
+		if _, ok := err.(validation.ForbiddenError); ok {
+			dto.AbortWithHttpError(c, http.StatusForbidden, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
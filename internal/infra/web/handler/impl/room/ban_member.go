@@ -0,0 +1,81 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BanMember godoc
+//
+// @Summary		Ban a user
+// @Description	Ban a user from a chat room, removing the membership. The ban is permanent when no expiration is informed. Only the room owner and moderators can ban users with a lower role.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Param		ban					body			dto.BanRequest			true	"Banned user"
+// @Success		204
+// @Failure		400
+// @Failure		401
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/bans	[post]
+func (h *RoomHandler) BanMember(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var requestBody dto.BanRequest
+
+	err = c.BindJSON(&requestBody)
+	if err != nil {
+		return
+	}
+
+	input := &usecase.BanMemberUseCaseInput{
+		RoomId:    c.Param("id"),
+		UserId:    jwtClaims.Subject,
+		MemberId:  requestBody.UserId,
+		ExpiresAt: requestBody.ExpiresAt,
+	}
+
+	err = h.banMemberUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.ForbiddenError); ok {
+			dto.AbortWithHttpError(c, http.StatusForbidden, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
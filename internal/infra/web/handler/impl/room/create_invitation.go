@@ -0,0 +1,83 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateInvitation godoc
+//
+// @Summary		Invite a user to a room
+// @Description	Invite a user to a chat room if the user is a room owner or moderator. The invitation token is used to accept or decline the invitation and expires after 7 days.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string					true	"Room Id"
+// @Param		invitation			body			dto.InvitationRequest	true	"Invitation"
+// @Success		201	{object}		dto.InvitationResponse
+// @Failure		400
+// @Failure		401
+// @Failure		401	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/invitations	[post]
+func (h *RoomHandler) CreateInvitation(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var requestBody dto.InvitationRequest
+
+	err = c.BindJSON(&requestBody)
+	if err != nil {
+		return
+	}
+
+	input := &usecase.CreateInvitationUseCaseInput{
+		RoomId:    c.Param("id"),
+		InviterId: jwtClaims.Subject,
+		InviteeId: requestBody.InviteeId,
+	}
+
+	output, err := h.createInvitationUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnauthorized, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := &dto.InvitationResponse{
+		Id:        output.Id,
+		RoomId:    output.RoomId,
+		InviteeId: output.InviteeId,
+		Token:     output.Token,
+		ExpiresAt: output.ExpiresAt,
+	}
+
+	c.JSON(http.StatusCreated, responseBody)
+}
@@ -16,6 +16,7 @@ import (
 //
 // @Summary		Create a room
 // @Description	Create a new chat room. The room categories are: [General, Tech, Game, Book, Movie, Music, Language, Science].
+// @Description	The room visibility is public or private, rooms are public by default. Private rooms are only visible to their members.
 // @Tags		rooms
 // @Accept		json
 // @Produce		json
@@ -42,9 +43,10 @@ func (h *RoomHandler) CreateRoom(c *gin.Context) {
 	}
 
 	input := &usecase.CreateRoomUseCaseInput{
-		AdminId:  jwtClaims.Subject,
-		Name:     requestBody.Name,
-		Category: requestBody.Category,
+		AdminId:    jwtClaims.Subject,
+		Name:       requestBody.Name,
+		Category:   requestBody.Category,
+		Visibility: requestBody.Visibility,
 	}
 
 	output, err := h.createRoomUseCase.Execute(c.Request.Context(), input)
@@ -0,0 +1,74 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeclineInvitation godoc
+//
+// @Summary		Decline a room invitation
+// @Description	Decline an invitation addressed to the user. Expired or answered invitations cannot be declined.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Param		token				path			string	true	"Invitation Token"
+// @Success		204
+// @Failure		400
+// @Failure		401
+// @Failure		401	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		409	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/invitations/{token}/decline	[post]
+func (h *RoomHandler) DeclineInvitation(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.DeclineInvitationUseCaseInput{
+		RoomId: c.Param("id"),
+		Token:  c.Param("token"),
+		UserId: jwtClaims.Subject,
+	}
+
+	err = h.declineInvitationUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnauthorized, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ConflictError); ok {
+			dto.AbortWithHttpError(c, http.StatusConflict, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
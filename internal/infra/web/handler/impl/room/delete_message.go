@@ -0,0 +1,67 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteMessage godoc
+//
+// @Summary		Delete a message
+// @Description	Delete a message if the user is the message sender or a room owner or moderator. The message is kept in the history as a tombstone and is unpinned.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Param		messageId			path			string	true	"Message Id"
+// @Success		204
+// @Failure		400
+// @Failure		400 {object}		dto.HttpError
+// @Failure		401
+// @Failure		404 {object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages/{messageId}	[delete]
+func (h *RoomHandler) DeleteMessage(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.DeleteMessageUseCaseInput{
+		RoomId:    c.Param("id"),
+		MessageId: c.Param("messageId"),
+		UserId:    jwtClaims.Subject,
+	}
+
+	err = h.deleteMessageUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
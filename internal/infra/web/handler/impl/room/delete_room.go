@@ -14,7 +14,7 @@ import (
 // DeleteRoom godoc
 //
 // @Summary		Delete a room
-// @Description	Delete a chat room if the user is room admin.
+// @Description	Delete a chat room if the user is the room owner.
 // @Tags		rooms
 // @Accept		json
 // @Produce		json
@@ -36,8 +36,8 @@ func (h *RoomHandler) DeleteRoom(c *gin.Context) {
 	}
 
 	input := &usecase.DeleteRoomUseCaseInput{
-		Id:      c.Param("id"),
-		AdminId: jwtClaims.Subject,
+		Id:     c.Param("id"),
+		UserId: jwtClaims.Subject,
 	}
 
 	err = h.deleteRoomUseCase.Execute(c.Request.Context(), input)
@@ -0,0 +1,67 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DemoteMember godoc
+//
+// @Summary		Demote a member
+// @Description	Demote a room moderator to member. Only the room owner can manage roles.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Param		userId				path			string	true	"Member User Id"
+// @Success		204
+// @Failure		400
+// @Failure		401
+// @Failure		404 {object}		dto.HttpError
+// @Failure		422 {object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/members/{userId}/demote 	[post]
+func (h *RoomHandler) DemoteMember(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.DemoteMemberUseCaseInput{
+		RoomId:   c.Param("id"),
+		UserId:   jwtClaims.Subject,
+		MemberId: c.Param("userId"),
+	}
+
+	err = h.demoteMemberUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,98 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EditMessage godoc
+//
+// @Summary		Edit a message
+// @Description	Edit a message if the user is the message sender and still a room member. The previous text is kept in the message history.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string				true	"Room Id"
+// @Param		messageId			path			string				true	"Message Id"
+// @Param		message				body			dto.MessageRequest	true	"Message"
+// @Success		200	{object}		dto.MessageResponse
+// @Failure		400
+// @Failure		401
+// @Failure		403	{object}		dto.HttpError
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages/{messageId}	[put]
+func (h *RoomHandler) EditMessage(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var requestBody dto.MessageRequest
+
+	err = c.BindJSON(&requestBody)
+	if err != nil {
+		return
+	}
+
+	input := &usecase.EditMessageUseCaseInput{
+		RoomId:    c.Param("id"),
+		MessageId: c.Param("messageId"),
+		SenderId:  jwtClaims.Subject,
+		Text:      requestBody.Text,
+	}
+
+	output, err := h.editMessageUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.ForbiddenError); ok {
+			dto.AbortWithHttpError(c, http.StatusForbidden, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := &dto.MessageResponse{
+		Id:         output.Id,
+		RoomId:     output.RoomId,
+		SenderId:   output.SenderId,
+		SenderName: output.SenderName,
+		Text:       output.Text,
+		CreatedAt:  output.CreatedAt,
+		EditedAt:   output.EditedAt,
+		DeletedAt:  output.DeletedAt,
+
+		ParentId:    output.ParentId,
+		ReplyCount:  output.ReplyCount,
+		LastReplyAt: output.LastReplyAt,
+		Reactions:   reactionResponses(output.Reactions),
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
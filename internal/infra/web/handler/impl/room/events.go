@@ -0,0 +1,211 @@
+package room
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const sseHeartbeatPeriod = 30 * time.Second
+
+// Events godoc
+//
+// @Summary		Stream room events
+// @Description	Open a Server-Sent Events stream that receives the messages sent to the chat room.
+// @Description	The message id is used as the event id, reconnecting with the Last-Event-ID header replays the missed messages.
+// @Description	The replay is limited, when more messages were missed a 'replay.truncated' event gives the cursor to list the rest after.
+// @Description	New messages are sent as 'message' events, edits as 'message.edited' events and deletions as 'message.deleted' events.
+// @Description	Read receipts are sent as 'message.read' events, with the id of the last read message.
+// @Description	Typing indicators are sent as 'user.typing' events. Neither receipts nor typing indicators are replayed.
+// @Description	The user is online while the stream is open, presence changes are sent as 'presence.changed' events.
+// @Description	The stream is closed when the user is kicked or banned from the room.
+// @Tags		rooms
+// @Produce		text/event-stream
+// @Param		id					path			string	true	"Room Id"
+// @Param		Last-Event-ID		header			string	false	"Last received message id"
+// @Success		200
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Failure		503
+// @Security	Bearer token
+// @Router		/rooms/{id}/events	[get]
+func (h *RoomHandler) Events(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.FindRoomUseCaseInput{
+		Id:     c.Param("id"),
+		UserId: jwtClaims.Subject,
+	}
+
+	room, err := h.findRoomUseCase.Execute(ctx, input)
+	if err != nil {
+		h.abortEvents(c, err)
+		return
+	}
+
+	// Subscribe before replaying so no message is lost in between.
+	subscription, ok := h.subscribe(c, room.Id, jwtClaims.Subject)
+	if !ok {
+		return
+	}
+	defer subscription.Unsubscribe()
+
+	replayed := &usecase.ReplayMessagesUseCaseOutput{}
+
+	if lastEventId := c.GetHeader("Last-Event-ID"); lastEventId != "" {
+		input := &usecase.ReplayMessagesUseCaseInput{
+			RoomId:        room.Id,
+			LastMessageId: lastEventId,
+		}
+
+		replayed, err = h.replayMessagesUseCase.Execute(ctx, input)
+		if err != nil {
+			h.abortEvents(c, err)
+			return
+		}
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	sent := make(map[string]struct{}, len(replayed.Messages))
+
+	for _, message := range replayed.Messages {
+		messageEvent := &event.MessageEvent{
+			Type:       event.MessageCreated,
+			Id:         message.Id,
+			RoomId:     message.RoomId,
+			SenderId:   message.SenderId,
+			SenderName: message.SenderName,
+			Text:       message.Text,
+			CreatedAt:  message.CreatedAt,
+			EditedAt:   message.EditedAt,
+			DeletedAt:  message.DeletedAt,
+			ParentId:   message.ParentId,
+		}
+
+		if err := writeMessageEvent(c.Writer, messageEvent); err != nil {
+			return
+		}
+
+		sent[message.Id] = struct{}{}
+	}
+
+	if replayed.Cursor != "" {
+		if _, err := fmt.Fprintf(c.Writer, "event: replay.truncated\ndata: {\"cursor\":%q}\n\n", replayed.Cursor); err != nil {
+			return
+		}
+	}
+
+	c.Writer.Flush()
+
+	presence := h.openPresenceSession(ctx, room.Id, jwtClaims.Subject)
+	defer presence.close(ctx)
+
+	ticker := time.NewTicker(sseHeartbeatPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-subscription.Closed():
+			return
+		case messageEvent := <-subscription.Events():
+			if _, ok := sent[messageEvent.Id]; ok && messageEvent.IsCreated() {
+				continue
+			}
+
+			if err := writeMessageEvent(c.Writer, messageEvent); err != nil {
+				return
+			}
+		case typingEvent := <-subscription.TypingEvents():
+			if err := writeTypingEvent(c.Writer, typingEvent); err != nil {
+				return
+			}
+		case presenceEvent := <-subscription.PresenceEvents():
+			if err := writePresenceEvent(c.Writer, presenceEvent); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := io.WriteString(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+
+		c.Writer.Flush()
+	}
+}
+
+func (h *RoomHandler) abortEvents(c *gin.Context, err error) {
+	if _, ok := err.(validation.ValidationError); ok {
+		dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if _, ok := err.(validation.NotFoundError); ok {
+		dto.AbortWithHttpError(c, http.StatusNotFound, err)
+		return
+	}
+
+	h.logger.Error(err)
+	c.AbortWithStatus(http.StatusInternalServerError)
+}
+
+func writeMessageEvent(w io.Writer, messageEvent *event.MessageEvent) error {
+	data, err := json.Marshal(messageEvent)
+	if err != nil {
+		return err
+	}
+
+	// Only new messages move the stream position, edits are sent without an id
+	// so a reconnection does not skip the messages after the edited one.
+	if !messageEvent.IsCreated() {
+		_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", messageEvent.Type, data)
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: message\ndata: %s\n\n", messageEvent.Id, data)
+	return err
+}
+
+func writeTypingEvent(w io.Writer, typingEvent *event.TypingEvent) error {
+	data, err := json.Marshal(typingEvent)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", typingEvent.Type, data)
+	return err
+}
+
+func writePresenceEvent(w io.Writer, presenceEvent *event.PresenceEvent) error {
+	data, err := json.Marshal(presenceEvent)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", presenceEvent.Type, data)
+	return err
+}
@@ -0,0 +1,77 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FindMessage godoc
+//
+// @Summary		Find a message
+// @Description	Find a message of a chat room.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path				string	true	"Room Id"
+// @Param		messageId			path				string	true	"Message Id"
+// @Success		200 {object}		dto.MessageResponse
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages/{messageId}	[get]
+func (h *RoomHandler) FindMessage(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.FindMessageUseCaseInput{
+		RoomId:    c.Param("id"),
+		MessageId: c.Param("messageId"),
+		UserId:    jwtClaims.Subject,
+	}
+
+	output, err := h.findMessageUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := &dto.MessageResponse{
+		Id:         output.Id,
+		RoomId:     output.RoomId,
+		SenderId:   output.SenderId,
+		SenderName: output.SenderName,
+		Text:       output.Text,
+		CreatedAt:  output.CreatedAt,
+		EditedAt:   output.EditedAt,
+		DeletedAt:  output.DeletedAt,
+
+		ParentId:    output.ParentId,
+		ReplyCount:  output.ReplyCount,
+		LastReplyAt: output.LastReplyAt,
+		Reactions:   reactionResponses(output.Reactions),
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,7 +14,7 @@ import (
 // FindRoom godoc
 //
 // @Summary		Find a room
-// @Description	Find a chat room.
+// @Description	Find a chat room. Private rooms are only visible to their members.
 // @Tags		rooms
 // @Accept		json
 // @Produce		json
@@ -26,8 +27,15 @@ import (
 // @Security	Bearer token
 // @Router		/rooms/{id} 		[get]
 func (h *RoomHandler) FindRoom(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
 	input := &usecase.FindRoomUseCaseInput{
-		Id: c.Param("id"),
+		Id:     c.Param("id"),
+		UserId: jwtClaims.Subject,
 	}
 
 	output, err := h.findRoomUseCase.Execute(c.Request.Context(), input)
@@ -48,9 +56,10 @@ func (h *RoomHandler) FindRoom(c *gin.Context) {
 	}
 
 	responseBody := &dto.RoomResponse{
-		Id:       output.Id,
-		Name:     output.Name,
-		Category: output.Category,
+		Id:         output.Id,
+		Name:       output.Name,
+		Category:   output.Category,
+		Visibility: output.Visibility,
 	}
 
 	c.JSON(http.StatusOK, responseBody)
@@ -0,0 +1,66 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// JoinRoom godoc
+//
+// @Summary		Join a room
+// @Description	Join a chat room as a member. Joining a room twice has no effect.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Success		204
+// @Failure		400
+// @Failure		400 {object}		dto.HttpError
+// @Failure		401
+// @Failure		403 {object}		dto.HttpError
+// @Failure		404 {object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/join 	[post]
+func (h *RoomHandler) JoinRoom(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.JoinRoomUseCaseInput{
+		RoomId: c.Param("id"),
+		UserId: jwtClaims.Subject,
+	}
+
+	err = h.joinRoomUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.ForbiddenError); ok {
+			dto.AbortWithHttpError(c, http.StatusForbidden, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,85 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListMembers godoc
+//
+// @Summary		List members
+// @Description	List the members of a chat room by join date.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path				string	true	"Room Id"
+// @Param		page				query				string	false	"Page"			default(0)
+// @Param		size				query				string	false	"Size"			default(10)
+// @Param		sort				query				string	false	"Sort"			default(asc)
+// @Param		search				query				string	false	"User Id"		default()
+// @Success		200	{object}		dto.MemberPage
+// @Failure		400 {object}		dto.HttpError
+// @Failure		401
+// @Failure		404 {object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/members	[get]
+func (h *RoomHandler) ListMembers(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListMembersUseCaseInput{
+		RoomId: c.Param("id"),
+		UserId: jwtClaims.Subject,
+		Page:   c.Query("page"),
+		Size:   c.Query("size"),
+		Sort:   c.Query("sort"),
+		Search: c.Query("search"),
+	}
+
+	output, err := h.listMembersUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	mapper := func(m *usecase.ListMembersUseCaseOutput) *dto.MemberResponse {
+		return &dto.MemberResponse{
+			UserId:   m.UserId,
+			Role:     m.Role,
+			JoinedAt: m.JoinedAt,
+		}
+	}
+
+	result := pagination.MapPage[*usecase.ListMembersUseCaseOutput, *dto.MemberResponse](output, mapper)
+
+	page := &dto.MemberPage{
+		Page:    result.Page,
+		Size:    result.Size,
+		Total:   result.Total,
+		Members: result.Items,
+	}
+
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,87 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListMessageReaders godoc
+//
+// @Summary		List message readers
+// @Description	List the members that read a message. Only the sender can list the readers, and only in rooms up to 50 members.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path				string	true	"Room Id"
+// @Param		messageId			path				string	true	"Message Id"
+// @Param		page				query				string	false	"Page"			default(0)
+// @Param		size				query				string	false	"Size"			default(10)
+// @Success		200	{object}		dto.ReaderPage
+// @Failure		400 {object}		dto.HttpError
+// @Failure		401
+// @Failure		404 {object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages/{messageId}/readers	[get]
+func (h *RoomHandler) ListMessageReaders(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListMessageReadersUseCaseInput{
+		RoomId:    c.Param("id"),
+		UserId:    jwtClaims.Subject,
+		MessageId: c.Param("messageId"),
+		Page:      c.Query("page"),
+		Size:      c.Query("size"),
+	}
+
+	output, err := h.listMessageReadersUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	mapper := func(r *usecase.ListMessageReadersUseCaseOutput) *dto.ReaderResponse {
+		return &dto.ReaderResponse{
+			UserId: r.UserId,
+			Role:   r.Role,
+		}
+	}
+
+	result := pagination.MapPage[*usecase.ListMessageReadersUseCaseOutput, *dto.ReaderResponse](output, mapper)
+
+	page := &dto.ReaderPage{
+		Page:    result.Page,
+		Size:    result.Size,
+		Total:   result.Total,
+		Readers: result.Items,
+	}
+
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,70 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListMessageRevisions godoc
+//
+// @Summary		List message revisions
+// @Description	List the previous texts of a message of the chat room from the oldest, a deleted message has no revisions.
+// @Tags		rooms
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Param		messageId			path			string	true	"Message Id"
+// @Success		200	{array}			dto.MessageRevisionResponse
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages/{messageId}/revisions	[get]
+func (h *RoomHandler) ListMessageRevisions(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListMessageRevisionsUseCaseInput{
+		RoomId:    c.Param("id"),
+		MessageId: c.Param("messageId"),
+		UserId:    jwtClaims.Subject,
+	}
+
+	output, err := h.listMessageRevisionsUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := make([]*dto.MessageRevisionResponse, 0, len(output))
+
+	for _, r := range output {
+		responseBody = append(responseBody, &dto.MessageRevisionResponse{
+			Id:        r.Id,
+			Text:      r.Text,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
@@ -0,0 +1,106 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListMessages godoc
+//
+// @Summary		List messages
+// @Description	List the messages of a chat room from the newest to the oldest. Use the 'before' cursor of a page with direction 'before' to load older messages and the 'after' cursor with direction 'after' to load newer ones.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path				string	true	"Room Id"
+// @Param		cursor				query				string	false	"Cursor"		default()
+// @Param		size				query				string	false	"Size"			default(10)
+// @Param		direction			query				string	false	"Direction"		default(before)
+// @Success		200	{object}		dto.MessagePage
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages	[get]
+func (h *RoomHandler) ListMessages(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListMessagesUseCaseInput{
+		RoomId:    c.Param("id"),
+		UserId:    jwtClaims.Subject,
+		Cursor:    c.Query("cursor"),
+		Size:      c.Query("size"),
+		Direction: c.Query("direction"),
+	}
+
+	output, err := h.listMessagesUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	mapper := func(m *usecase.ListMessagesUseCaseOutput) *dto.MessageResponse {
+		return &dto.MessageResponse{
+			Id:         m.Id,
+			RoomId:     m.RoomId,
+			SenderId:   m.SenderId,
+			SenderName: m.SenderName,
+			Text:       m.Text,
+			CreatedAt:  m.CreatedAt,
+			EditedAt:   m.EditedAt,
+			DeletedAt:  m.DeletedAt,
+
+			ParentId:    m.ParentId,
+			ReplyCount:  m.ReplyCount,
+			LastReplyAt: m.LastReplyAt,
+			Reactions:   reactionResponses(m.Reactions),
+		}
+	}
+
+	result := pagination.MapCursorPage[*usecase.ListMessagesUseCaseOutput, *dto.MessageResponse](output, mapper)
+
+	page := &dto.MessagePage{
+		Size:     result.Size,
+		Before:   result.Before,
+		After:    result.After,
+		Messages: result.Items,
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func reactionResponses(reactions []*usecase.MessageReactionOutput) []*dto.ReactionResponse {
+	responses := make([]*dto.ReactionResponse, len(reactions))
+
+	for i, r := range reactions {
+		responses[i] = &dto.ReactionResponse{
+			Emoji: r.Emoji,
+			Count: r.Count,
+		}
+	}
+
+	return responses
+}
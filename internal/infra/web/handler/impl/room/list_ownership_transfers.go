@@ -0,0 +1,70 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListOwnershipTransfers godoc
+//
+// @Summary		List room transfers
+// @Description	List the ownership transfers of a chat room from the oldest.
+// @Tags		rooms
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Success		200	{array}			dto.OwnershipTransferResponse
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/transfers	[get]
+func (h *RoomHandler) ListOwnershipTransfers(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListOwnershipTransfersUseCaseInput{
+		RoomId: c.Param("id"),
+		UserId: jwtClaims.Subject,
+	}
+
+	output, err := h.listOwnershipTransfersUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := make([]*dto.OwnershipTransferResponse, 0, len(output))
+
+	for _, t := range output {
+		responseBody = append(responseBody, &dto.OwnershipTransferResponse{
+			Id:              t.Id,
+			PreviousAdminId: t.PreviousAdminId,
+			AdminId:         t.AdminId,
+			TransferredBy:   t.TransferredBy,
+			TransferredAt:   t.TransferredAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
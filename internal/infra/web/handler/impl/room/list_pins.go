@@ -0,0 +1,77 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListPins godoc
+//
+// @Summary		List pinned messages
+// @Description	List the pinned messages of the chat room from the last pinned.
+// @Tags		rooms
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Success		200	{array}			dto.PinResponse
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/pins	[get]
+func (h *RoomHandler) ListPins(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListPinsUseCaseInput{
+		RoomId: c.Param("id"),
+		UserId: jwtClaims.Subject,
+	}
+
+	output, err := h.listPinsUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := make([]*dto.PinResponse, 0, len(output))
+
+	for _, p := range output {
+		responseBody = append(responseBody, &dto.PinResponse{
+			Message: &dto.MessageResponse{
+				Id:         p.MessageId,
+				RoomId:     input.RoomId,
+				SenderId:   p.SenderId,
+				SenderName: p.SenderName,
+				Text:       p.Text,
+				CreatedAt:  p.CreatedAt,
+				EditedAt:   p.EditedAt,
+				DeletedAt:  p.DeletedAt,
+			},
+			PinnedBy: p.PinnedBy,
+			PinnedAt: p.PinnedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
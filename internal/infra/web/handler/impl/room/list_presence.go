@@ -0,0 +1,68 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListPresence godoc
+//
+// @Summary		List room presence
+// @Description	List the users that are online or away in the chat room, the users that are not listed are offline.
+// @Tags		rooms
+// @Produce		json
+// @Param		id					path			string	true	"Room Id"
+// @Success		200	{array}			dto.PresenceResponse
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/presence	[get]
+func (h *RoomHandler) ListPresence(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListPresenceUseCaseInput{
+		RoomId: c.Param("id"),
+		UserId: jwtClaims.Subject,
+	}
+
+	output, err := h.listPresenceUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody := make([]*dto.PresenceResponse, 0, len(output))
+
+	for _, p := range output {
+		responseBody = append(responseBody, &dto.PresenceResponse{
+			UserId: p.UserId,
+			Status: p.Status,
+			SeenAt: p.SeenAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
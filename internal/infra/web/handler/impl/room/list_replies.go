@@ -0,0 +1,92 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListReplies godoc
+//
+// @Summary		List replies
+// @Description	List the replies to a message of a chat room from the newest to the oldest. The pages are loaded with the cursors as in the message listing.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path				string	true	"Room Id"
+// @Param		messageId			path				string	true	"Message Id"
+// @Param		cursor				query				string	false	"Cursor"		default()
+// @Param		size				query				string	false	"Size"			default(10)
+// @Param		direction			query				string	false	"Direction"		default(before)
+// @Success		200	{object}		dto.MessagePage
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/messages/{messageId}/replies	[get]
+func (h *RoomHandler) ListReplies(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListRepliesUseCaseInput{
+		RoomId:    c.Param("id"),
+		MessageId: c.Param("messageId"),
+		UserId:    jwtClaims.Subject,
+		Cursor:    c.Query("cursor"),
+		Size:      c.Query("size"),
+		Direction: c.Query("direction"),
+	}
+
+	output, err := h.listRepliesUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	mapper := func(m *usecase.ListRepliesUseCaseOutput) *dto.MessageResponse {
+		return &dto.MessageResponse{
+			Id:         m.Id,
+			RoomId:     m.RoomId,
+			SenderId:   m.SenderId,
+			SenderName: m.SenderName,
+			Text:       m.Text,
+			CreatedAt:  m.CreatedAt,
+			EditedAt:   m.EditedAt,
+			DeletedAt:  m.DeletedAt,
+			ParentId:   m.ParentId,
+			Reactions:  reactionResponses(m.Reactions),
+		}
+	}
+
+	result := pagination.MapCursorPage[*usecase.ListRepliesUseCaseOutput, *dto.MessageResponse](output, mapper)
+
+	page := &dto.MessagePage{
+		Size:     result.Size,
+		Before:   result.Before,
+		After:    result.After,
+		Messages: result.Items,
+	}
+
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,75 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MarkRoomAsRead godoc
+//
+// @Summary		Mark a room as read
+// @Description	Mark the messages of a chat room as read up to a message and send a read receipt to the room members.
+// @Description	Marking an older message than the last read one, or the same one, has no effect and sends no receipt.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string				true	"Room Id"
+// @Param		read				body			dto.ReadRequest		true	"Last read message"
+// @Success		204
+// @Failure		400
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/read	[post]
+func (h *RoomHandler) MarkRoomAsRead(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var requestBody dto.ReadRequest
+
+	err = c.BindJSON(&requestBody)
+	if err != nil {
+		return
+	}
+
+	input := &usecase.MarkRoomAsReadUseCaseInput{
+		RoomId:    c.Param("id"),
+		UserId:    jwtClaims.Subject,
+		MessageId: requestBody.MessageId,
+	}
+
+	err = h.markRoomAsReadUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
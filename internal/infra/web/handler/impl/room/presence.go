@@ -0,0 +1,104 @@
+package room
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+)
+
+const presenceCloseTimeout = 5 * time.Second
+
+// presenceSession keeps the user online in the room while a live connection
+// is open. The session is opened once the membership is checked, then a
+// goroutine sends the heartbeats until the connection is closed.
+type presenceSession struct {
+	session usecase.PresenceSession
+	status  atomic.Value
+	active  bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func (h *RoomHandler) openPresenceSession(ctx context.Context, roomId string, userId string) *presenceSession {
+	s := &presenceSession{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	s.status.Store(valueobject.PresenceStatusOnline)
+
+	input := &usecase.OpenPresenceSessionUseCaseInput{
+		RoomId: roomId,
+		UserId: userId,
+	}
+
+	// A user that is not a member is never shown online, the use case logs the
+	// unexpected errors.
+	session, err := h.updatePresenceUseCase.OpenSession(ctx, input)
+	if err != nil {
+		close(s.done)
+		return s
+	}
+
+	s.session = session
+	s.active = true
+
+	// The errors are ignored, the next heartbeat sends the presence again.
+	_ = s.session.Update(ctx, valueobject.PresenceStatusOnline)
+	go s.run(ctx)
+
+	return s
+}
+
+// update changes the status of the session to online or away.
+func (s *presenceSession) update(ctx context.Context, status string) {
+	if !s.active {
+		return
+	}
+
+	if status != valueobject.PresenceStatusOnline && status != valueobject.PresenceStatusAway {
+		return
+	}
+
+	s.status.Store(status)
+	_ = s.session.Update(ctx, status)
+}
+
+// run sends the heartbeats out of the write loop of the connection.
+func (s *presenceSession) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(entity.PresenceHeartbeatPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			_ = s.session.Update(ctx, s.status.Load().(string))
+		}
+	}
+}
+
+// close stops the heartbeats and sends the offline status even if the request
+// context is already done.
+func (s *presenceSession) close(ctx context.Context) {
+	close(s.stop)
+	<-s.done
+
+	if !s.active {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), presenceCloseTimeout)
+	defer cancel()
+
+	_ = s.session.Close(ctx)
+}
@@ -1,19 +1,53 @@
 package room
 
 import (
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/hub"
 	usecase "github.com/sesaquecruz/go-chat-api/internal/usecase"
 
 	"github.com/sesaquecruz/go-chat-api/pkg/log"
 )
 
 type RoomHandler struct {
-	createRoomUseCase  usecase.CreateRoomUseCase
-	searchRoomUseCase  usecase.SearchRoomUseCase
-	findRoomUseCase    usecase.FindRoomUseCase
-	updateRoomUseCase  usecase.UpdateRoomUseCase
-	deleteRoomUseCase  usecase.DeleteRoomUseCase
-	sendMessageUseCase usecase.SendMessageUseCase
-	logger             *log.Logger
+	createRoomUseCase             usecase.CreateRoomUseCase
+	searchRoomUseCase             usecase.SearchRoomUseCase
+	findRoomUseCase               usecase.FindRoomUseCase
+	updateRoomUseCase             usecase.UpdateRoomUseCase
+	deleteRoomUseCase             usecase.DeleteRoomUseCase
+	transferOwnershipUseCase      usecase.TransferOwnershipUseCase
+	listOwnershipTransfersUseCase usecase.ListOwnershipTransfersUseCase
+	joinRoomUseCase               usecase.JoinRoomUseCase
+	leaveRoomUseCase              usecase.LeaveRoomUseCase
+	listMembersUseCase            usecase.ListMembersUseCase
+	promoteMemberUseCase          usecase.PromoteMemberUseCase
+	demoteMemberUseCase           usecase.DemoteMemberUseCase
+	kickMemberUseCase             usecase.KickMemberUseCase
+	banMemberUseCase              usecase.BanMemberUseCase
+	unbanMemberUseCase            usecase.UnbanMemberUseCase
+	muteMemberUseCase             usecase.MuteMemberUseCase
+	unmuteMemberUseCase           usecase.UnmuteMemberUseCase
+	createInvitationUseCase       usecase.CreateInvitationUseCase
+	acceptInvitationUseCase       usecase.AcceptInvitationUseCase
+	declineInvitationUseCase      usecase.DeclineInvitationUseCase
+	sendMessageUseCase            usecase.SendMessageUseCase
+	listMessagesUseCase           usecase.ListMessagesUseCase
+	listRepliesUseCase            usecase.ListRepliesUseCase
+	findMessageUseCase            usecase.FindMessageUseCase
+	editMessageUseCase            usecase.EditMessageUseCase
+	deleteMessageUseCase          usecase.DeleteMessageUseCase
+	markRoomAsReadUseCase         usecase.MarkRoomAsReadUseCase
+	listMessageReadersUseCase     usecase.ListMessageReadersUseCase
+	listMessageRevisionsUseCase   usecase.ListMessageRevisionsUseCase
+	addReactionUseCase            usecase.AddReactionUseCase
+	removeReactionUseCase         usecase.RemoveReactionUseCase
+	pinMessageUseCase             usecase.PinMessageUseCase
+	unpinMessageUseCase           usecase.UnpinMessageUseCase
+	listPinsUseCase               usecase.ListPinsUseCase
+	sendTypingUseCase             usecase.SendTypingUseCase
+	updatePresenceUseCase         usecase.UpdatePresenceUseCase
+	listPresenceUseCase           usecase.ListPresenceUseCase
+	replayMessagesUseCase         usecase.ReplayMessagesUseCase
+	messageHub                    *hub.MessageHub
+	logger                        *log.Logger
 }
 
 func NewRoomHandler(
@@ -22,15 +56,81 @@ func NewRoomHandler(
 	findRoomUseCase usecase.FindRoomUseCase,
 	updateRoomUseCase usecase.UpdateRoomUseCase,
 	deleteRoomUseCase usecase.DeleteRoomUseCase,
+	transferOwnershipUseCase usecase.TransferOwnershipUseCase,
+	listOwnershipTransfersUseCase usecase.ListOwnershipTransfersUseCase,
+	joinRoomUseCase usecase.JoinRoomUseCase,
+	leaveRoomUseCase usecase.LeaveRoomUseCase,
+	listMembersUseCase usecase.ListMembersUseCase,
+	promoteMemberUseCase usecase.PromoteMemberUseCase,
+	demoteMemberUseCase usecase.DemoteMemberUseCase,
+	kickMemberUseCase usecase.KickMemberUseCase,
+	banMemberUseCase usecase.BanMemberUseCase,
+	unbanMemberUseCase usecase.UnbanMemberUseCase,
+	muteMemberUseCase usecase.MuteMemberUseCase,
+	unmuteMemberUseCase usecase.UnmuteMemberUseCase,
+	createInvitationUseCase usecase.CreateInvitationUseCase,
+	acceptInvitationUseCase usecase.AcceptInvitationUseCase,
+	declineInvitationUseCase usecase.DeclineInvitationUseCase,
 	sendMessageUseCase usecase.SendMessageUseCase,
+	listMessagesUseCase usecase.ListMessagesUseCase,
+	listRepliesUseCase usecase.ListRepliesUseCase,
+	findMessageUseCase usecase.FindMessageUseCase,
+	editMessageUseCase usecase.EditMessageUseCase,
+	deleteMessageUseCase usecase.DeleteMessageUseCase,
+	markRoomAsReadUseCase usecase.MarkRoomAsReadUseCase,
+	listMessageReadersUseCase usecase.ListMessageReadersUseCase,
+	listMessageRevisionsUseCase usecase.ListMessageRevisionsUseCase,
+	addReactionUseCase usecase.AddReactionUseCase,
+	removeReactionUseCase usecase.RemoveReactionUseCase,
+	pinMessageUseCase usecase.PinMessageUseCase,
+	unpinMessageUseCase usecase.UnpinMessageUseCase,
+	listPinsUseCase usecase.ListPinsUseCase,
+	sendTypingUseCase usecase.SendTypingUseCase,
+	updatePresenceUseCase usecase.UpdatePresenceUseCase,
+	listPresenceUseCase usecase.ListPresenceUseCase,
+	replayMessagesUseCase usecase.ReplayMessagesUseCase,
+	messageHub *hub.MessageHub,
 ) *RoomHandler {
 	return &RoomHandler{
-		createRoomUseCase:  createRoomUseCase,
-		searchRoomUseCase:  searchRoomUseCase,
-		findRoomUseCase:    findRoomUseCase,
-		updateRoomUseCase:  updateRoomUseCase,
-		deleteRoomUseCase:  deleteRoomUseCase,
-		sendMessageUseCase: sendMessageUseCase,
-		logger:             log.NewLogger("RoomHandler"),
+		createRoomUseCase:             createRoomUseCase,
+		searchRoomUseCase:             searchRoomUseCase,
+		findRoomUseCase:               findRoomUseCase,
+		updateRoomUseCase:             updateRoomUseCase,
+		deleteRoomUseCase:             deleteRoomUseCase,
+		transferOwnershipUseCase:      transferOwnershipUseCase,
+		listOwnershipTransfersUseCase: listOwnershipTransfersUseCase,
+		joinRoomUseCase:               joinRoomUseCase,
+		leaveRoomUseCase:              leaveRoomUseCase,
+		listMembersUseCase:            listMembersUseCase,
+		promoteMemberUseCase:          promoteMemberUseCase,
+		demoteMemberUseCase:           demoteMemberUseCase,
+		kickMemberUseCase:             kickMemberUseCase,
+		banMemberUseCase:              banMemberUseCase,
+		unbanMemberUseCase:            unbanMemberUseCase,
+		muteMemberUseCase:             muteMemberUseCase,
+		unmuteMemberUseCase:           unmuteMemberUseCase,
+		createInvitationUseCase:       createInvitationUseCase,
+		acceptInvitationUseCase:       acceptInvitationUseCase,
+		declineInvitationUseCase:      declineInvitationUseCase,
+		sendMessageUseCase:            sendMessageUseCase,
+		listMessagesUseCase:           listMessagesUseCase,
+		listRepliesUseCase:            listRepliesUseCase,
+		findMessageUseCase:            findMessageUseCase,
+		editMessageUseCase:            editMessageUseCase,
+		deleteMessageUseCase:          deleteMessageUseCase,
+		markRoomAsReadUseCase:         markRoomAsReadUseCase,
+		listMessageReadersUseCase:     listMessageReadersUseCase,
+		listMessageRevisionsUseCase:   listMessageRevisionsUseCase,
+		addReactionUseCase:            addReactionUseCase,
+		removeReactionUseCase:         removeReactionUseCase,
+		pinMessageUseCase:             pinMessageUseCase,
+		unpinMessageUseCase:           unpinMessageUseCase,
+		listPinsUseCase:               listPinsUseCase,
+		sendTypingUseCase:             sendTypingUseCase,
+		updatePresenceUseCase:         updatePresenceUseCase,
+		listPresenceUseCase:           listPresenceUseCase,
+		replayMessagesUseCase:         replayMessagesUseCase,
+		messageHub:                    messageHub,
+		logger:                        log.NewLogger("RoomHandler"),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,7 +15,7 @@ import (
 // SearchRoom godoc
 //
 // @Summary		Search rooms
-// @Description	Search chat rooms.
+// @Description	Search chat rooms. Private rooms are only listed for their members.
 // @Tags		rooms
 // @Accept		json
 // @Produce		json
@@ -29,7 +30,14 @@ import (
 // @Security	Bearer token
 // @Router		/rooms		 		[get]
 func (h *RoomHandler) SearchRoom(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
 	input := &usecase.SearchRoomUseCaseInput{
+		UserId: jwtClaims.Subject,
 		Page:   c.Query("page"),
 		Size:   c.Query("size"),
 		Sort:   c.Query("sort"),
@@ -50,9 +58,10 @@ func (h *RoomHandler) SearchRoom(c *gin.Context) {
 
 	mapper := func(r *usecase.SearchRoomUseCaseOutput) *dto.RoomResponse {
 		return &dto.RoomResponse{
-			Id:       r.Id,
-			Name:     r.Name,
-			Category: r.Category,
+			Id:         r.Id,
+			Name:       r.Name,
+			Category:   r.Category,
+			Visibility: r.Visibility,
 		}
 	}
 
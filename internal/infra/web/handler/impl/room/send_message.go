@@ -1,7 +1,9 @@
 package room
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
@@ -14,15 +16,19 @@ import (
 // SendMessage godoc
 //
 // @Summary		Send a message
-// @Description	Send a message to the chat room.
+// @Description	Send a message to the chat room if the user is a room member. Set the parent id to reply to a message of the room.
 // @Tags		rooms
 // @Accept		json
 // @Produce		json
 // @Param		id					path			string				true	"Room Id"
 // @Param		message				body			dto.MessageRequest	true	"Message"
-// @Success		201
+// @Param		Idempotency-Key		header			string				false	"Idempotency Key"
+// @Success		201	{object}		dto.MessageResponse
+// @Header		201	{string}		Location	"Message location"
 // @Failure		400
 // @Failure		401
+// @Failure		401	{object}		dto.HttpError
+// @Failure		403	{object}		dto.HttpError
 // @Failure		404	{object}		dto.HttpError
 // @Failure		422	{object}		dto.HttpError
 // @Failure		500
@@ -47,10 +53,23 @@ func (h *RoomHandler) SendMessage(c *gin.Context) {
 		SenderId:   jwtClaims.Subject,
 		SenderName: jwtClaims.Nickname,
 		Text:       requestBody.Text,
+		ParentId:   requestBody.ParentId,
+
+		IdempotencyKey: c.GetHeader("Idempotency-Key"),
 	}
 
-	_, err = h.sendMessageUseCase.Execute(c.Request.Context(), input)
+	output, err := h.sendMessageUseCase.Execute(c.Request.Context(), input)
 	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnauthorized, err)
+			return
+		}
+
+		if _, ok := err.(validation.ForbiddenError); ok {
+			dto.AbortWithHttpError(c, http.StatusForbidden, err)
+			return
+		}
+
 		if _, ok := err.(validation.NotFoundError); ok {
 			dto.AbortWithHttpError(c, http.StatusNotFound, err)
 			return
@@ -63,7 +82,27 @@ func (h *RoomHandler) SendMessage(c *gin.Context) {
 
 		h.logger.Error(err)
 		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	if output.Replayed {
+		c.Header("Idempotent-Replayed", "true")
 	}
 
-	c.Status(http.StatusCreated)
+	responseBody := &dto.MessageResponse{
+		Id:         output.MessageId,
+		RoomId:     output.RoomId,
+		SenderId:   output.SenderId,
+		SenderName: output.SenderName,
+		Text:       output.Text,
+		CreatedAt:  output.CreatedAt,
+		EditedAt:   output.EditedAt,
+		DeletedAt:  output.DeletedAt,
+		ParentId:   output.ParentId,
+	}
+
+	location := fmt.Sprintf("%s/messages/%s", strings.TrimSuffix(c.Request.URL.Path, "/send"), output.MessageId)
+
+	c.Header("Location", location)
+	c.JSON(http.StatusCreated, responseBody)
 }
@@ -0,0 +1,32 @@
+package room
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/hub"
+
+	"github.com/gin-gonic/gin"
+)
+
+const subscriptionTimeout = 10 * time.Second
+
+// subscribe subscribes to the room events and waits for them to be received,
+// aborting the request when the broker does not respond in time.
+func (h *RoomHandler) subscribe(c *gin.Context, roomId string, userId string) (*hub.Subscription, bool) {
+	subscription := h.messageHub.Subscribe(roomId, userId)
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), subscriptionTimeout)
+	defer cancel()
+
+	if err := subscription.Wait(ctx); err != nil {
+		subscription.Unsubscribe()
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusServiceUnavailable)
+		return nil, false
+	}
+
+	return subscription, true
+}
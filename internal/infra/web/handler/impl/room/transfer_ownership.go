@@ -0,0 +1,75 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransferOwnership godoc
+//
+// @Summary		Transfer a room
+// @Description	Transfer the ownership of a chat room to one of its members if the user is the room owner or a platform administrator. The previous owner becomes a moderator and the room members are notified.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string							true	"Room Id"
+// @Param		transfer			body			dto.TransferOwnershipRequest	true	"New admin"
+// @Success		204
+// @Failure		400
+// @Failure		401
+// @Failure		404 {object}		dto.HttpError
+// @Failure		422 {object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/transfer	[post]
+func (h *RoomHandler) TransferOwnership(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var requestBody dto.TransferOwnershipRequest
+
+	err = c.BindJSON(&requestBody)
+	if err != nil {
+		return
+	}
+
+	input := &usecase.TransferOwnershipUseCaseInput{
+		RoomId:        c.Param("id"),
+		UserId:        jwtClaims.Subject,
+		AdminId:       requestBody.AdminId,
+		PlatformAdmin: jwtClaims.IsPlatformAdmin(),
+	}
+
+	err = h.transferOwnershipUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
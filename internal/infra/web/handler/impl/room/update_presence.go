@@ -0,0 +1,76 @@
+package room
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpdatePresence godoc
+//
+// @Summary		Send a presence heartbeat
+// @Description	Mark the user as online or away in the chat room, for clients without a live connection.
+// @Description	The presence expires when no heartbeat is sent for a minute, sending one every 20 seconds keeps it.
+// @Tags		rooms
+// @Accept		json
+// @Produce		json
+// @Param		id					path			string					true	"Room Id"
+// @Param		presence			body			dto.PresenceRequest		true	"Presence"
+// @Success		204
+// @Failure		400
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		422	{object}		dto.HttpError
+// @Failure		500
+// @Security	Bearer token
+// @Router		/rooms/{id}/presence	[post]
+func (h *RoomHandler) UpdatePresence(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var requestBody dto.PresenceRequest
+
+	err = c.BindJSON(&requestBody)
+	if err != nil {
+		return
+	}
+
+	// The heartbeats of a user share one session apart from its connections.
+	input := &usecase.UpdatePresenceUseCaseInput{
+		RoomId: c.Param("id"),
+		UserId: jwtClaims.Subject,
+		Status: requestBody.Status,
+	}
+
+	err = h.updatePresenceUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
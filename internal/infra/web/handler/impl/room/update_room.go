@@ -14,7 +14,8 @@ import (
 // UpdateRoom godoc
 //
 // @Summary		Update a room
-// @Description	Update a chat room if the user is room admin. The room categories are: [General, Tech, Game, Book, Movie, Music, Language, Science].
+// @Description	Update a chat room if the user is the room owner. The room categories are: [General, Tech, Game, Book, Movie, Music, Language, Science].
+// @Description	The room visibility is public or private, an empty visibility keeps the current one.
 // @Tags		rooms
 // @Accept		json
 // @Produce		json
@@ -44,10 +45,11 @@ func (h *RoomHandler) UpdateRoom(c *gin.Context) {
 	}
 
 	input := &usecase.UpdateRoomUseCaseInput{
-		Id:       c.Param("id"),
-		AdminId:  jwtClaims.Subject,
-		Name:     requestBody.Name,
-		Category: requestBody.Category,
+		Id:         c.Param("id"),
+		UserId:     jwtClaims.Subject,
+		Name:       requestBody.Name,
+		Category:   requestBody.Category,
+		Visibility: requestBody.Visibility,
 	}
 
 	err = h.updateRoomUseCase.Execute(c.Request.Context(), input)
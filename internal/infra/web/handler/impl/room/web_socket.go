@@ -0,0 +1,167 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsWriteWait      = 10 * time.Second
+	wsPongWait       = 60 * time.Second
+	wsPingPeriod     = (wsPongWait * 9) / 10
+	wsMaxMessageSize = 512
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// WebSocket godoc
+//
+// @Summary		Stream room messages
+// @Description	Open a WebSocket that receives the messages sent to the chat room as JSON frames.
+// @Description	Sending a {"type": "typing"} frame notifies the room members that the user is typing.
+// @Description	The user is online while the WebSocket is open, a {"type": "presence", "status": "away"} frame changes the status.
+// @Description	The WebSocket is closed when the user is kicked or banned from the room.
+// @Tags		rooms
+// @Param		id					path			string	true	"Room Id"
+// @Success		101
+// @Failure		400	{object}		dto.HttpError
+// @Failure		401
+// @Failure		404	{object}		dto.HttpError
+// @Failure		500
+// @Failure		503
+// @Security	Bearer token
+// @Router		/rooms/{id}/ws		[get]
+func (h *RoomHandler) WebSocket(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.FindRoomUseCaseInput{
+		Id:     c.Param("id"),
+		UserId: jwtClaims.Subject,
+	}
+
+	room, err := h.findRoomUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if _, ok := err.(validation.NotFoundError); ok {
+			dto.AbortWithHttpError(c, http.StatusNotFound, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	subscription, ok := h.subscribe(c, room.Id, jwtClaims.Subject)
+	if !ok {
+		return
+	}
+	defer subscription.Unsubscribe()
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	presence := h.openPresenceSession(c.Request.Context(), room.Id, jwtClaims.Subject)
+	defer presence.close(c.Request.Context())
+
+	closed := make(chan struct{})
+
+	go func() {
+		defer close(closed)
+
+		conn.SetReadLimit(wsMaxMessageSize)
+		conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		})
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var frame dto.Frame
+
+			if err := json.Unmarshal(data, &frame); err != nil {
+				continue
+			}
+
+			switch frame.Type {
+			case dto.TypingFrame:
+				h.sendTyping(c.Request.Context(), jwtClaims, room.Id)
+			case dto.PresenceFrame:
+				presence.update(c.Request.Context(), frame.Status)
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-subscription.Closed():
+			message := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "removed from the room")
+			conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(wsWriteWait))
+			return
+		case messageEvent := <-subscription.Events():
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteJSON(messageEvent); err != nil {
+				return
+			}
+		case typingEvent := <-subscription.TypingEvents():
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteJSON(typingEvent); err != nil {
+				return
+			}
+		case presenceEvent := <-subscription.PresenceEvents():
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteJSON(presenceEvent); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sendTyping sends a typing event received from a WebSocket frame. There is no
+// response frame, so a rejected typing event is just dropped.
+func (h *RoomHandler) sendTyping(ctx context.Context, jwtClaims *middleware.JwtAllClaims, roomId string) {
+	input := &usecase.SendTypingUseCaseInput{
+		RoomId:   roomId,
+		UserId:   jwtClaims.Subject,
+		UserName: jwtClaims.Nickname,
+	}
+
+	_ = h.sendTypingUseCase.Execute(ctx, input)
+}
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListUserRooms godoc
+//
+// @Summary		List my rooms
+// @Description	List the rooms the user is a member of with their last message and unread count, the most recently active first.
+// @Tags		me
+// @Accept		json
+// @Produce		json
+// @Param		page				query				string	false	"Page"			default(0)
+// @Param		size				query				string	false	"Size"			default(10)
+// @Success		200	{object}		dto.UserRoomPage
+// @Failure		400 {object}		dto.HttpError
+// @Failure		401
+// @Failure		500
+// @Security	Bearer token
+// @Router		/me/rooms			[get]
+func (h *UserHandler) ListUserRooms(c *gin.Context) {
+	jwtClaims, err := middleware.JwtClaims(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	input := &usecase.ListUserRoomsUseCaseInput{
+		UserId: jwtClaims.Subject,
+		Page:   c.Query("page"),
+		Size:   c.Query("size"),
+	}
+
+	output, err := h.listUserRoomsUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		if _, ok := err.(validation.ValidationError); ok {
+			dto.AbortWithHttpError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		h.logger.Error(err)
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	mapper := func(r *usecase.ListUserRoomsUseCaseOutput) *dto.UserRoomResponse {
+		response := &dto.UserRoomResponse{
+			Id:             r.RoomId,
+			Name:           r.Name,
+			Category:       r.Category,
+			Visibility:     r.Visibility,
+			Role:           r.Role,
+			LastActivityAt: r.LastActivityAt,
+			UnreadCount:    r.UnreadCount,
+		}
+
+		if m := r.LastMessage; m != nil {
+			response.LastMessage = &dto.MessageResponse{
+				Id:         m.MessageId,
+				RoomId:     r.RoomId,
+				SenderId:   m.SenderId,
+				SenderName: m.SenderName,
+				Text:       m.Text,
+				CreatedAt:  m.CreatedAt,
+				EditedAt:   m.EditedAt,
+				DeletedAt:  m.DeletedAt,
+			}
+		}
+
+		return response
+	}
+
+	result := pagination.MapPage[*usecase.ListUserRoomsUseCaseOutput, *dto.UserRoomResponse](output, mapper)
+
+	page := &dto.UserRoomPage{
+		Page:  result.Page,
+		Size:  result.Size,
+		Total: result.Total,
+		Rooms: result.Items,
+	}
+
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,19 @@
+package user
+
+import (
+	usecase "github.com/sesaquecruz/go-chat-api/internal/usecase"
+
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type UserHandler struct {
+	listUserRoomsUseCase usecase.ListUserRoomsUseCase
+	logger               *log.Logger
+}
+
+func NewUserHandler(listUserRoomsUseCase usecase.ListUserRoomsUseCase) *UserHandler {
+	return &UserHandler{
+		listUserRoomsUseCase: listUserRoomsUseCase,
+		logger:               log.NewLogger("UserHandler"),
+	}
+}
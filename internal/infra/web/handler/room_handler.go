@@ -10,5 +10,38 @@ type RoomHandler interface {
 	SearchRoom(c *gin.Context)
 	UpdateRoom(c *gin.Context)
 	DeleteRoom(c *gin.Context)
+	TransferOwnership(c *gin.Context)
+	ListOwnershipTransfers(c *gin.Context)
 	SendMessage(c *gin.Context)
+	ListMessages(c *gin.Context)
+	ListReplies(c *gin.Context)
+	FindMessage(c *gin.Context)
+	EditMessage(c *gin.Context)
+	DeleteMessage(c *gin.Context)
+	MarkRoomAsRead(c *gin.Context)
+	ListMessageReaders(c *gin.Context)
+	ListMessageRevisions(c *gin.Context)
+	AddReaction(c *gin.Context)
+	RemoveReaction(c *gin.Context)
+	PinMessage(c *gin.Context)
+	UnpinMessage(c *gin.Context)
+	ListPins(c *gin.Context)
+	SendTyping(c *gin.Context)
+	UpdatePresence(c *gin.Context)
+	ListPresence(c *gin.Context)
+	JoinRoom(c *gin.Context)
+	LeaveRoom(c *gin.Context)
+	ListMembers(c *gin.Context)
+	PromoteMember(c *gin.Context)
+	DemoteMember(c *gin.Context)
+	KickMember(c *gin.Context)
+	BanMember(c *gin.Context)
+	UnbanMember(c *gin.Context)
+	MuteMember(c *gin.Context)
+	UnmuteMember(c *gin.Context)
+	CreateInvitation(c *gin.Context)
+	AcceptInvitation(c *gin.Context)
+	DeclineInvitation(c *gin.Context)
+	WebSocket(c *gin.Context)
+	Events(c *gin.Context)
 }
@@ -0,0 +1,9 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type UserHandler interface {
+	ListUserRooms(c *gin.Context)
+}
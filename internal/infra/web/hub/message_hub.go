@@ -0,0 +1,270 @@
+package hub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+const subscriptionBuffer = 32
+const receiveRetryDelay = time.Second
+
+type Subscription struct {
+	room           *room
+	userId         string
+	events         chan *event.MessageEvent
+	typingEvents   chan *event.TypingEvent
+	presenceEvents chan *event.PresenceEvent
+	hub            *MessageHub
+	once           sync.Once
+	closed         chan struct{}
+	closeOnce      sync.Once
+}
+
+func (s *Subscription) Events() <-chan *event.MessageEvent {
+	return s.events
+}
+
+func (s *Subscription) TypingEvents() <-chan *event.TypingEvent {
+	return s.typingEvents
+}
+
+func (s *Subscription) PresenceEvents() <-chan *event.PresenceEvent {
+	return s.presenceEvents
+}
+
+// Closed is closed when the user is kicked or banned from the room. The stream
+// of the subscription must be closed then.
+func (s *Subscription) Closed() <-chan struct{} {
+	return s.closed
+}
+
+func (s *Subscription) close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+}
+
+// Wait blocks until the events of the room are being received or the context
+// is done.
+func (s *Subscription) Wait(ctx context.Context) error {
+	select {
+	case <-s.room.ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.hub.unsubscribe(s)
+	})
+}
+
+type room struct {
+	id            string
+	subscriptions map[*Subscription]struct{}
+	ready         chan struct{}
+	readyOnce     sync.Once
+	cancel        context.CancelFunc
+}
+
+// MessageHub keeps one broker consumer of messages and one of typing events
+// per room with subscriptions in this instance. The room consumers start with
+// the first subscription to the room and stop after the last one is removed.
+type MessageHub struct {
+	messageEventGateway gateway.MessageEventGateway
+	typingEventGateway  gateway.TypingEventGateway
+	mu                  sync.Mutex
+	rooms               map[string]*room
+	logger              *log.Logger
+}
+
+func NewMessageHub(
+	messageEventGateway gateway.MessageEventGateway,
+	typingEventGateway gateway.TypingEventGateway,
+) *MessageHub {
+	return &MessageHub{
+		messageEventGateway: messageEventGateway,
+		typingEventGateway:  typingEventGateway,
+		rooms:               make(map[string]*room),
+		logger:              log.NewLogger("MessageHub"),
+	}
+}
+
+func (h *MessageHub) Subscribe(roomId string, userId string) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r, ok := h.rooms[roomId]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		r = &room{
+			id:            roomId,
+			subscriptions: make(map[*Subscription]struct{}),
+			ready:         make(chan struct{}),
+			cancel:        cancel,
+		}
+
+		h.rooms[roomId] = r
+		go h.receive(ctx, r)
+		go h.receiveTyping(ctx, r)
+	}
+
+	subscription := &Subscription{
+		room:           r,
+		userId:         userId,
+		events:         make(chan *event.MessageEvent, subscriptionBuffer),
+		typingEvents:   make(chan *event.TypingEvent, subscriptionBuffer),
+		presenceEvents: make(chan *event.PresenceEvent, subscriptionBuffer),
+		hub:            h,
+		closed:         make(chan struct{}),
+	}
+
+	r.subscriptions[subscription] = struct{}{}
+
+	return subscription
+}
+
+func (h *MessageHub) unsubscribe(subscription *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r := subscription.room
+	delete(r.subscriptions, subscription)
+
+	if len(r.subscriptions) == 0 {
+		delete(h.rooms, r.id)
+		r.cancel()
+	}
+}
+
+func (h *MessageHub) receive(ctx context.Context, r *room) {
+	messageEvents := make(chan *event.MessageEvent)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case messageEvent := <-messageEvents:
+				h.dispatch(r, messageEvent)
+			}
+		}
+	}()
+
+	ready := func() {
+		r.readyOnce.Do(func() {
+			close(r.ready)
+		})
+	}
+
+	for ctx.Err() == nil {
+		err := h.messageEventGateway.Receive(ctx, r.id, messageEvents, ready)
+		if err == nil {
+			continue
+		}
+
+		h.logger.Error(err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(receiveRetryDelay):
+		}
+	}
+}
+
+func (h *MessageHub) dispatch(r *room, messageEvent *event.MessageEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if messageEvent.RoomId != r.id {
+		return
+	}
+
+	for subscription := range r.subscriptions {
+		select {
+		case subscription.events <- messageEvent:
+		default:
+			h.logger.Warningf("dropping message %s for a slow subscriber of room %s\n", messageEvent.Id, messageEvent.RoomId)
+		}
+
+		if messageEvent.RemovesMember() && subscription.userId == messageEvent.MemberId {
+			subscription.close()
+		}
+	}
+}
+
+// receiveTyping delivers the typing events of the room. The subscriptions do
+// not wait for them, typing events sent meanwhile are not needed.
+func (h *MessageHub) receiveTyping(ctx context.Context, r *room) {
+	typingEvents := make(chan *event.TypingEvent)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case typingEvent := <-typingEvents:
+				h.dispatchTyping(r, typingEvent)
+			}
+		}
+	}()
+
+	for ctx.Err() == nil {
+		err := h.typingEventGateway.Receive(ctx, r.id, typingEvents, func() {})
+		if err == nil {
+			continue
+		}
+
+		h.logger.Error(err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(receiveRetryDelay):
+		}
+	}
+}
+
+func (h *MessageHub) dispatchTyping(r *room, typingEvent *event.TypingEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if typingEvent.RoomId != r.id {
+		return
+	}
+
+	for subscription := range r.subscriptions {
+		select {
+		case subscription.typingEvents <- typingEvent:
+		default:
+		}
+	}
+}
+
+// dispatchPresence delivers a presence change to the subscribers of the room
+// in this instance. The presence changes come from the PresenceTracker, which
+// already receives the presence events of every room.
+func (h *MessageHub) dispatchPresence(presenceEvent *event.PresenceEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r, ok := h.rooms[presenceEvent.RoomId]
+	if !ok {
+		return
+	}
+
+	for subscription := range r.subscriptions {
+		select {
+		case subscription.presenceEvents <- presenceEvent:
+		default:
+			h.logger.Warningf("dropping presence of %s for a slow subscriber of room %s\n", presenceEvent.UserId, presenceEvent.RoomId)
+		}
+	}
+}
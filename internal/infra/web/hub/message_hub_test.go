@@ -0,0 +1,215 @@
+package hub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeConsumer struct {
+	sent    chan *event.MessageEvent
+	stopped chan struct{}
+}
+
+func newFakeConsumer(messageEventGateway *mocks.MessageEventGatewayMock, roomId string) *fakeConsumer {
+	consumer := &fakeConsumer{
+		sent:    make(chan *event.MessageEvent),
+		stopped: make(chan struct{}),
+	}
+
+	messageEventGateway.EXPECT().
+		Receive(mock.Anything, roomId, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, roomId string, messageEvents chan<- *event.MessageEvent, ready func()) error {
+			defer close(consumer.stopped)
+
+			ready()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case messageEvent := <-consumer.sent:
+					messageEvents <- messageEvent
+				}
+			}
+		}).
+		Once()
+
+	return consumer
+}
+
+type fakeTypingConsumer struct {
+	sent    chan *event.TypingEvent
+	stopped chan struct{}
+}
+
+func newFakeTypingConsumer(typingEventGateway *mocks.TypingEventGatewayMock, roomId string) *fakeTypingConsumer {
+	consumer := &fakeTypingConsumer{
+		sent:    make(chan *event.TypingEvent),
+		stopped: make(chan struct{}),
+	}
+
+	typingEventGateway.EXPECT().
+		Receive(mock.Anything, roomId, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, roomId string, typingEvents chan<- *event.TypingEvent, ready func()) error {
+			defer close(consumer.stopped)
+
+			ready()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case typingEvent := <-consumer.sent:
+					typingEvents <- typingEvent
+				}
+			}
+		}).
+		Once()
+
+	return consumer
+}
+
+func TestMessageHub_ShouldDispatchEventsToTheRoomSubscribers(t *testing.T) {
+	roomId := "b3588483-4795-434a-877c-dcd158d6caa7"
+	otherRoomId := "4dbbd27d-7d0b-46a6-9e2e-2a5d2bc7c5a6"
+
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+	roomConsumer := newFakeConsumer(messageEventGateway, roomId)
+	otherRoomConsumer := newFakeConsumer(messageEventGateway, otherRoomId)
+
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+	roomTypingConsumer := newFakeTypingConsumer(typingEventGateway, roomId)
+	otherRoomTypingConsumer := newFakeTypingConsumer(typingEventGateway, otherRoomId)
+
+	hub := NewMessageHub(messageEventGateway, typingEventGateway)
+
+	subscription1 := hub.Subscribe(roomId, "auth0|64c8457bb160e37c8c34533b")
+	subscription2 := hub.Subscribe(roomId, "auth0|64c8457bb160e37c8c34533c")
+	subscription3 := hub.Subscribe(otherRoomId, "auth0|64c8457bb160e37c8c34533b")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for _, subscription := range []*Subscription{subscription1, subscription2, subscription3} {
+		assert.Nil(t, subscription.Wait(ctx))
+	}
+
+	messageEvent := &event.MessageEvent{Id: "1", RoomId: roomId}
+	roomConsumer.sent <- messageEvent
+
+	for _, subscription := range []*Subscription{subscription1, subscription2} {
+		select {
+		case received := <-subscription.Events():
+			assert.Equal(t, messageEvent, received)
+		case <-time.After(time.Second):
+			t.Fatal("subscription did not receive the event")
+		}
+	}
+
+	select {
+	case <-subscription3.Events():
+		t.Fatal("subscription of another room received the event")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	typingEvent := &event.TypingEvent{RoomId: roomId, UserId: "auth0|64c8457bb160e37c8c34533b"}
+	roomTypingConsumer.sent <- typingEvent
+
+	for _, subscription := range []*Subscription{subscription1, subscription2} {
+		select {
+		case received := <-subscription.TypingEvents():
+			assert.Equal(t, typingEvent, received)
+		case <-time.After(time.Second):
+			t.Fatal("subscription did not receive the typing event")
+		}
+	}
+
+	select {
+	case <-subscription3.TypingEvents():
+		t.Fatal("subscription of another room received the typing event")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	subscription1.Unsubscribe()
+	subscription3.Unsubscribe()
+	subscription3.Unsubscribe()
+
+	for _, stopped := range []chan struct{}{otherRoomConsumer.stopped, otherRoomTypingConsumer.stopped} {
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("consumer did not stop after the last subscription of the room was removed")
+		}
+	}
+
+	select {
+	case <-roomConsumer.stopped:
+		t.Fatal("consumer stopped while the room still has subscriptions")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	subscription2.Unsubscribe()
+
+	for _, stopped := range []chan struct{}{roomConsumer.stopped, roomTypingConsumer.stopped} {
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("consumer did not stop after the last subscription of the room was removed")
+		}
+	}
+}
+
+func TestMessageHub_ShouldCloseTheSubscriptionsOfARemovedMember(t *testing.T) {
+	roomId := "b3588483-4795-434a-877c-dcd158d6caa7"
+	userId := "auth0|64c8457bb160e37c8c34533b"
+
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+	roomConsumer := newFakeConsumer(messageEventGateway, roomId)
+
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+	newFakeTypingConsumer(typingEventGateway, roomId)
+
+	hub := NewMessageHub(messageEventGateway, typingEventGateway)
+
+	subscription1 := hub.Subscribe(roomId, userId)
+	defer subscription1.Unsubscribe()
+
+	subscription2 := hub.Subscribe(roomId, "auth0|64c8457bb160e37c8c34533c")
+	defer subscription2.Unsubscribe()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.Nil(t, subscription1.Wait(ctx))
+
+	messageEvent := &event.MessageEvent{Type: event.MemberBanned, Id: "1", RoomId: roomId, MemberId: userId}
+	roomConsumer.sent <- messageEvent
+
+	for _, subscription := range []*Subscription{subscription1, subscription2} {
+		select {
+		case received := <-subscription.Events():
+			assert.Equal(t, messageEvent, received)
+		case <-time.After(time.Second):
+			t.Fatal("subscription did not receive the event")
+		}
+	}
+
+	select {
+	case <-subscription1.Closed():
+	case <-time.After(time.Second):
+		t.Fatal("subscription of the removed member was not closed")
+	}
+
+	select {
+	case <-subscription2.Closed():
+		t.Fatal("subscription of another member was closed")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,178 @@
+package hub
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+const presenceExpirationPeriod = 10 * time.Second
+
+// PresenceTracker keeps the presence of the user sessions from the presence
+// events sent by every instance, and notifies the room subscribers of this
+// instance when the merged status of a user changes. Sessions without a
+// heartbeat within the presence timeout are expired as offline.
+//
+// Events and expirations are handled by a single goroutine, so the status
+// before and after each update is consistent.
+type PresenceTracker struct {
+	presenceRepository   repository.PresenceRepository
+	presenceEventGateway gateway.PresenceEventGateway
+	messageHub           *MessageHub
+	expirationPeriod     time.Duration
+	logger               *log.Logger
+}
+
+func NewPresenceTracker(
+	presenceRepository repository.PresenceRepository,
+	presenceEventGateway gateway.PresenceEventGateway,
+	messageHub *MessageHub,
+) *PresenceTracker {
+	return &PresenceTracker{
+		presenceRepository:   presenceRepository,
+		presenceEventGateway: presenceEventGateway,
+		messageHub:           messageHub,
+		expirationPeriod:     presenceExpirationPeriod,
+		logger:               log.NewLogger("PresenceTracker"),
+	}
+}
+
+// Start tracks the presences until the context is done.
+func (t *PresenceTracker) Start(ctx context.Context) {
+	presenceEvents := make(chan *event.PresenceEvent)
+
+	go t.receive(ctx, presenceEvents)
+
+	ticker := time.NewTicker(t.expirationPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case presenceEvent := <-presenceEvents:
+			t.apply(ctx, presenceEvent)
+		case <-ticker.C:
+			t.expire(ctx)
+		}
+	}
+}
+
+func (t *PresenceTracker) receive(ctx context.Context, presenceEvents chan<- *event.PresenceEvent) {
+	for ctx.Err() == nil {
+		err := t.presenceEventGateway.Receive(ctx, presenceEvents, func() {})
+		if err == nil {
+			continue
+		}
+
+		t.logger.Error(err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(receiveRetryDelay):
+		}
+	}
+}
+
+func (t *PresenceTracker) apply(ctx context.Context, presenceEvent *event.PresenceEvent) {
+	presence, err := presenceFromEvent(presenceEvent)
+	if err != nil {
+		t.logger.Error(err)
+		return
+	}
+
+	t.update(ctx, presence)
+}
+
+func (t *PresenceTracker) expire(ctx context.Context) {
+	presences, err := t.presenceRepository.ListExpired(ctx)
+	if err != nil {
+		t.logger.Error(err)
+		return
+	}
+
+	offline, _ := valueobject.NewPresenceStatusWith(valueobject.PresenceStatusOffline)
+
+	for _, p := range presences {
+		t.update(ctx, entity.NewPresenceWith(p.RoomId(), p.UserId(), p.SessionId(), offline, p.SeenAt()))
+	}
+}
+
+func (t *PresenceTracker) update(ctx context.Context, presence *entity.Presence) {
+	before, err := t.find(ctx, presence)
+	if err != nil {
+		t.logger.Error(err)
+		return
+	}
+
+	if presence.Status().IsOffline() {
+		err = t.presenceRepository.Delete(ctx, presence)
+	} else {
+		err = t.presenceRepository.Save(ctx, presence)
+	}
+
+	if err != nil {
+		t.logger.Error(err)
+		return
+	}
+
+	after, err := t.find(ctx, presence)
+	if err != nil {
+		t.logger.Error(err)
+		return
+	}
+
+	if before.Status().Value() == after.Status().Value() {
+		return
+	}
+
+	t.messageHub.dispatchPresence(event.NewPresenceEvent(after))
+}
+
+// find returns the merged presence of the user, which is offline when the user
+// has no sessions in the room.
+func (t *PresenceTracker) find(ctx context.Context, presence *entity.Presence) (*entity.Presence, error) {
+	merged, err := t.presenceRepository.FindByRoomAndUser(ctx, presence.RoomId(), presence.UserId())
+	if err == nil {
+		return merged, nil
+	}
+
+	if !errors.Is(err, repository.ErrNotFoundPresence) {
+		return nil, err
+	}
+
+	offline, _ := valueobject.NewPresenceStatusWith(valueobject.PresenceStatusOffline)
+
+	return entity.NewPresenceWith(presence.RoomId(), presence.UserId(), "", offline, presence.SeenAt()), nil
+}
+
+func presenceFromEvent(presenceEvent *event.PresenceEvent) (*entity.Presence, error) {
+	roomId, err := valueobject.NewIdWith(presenceEvent.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(presenceEvent.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := valueobject.NewPresenceStatusWith(presenceEvent.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	seenAt, err := valueobject.NewTimestampWith(presenceEvent.SeenAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewPresenceWith(roomId, userId, presenceEvent.SessionId, status, seenAt), nil
+}
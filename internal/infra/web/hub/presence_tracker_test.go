@@ -0,0 +1,98 @@
+package hub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/memory"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPresenceTracker_ShouldNotifyTheRoomWhenThePresenceOfAUserChanges(t *testing.T) {
+	roomId := valueobject.NewId()
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+	newFakeConsumer(messageEventGateway, roomId.Value())
+
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+	newFakeTypingConsumer(typingEventGateway, roomId.Value())
+
+	hub := NewMessageHub(messageEventGateway, typingEventGateway)
+
+	subscription := hub.Subscribe(roomId.Value(), "auth0|64c8457bb160e37c8c34533b")
+	defer subscription.Unsubscribe()
+
+	sent := make(chan *event.PresenceEvent)
+
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+	presenceEventGateway.EXPECT().
+		Receive(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, presenceEvents chan<- *event.PresenceEvent, ready func()) error {
+			ready()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case presenceEvent := <-sent:
+					presenceEvents <- presenceEvent
+				}
+			}
+		}).
+		Once()
+
+	tracker := NewPresenceTracker(memory.NewPresenceMemoryRepository(), presenceEventGateway, hub)
+	tracker.expirationPeriod = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go tracker.Start(ctx)
+
+	presence := func(sessionId, status string, seenAt *valueobject.Timestamp) *event.PresenceEvent {
+		presenceStatus, _ := valueobject.NewPresenceStatusWith(status)
+		return event.NewPresenceEvent(entity.NewPresenceWith(roomId, userId, sessionId, presenceStatus, seenAt))
+	}
+
+	expectChange := func(status string) {
+		select {
+		case received := <-subscription.PresenceEvents():
+			assert.Equal(t, event.PresenceChanged, received.Type)
+			assert.Equal(t, roomId.Value(), received.RoomId)
+			assert.Equal(t, userId.Value(), received.UserId)
+			assert.Empty(t, received.SessionId)
+			assert.Equal(t, status, received.Status)
+		case <-time.After(time.Second):
+			t.Fatalf("subscription did not receive the %s presence", status)
+		}
+	}
+
+	expectNoChange := func() {
+		select {
+		case received := <-subscription.PresenceEvents():
+			t.Fatalf("subscription received an unchanged %s presence", received.Status)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	sent <- presence("a session", valueobject.PresenceStatusOnline, valueobject.NewTimestamp())
+	expectChange(valueobject.PresenceStatusOnline)
+
+	sent <- presence("a session", valueobject.PresenceStatusOnline, valueobject.NewTimestamp())
+	sent <- presence("another session", valueobject.PresenceStatusAway, valueobject.NewTimestamp())
+	expectNoChange()
+
+	sent <- presence("a session", valueobject.PresenceStatusOffline, valueobject.NewTimestamp())
+	expectChange(valueobject.PresenceStatusAway)
+
+	sent <- presence("another session", valueobject.PresenceStatusAway, valueobject.NewTimestamp().Add(-entity.PresenceTimeout))
+	expectChange(valueobject.PresenceStatusOffline)
+}
@@ -17,6 +17,8 @@ func ApiRouter(
 	cfg *config.ApiConfig,
 	healthCheck health.Health,
 	roomHandler handler.RoomHandler,
+	directHandler handler.DirectHandler,
+	userHandler handler.UserHandler,
 ) *gin.Engine {
 	gin.SetMode(cfg.Mode)
 
@@ -32,6 +34,8 @@ func ApiRouter(
 		api.Use(middleware.JwtMiddleware(cfg.JwtIssuer, []string{cfg.JwtAudience}))
 
 		RoomRouter(api, roomHandler)
+		DirectRouter(api, directHandler)
+		UserRouter(api, userHandler)
 	}
 
 	return r
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -8,6 +9,8 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,28 +22,38 @@ import (
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/database"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/event"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/memory"
 	"github.com/sesaquecruz/go-chat-api/internal/infra/web/dto"
+	direct_handler "github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/direct"
 	room_handler "github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/room"
+	user_handler "github.com/sesaquecruz/go-chat-api/internal/infra/web/handler/impl/user"
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/hub"
 	usecase "github.com/sesaquecruz/go-chat-api/internal/usecase/impl"
 	"github.com/sesaquecruz/go-chat-api/pkg/health"
+	"github.com/sesaquecruz/go-chat-api/pkg/middleware"
 	"github.com/sesaquecruz/go-chat-api/test/services"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
 var db, _ = services.NewPostgresContainer(context.Background(), "file://../../../../")
-var broker, _ = services.NewRabbitmqContainer(context.Background(), "../../../../")
+var broker, _ = services.NewRabbitmqContainer(context.Background())
 var auth = services.NewAuth0Server()
 
 type RouterTestSuite struct {
 	suite.Suite
 	ctx                 context.Context
 	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
 	messageRepository   repository.MessageRepository
 	messageEventGateway gateway.MessageEventGateway
+	typingEventGateway  gateway.TypingEventGateway
 	router              *gin.Engine
+	stopRelay           context.CancelFunc
+	stopTracker         context.CancelFunc
 }
 
 func (s *RouterTestSuite) SetupTest() {
@@ -54,24 +67,89 @@ func (s *RouterTestSuite) SetupTest() {
 		Name:     db.Name,
 	})
 
-	conn := event.RabbitMqConnection(&config.BrokerConfig{
-		Host:     broker.Host,
-		Port:     broker.Port,
-		User:     broker.User,
-		Password: broker.Password,
-	})
+	brokerConfig := &config.BrokerConfig{
+		Host:             broker.Host,
+		Port:             broker.Port,
+		User:             broker.User,
+		Password:         broker.Password,
+		Exchange:         "messages",
+		TypingExchange:   "typing",
+		PresenceExchange: "presence",
+	}
 
+	conn := event.NewRabbitMqConnection(brokerConfig)
+
+	transactionManager := database.NewPostgresTransactionManager(db)
 	roomRepository := database.NewRoomPostgresRepository(db)
+	memberRepository := database.NewMemberPostgresRepository(db)
+	invitationRepository := database.NewInvitationPostgresRepository(db)
+	ownershipTransferRepository := database.NewOwnershipTransferPostgresRepository(db)
+	banRepository := database.NewBanPostgresRepository(db)
+	muteRepository := database.NewMutePostgresRepository(db)
+	directRoomRepository := database.NewDirectRoomPostgresRepository(db)
+	userRoomRepository := database.NewUserRoomPostgresRepository(db)
 	messageRepository := database.NewMessagePostgresRepository(db)
+	messageRevisionRepository := database.NewMessageRevisionPostgresRepository(db)
+	reactionRepository := database.NewReactionPostgresRepository(db)
+	pinRepository := database.NewPinPostgresRepository(db)
+	idempotentRequestRepository := database.NewIdempotentRequestPostgresRepository(db)
+
+	brokerGateway := event.NewMessageEventRabbitMqGateway(conn, brokerConfig)
+	messageEventGateway := database.NewMessageEventOutboxGateway(db, brokerGateway)
 
-	messageEventGateway := event.NewMessageEventRabbitMqGateway(conn)
+	typingEventGateway := event.NewTypingEventRabbitMqGateway(conn, brokerConfig)
+	presenceEventGateway := event.NewPresenceEventRabbitMqGateway(conn, brokerConfig)
+	presenceRepository := memory.NewPresenceMemoryRepository()
 
-	createRoomUseCase := usecase.NewCreateRoomUseCase(roomRepository)
-	findRoomUseCase := usecase.NewFindRoomUseCase(roomRepository)
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	go database.NewOutboxRelay(db, brokerGateway).Start(relayCtx)
+
+	createRoomUseCase := usecase.NewCreateRoomUseCase(transactionManager, roomRepository, memberRepository)
+	findRoomUseCase := usecase.NewFindRoomUseCase(roomRepository, memberRepository, banRepository)
 	searchRoomUseCase := usecase.NewSearchRoomUseCase(roomRepository)
-	updateRoomUsecase := usecase.NewUpdateRoomUseCase(roomRepository)
-	deleteRoomUseCase := usecase.NewDeleteRoomUseCase(roomRepository)
-	createMessageUseCase := usecase.NewSendMessageUseCase(roomRepository, messageRepository, messageEventGateway)
+	updateRoomUsecase := usecase.NewUpdateRoomUseCase(roomRepository, memberRepository)
+	deleteRoomUseCase := usecase.NewDeleteRoomUseCase(roomRepository, memberRepository)
+	transferOwnershipUseCase := usecase.NewTransferOwnershipUseCase(transactionManager, roomRepository, memberRepository, ownershipTransferRepository, messageEventGateway)
+	listOwnershipTransfersUseCase := usecase.NewListOwnershipTransfersUseCase(roomRepository, memberRepository, banRepository, ownershipTransferRepository)
+	joinRoomUseCase := usecase.NewJoinRoomUseCase(roomRepository, memberRepository, banRepository)
+	leaveRoomUseCase := usecase.NewLeaveRoomUseCase(roomRepository, memberRepository)
+	listMembersUseCase := usecase.NewListMembersUseCase(roomRepository, memberRepository, banRepository)
+	promoteMemberUseCase := usecase.NewPromoteMemberUseCase(roomRepository, memberRepository)
+	demoteMemberUseCase := usecase.NewDemoteMemberUseCase(roomRepository, memberRepository)
+	kickMemberUseCase := usecase.NewKickMemberUseCase(transactionManager, roomRepository, memberRepository, messageEventGateway)
+	banMemberUseCase := usecase.NewBanMemberUseCase(transactionManager, roomRepository, memberRepository, banRepository, messageEventGateway)
+	unbanMemberUseCase := usecase.NewUnbanMemberUseCase(roomRepository, memberRepository, banRepository)
+	muteMemberUseCase := usecase.NewMuteMemberUseCase(roomRepository, memberRepository, muteRepository)
+	unmuteMemberUseCase := usecase.NewUnmuteMemberUseCase(roomRepository, memberRepository, muteRepository)
+	createInvitationUseCase := usecase.NewCreateInvitationUseCase(roomRepository, memberRepository, invitationRepository)
+	acceptInvitationUseCase := usecase.NewAcceptInvitationUseCase(transactionManager, roomRepository, memberRepository, banRepository, invitationRepository)
+	declineInvitationUseCase := usecase.NewDeclineInvitationUseCase(roomRepository, invitationRepository)
+	createMessageUseCase := usecase.NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+	listMessagesUseCase := usecase.NewListMessagesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+	listRepliesUseCase := usecase.NewListRepliesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+	findMessageUseCase := usecase.NewFindMessageUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+	editMessageUseCase := usecase.NewEditMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, messageRevisionRepository, messageEventGateway)
+	deleteMessageUseCase := usecase.NewDeleteMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+	markRoomAsReadUseCase := usecase.NewMarkRoomAsReadUseCase(transactionManager, roomRepository, memberRepository, messageRepository, messageEventGateway)
+	listMessageReadersUseCase := usecase.NewListMessageReadersUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+	listMessageRevisionsUseCase := usecase.NewListMessageRevisionsUseCase(roomRepository, memberRepository, banRepository, messageRepository, messageRevisionRepository)
+	addReactionUseCase := usecase.NewAddReactionUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, reactionRepository, messageEventGateway)
+	removeReactionUseCase := usecase.NewRemoveReactionUseCase(transactionManager, roomRepository, memberRepository, messageRepository, reactionRepository, messageEventGateway)
+	pinMessageUseCase := usecase.NewPinMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+	unpinMessageUseCase := usecase.NewUnpinMessageUseCase(transactionManager, roomRepository, memberRepository, pinRepository, messageEventGateway)
+	listPinsUseCase := usecase.NewListPinsUseCase(roomRepository, memberRepository, banRepository, pinRepository)
+	sendTypingUseCase := usecase.NewSendTypingUseCase(roomRepository, memberRepository, muteRepository, typingEventGateway)
+	updatePresenceUseCase := usecase.NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+	listPresenceUseCase := usecase.NewListPresenceUseCase(roomRepository, memberRepository, banRepository, presenceRepository)
+	replayMessagesUseCase := usecase.NewReplayMessagesUseCase(roomRepository, messageRepository)
+	openDirectRoomUseCase := usecase.NewOpenDirectRoomUseCase(transactionManager, roomRepository, memberRepository, directRoomRepository)
+	listDirectRoomsUseCase := usecase.NewListDirectRoomsUseCase(directRoomRepository)
+	listUserRoomsUseCase := usecase.NewListUserRoomsUseCase(userRoomRepository)
+
+	messageHub := hub.NewMessageHub(messageEventGateway, typingEventGateway)
+
+	trackerCtx, stopTracker := context.WithCancel(context.Background())
+	go hub.NewPresenceTracker(presenceRepository, presenceEventGateway, messageHub).Start(trackerCtx)
 
 	health := health.NewHealthCheck(db, conn)
 
@@ -81,9 +159,49 @@ func (s *RouterTestSuite) SetupTest() {
 		findRoomUseCase,
 		updateRoomUsecase,
 		deleteRoomUseCase,
+		transferOwnershipUseCase,
+		listOwnershipTransfersUseCase,
+		joinRoomUseCase,
+		leaveRoomUseCase,
+		listMembersUseCase,
+		promoteMemberUseCase,
+		demoteMemberUseCase,
+		kickMemberUseCase,
+		banMemberUseCase,
+		unbanMemberUseCase,
+		muteMemberUseCase,
+		unmuteMemberUseCase,
+		createInvitationUseCase,
+		acceptInvitationUseCase,
+		declineInvitationUseCase,
 		createMessageUseCase,
+		listMessagesUseCase,
+		listRepliesUseCase,
+		findMessageUseCase,
+		editMessageUseCase,
+		deleteMessageUseCase,
+		markRoomAsReadUseCase,
+		listMessageReadersUseCase,
+		listMessageRevisionsUseCase,
+		addReactionUseCase,
+		removeReactionUseCase,
+		pinMessageUseCase,
+		unpinMessageUseCase,
+		listPinsUseCase,
+		sendTypingUseCase,
+		updatePresenceUseCase,
+		listPresenceUseCase,
+		replayMessagesUseCase,
+		messageHub,
+	)
+
+	directHandler := direct_handler.NewDirectHandler(
+		openDirectRoomUseCase,
+		listDirectRoomsUseCase,
 	)
 
+	userHandler := user_handler.NewUserHandler(listUserRoomsUseCase)
+
 	router := ApiRouter(&config.ApiConfig{
 		Port:         "",
 		Path:         "/api/v1",
@@ -94,13 +212,24 @@ func (s *RouterTestSuite) SetupTest() {
 	},
 		health,
 		roomHandler,
+		directHandler,
+		userHandler,
 	)
 
 	s.ctx = context.Background()
 	s.roomRepository = roomRepository
+	s.memberRepository = memberRepository
 	s.messageRepository = messageRepository
 	s.messageEventGateway = messageEventGateway
+	s.typingEventGateway = typingEventGateway
 	s.router = router
+	s.stopRelay = stopRelay
+	s.stopTracker = stopTracker
+}
+
+func (s *RouterTestSuite) TearDownTest() {
+	s.stopRelay()
+	s.stopTracker()
 }
 
 func (s *RouterTestSuite) TearDownSuite() {
@@ -412,6 +541,7 @@ func (s *RouterTestSuite) TestShouldUpdateARoom() {
 	category, _ := valueobject.NewRoomCategoryWith("Tech")
 	room := entity.NewRoom(adminId, name, category)
 	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), adminId))
 
 	payload := struct {
 		Name     string `json:"name"`
@@ -456,6 +586,7 @@ func (s *RouterTestSuite) TestShouldDeleteARoom() {
 	room := entity.NewRoom(adminId, name, category)
 
 	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), adminId))
 	assert.False(t, room.IsDeleted())
 
 	w := httptest.NewRecorder()
@@ -473,53 +604,1610 @@ func (s *RouterTestSuite) TestShouldDeleteARoom() {
 	assert.True(t, savedRoom.IsDeleted())
 }
 
-func (s *RouterTestSuite) TestCreateMessage_ShouldCreateAMessage() {
+func (s *RouterTestSuite) TestMembers_ShouldJoinLeaveAndListTheRoomMembers() {
 	defer db.Clear()
 	t := s.T()
 	r := s.router
 
-	userName := auth.GetNickname()
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	w := httptest.NewRecorder()
+	body, _ := json.Marshal(map[string]string{"name": "A Game", "category": "Game"})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/rooms", bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+adminJwt)
+
+	r.ServeHTTP(w, req)
+	res := w.Result()
+
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	roomUrl := res.Header.Get("Location")
+
 	userId := auth.GenerateSub()
-	jwt, _ := auth.GenerateJWT(userId)
+	userJwt, _ := auth.GenerateJWT(userId)
 
-	room := createARoom(userId, "A Game", "Game")
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	listMembers := func() dto.MemberPage {
+		res := request(http.MethodGet, roomUrl+"/members", userJwt, nil)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var page dto.MemberPage
+		json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+
+		return page
+	}
+
+	message, _ := json.Marshal(map[string]string{"text": "A text"})
+
+	res = request(http.MethodPost, roomUrl+"/send", userJwt, message)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	page := listMembers()
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, adminId, page.Members[0].UserId)
+
+	res = request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	page = listMembers()
+	assert.Equal(t, int64(2), page.Total)
+	assert.Equal(t, userId, page.Members[1].UserId)
+
+	res = request(http.MethodPost, roomUrl+"/send", userJwt, message)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/leave", adminJwt, nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/leave", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/leave", userJwt, nil)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	page = listMembers()
+	assert.Equal(t, int64(1), page.Total)
+}
+
+func (s *RouterTestSuite) TestRoles_ShouldLetTheOwnerPromoteAndDemoteMembers() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	body, _ := json.Marshal(map[string]string{"name": "A Game", "category": "Game"})
+	res := request(http.MethodPost, "/api/v1/rooms", adminJwt, body)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	roomUrl := res.Header.Get("Location")
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	res = request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	body, _ = json.Marshal(map[string]string{"name": "Other Game", "category": "Game"})
+	res = request(http.MethodPut, roomUrl, userJwt, body)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/members/"+adminId+"/demote", userJwt, nil)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/members/"+userId+"/promote", adminJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/members/"+userId+"/promote", adminJwt, nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	res = request(http.MethodGet, roomUrl+"/members", userJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var page dto.MemberPage
+	json.NewDecoder(res.Body).Decode(&page)
+	res.Body.Close()
+
+	assert.Equal(t, int64(2), page.Total)
+	assert.Equal(t, valueobject.MemberRoleOwner, page.Members[0].Role)
+	assert.Equal(t, valueobject.MemberRoleModerator, page.Members[1].Role)
+
+	res = request(http.MethodPost, roomUrl+"/members/"+userId+"/demote", adminJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/members/"+auth.GenerateSub()+"/promote", adminJwt, nil)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func (s *RouterTestSuite) TestModeration_ShouldLetModeratorsKickBanAndMuteMembers() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	body, _ := json.Marshal(map[string]string{"name": "A Game", "category": "Game"})
+	res := request(http.MethodPost, "/api/v1/rooms", adminJwt, body)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	roomUrl := res.Header.Get("Location")
+
+	moderatorId := auth.GenerateSub()
+	moderatorJwt, _ := auth.GenerateJWT(moderatorId)
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	for _, jwt := range []string{moderatorJwt, userJwt} {
+		res = request(http.MethodPost, roomUrl+"/join", jwt, nil)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	}
+
+	res = request(http.MethodPost, roomUrl+"/members/"+moderatorId+"/promote", adminJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodDelete, roomUrl+"/members/"+moderatorId, userJwt, nil)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = request(http.MethodDelete, roomUrl+"/members/"+adminId, moderatorJwt, nil)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = request(http.MethodDelete, roomUrl+"/members/"+userId, moderatorJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	expiresAt := valueobject.NewTimestamp().Add(time.Hour).Value()
+	body, _ = json.Marshal(map[string]string{"user_id": userId, "expires_at": expiresAt})
+	res = request(http.MethodPost, roomUrl+"/mutes", moderatorJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	body, _ = json.Marshal(map[string]string{"text": "A text"})
+	res = request(http.MethodPost, roomUrl+"/send", userJwt, body)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = request(http.MethodDelete, roomUrl+"/mutes/"+userId, moderatorJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/send", userJwt, body)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	body, _ = json.Marshal(map[string]string{"user_id": userId})
+	res = request(http.MethodPost, roomUrl+"/bans", moderatorJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = request(http.MethodGet, roomUrl+"/messages", userJwt, nil)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = request(http.MethodGet, roomUrl+"/members", adminJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var page dto.MemberPage
+	json.NewDecoder(res.Body).Decode(&page)
+	res.Body.Close()
+	assert.Equal(t, int64(2), page.Total)
+
+	res = request(http.MethodDelete, roomUrl+"/bans/"+userId, adminJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodDelete, roomUrl+"/bans/"+userId, adminJwt, nil)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	body, _ = json.Marshal(map[string]string{"user_id": userId})
+	res = request(http.MethodPost, roomUrl+"/bans", adminJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodDelete, roomUrl+"/bans/"+userId, moderatorJwt, nil)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func (s *RouterTestSuite) TestDirectRooms_ShouldOpenAndListDirectRooms() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	recipientId := auth.GenerateSub()
+	recipientJwt, _ := auth.GenerateJWT(recipientId)
+
+	body, _ := json.Marshal(map[string]string{"user_id": recipientId})
+	res := request(http.MethodPost, "/api/v1/dms", userJwt, body)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var created dto.DirectRoomResponse
+	json.NewDecoder(res.Body).Decode(&created)
+	res.Body.Close()
+
+	roomUrl := res.Header.Get("Location")
+	assert.Equal(t, "/api/v1/rooms/"+created.RoomId, roomUrl)
+	assert.Equal(t, recipientId, created.UserId)
+
+	body, _ = json.Marshal(map[string]string{"user_id": userId})
+	res = request(http.MethodPost, "/api/v1/dms", recipientJwt, body)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var found dto.DirectRoomResponse
+	json.NewDecoder(res.Body).Decode(&found)
+	res.Body.Close()
+	assert.Equal(t, created.RoomId, found.RoomId)
+
+	body, _ = json.Marshal(map[string]string{"user_id": userId})
+	res = request(http.MethodPost, "/api/v1/dms", userJwt, body)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	strangerJwt, _ := auth.GenerateJWT(auth.GenerateSub())
+
+	res = request(http.MethodGet, roomUrl, strangerJwt, nil)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = request(http.MethodGet, "/api/v1/rooms", userJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var rooms dto.RoomPage
+	json.NewDecoder(res.Body).Decode(&rooms)
+	res.Body.Close()
+	assert.Equal(t, int64(0), rooms.Total)
+
+	res = request(http.MethodGet, "/api/v1/dms", recipientJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var page dto.DirectRoomPage
+	json.NewDecoder(res.Body).Decode(&page)
+	res.Body.Close()
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, userId, page.Rooms[0].UserId)
+	assert.Nil(t, page.Rooms[0].LastMessage)
+
+	body, _ = json.Marshal(map[string]string{"text": "A text"})
+	res = request(http.MethodPost, roomUrl+"/send", userJwt, body)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	res = request(http.MethodGet, "/api/v1/dms", recipientJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	page = dto.DirectRoomPage{}
+	json.NewDecoder(res.Body).Decode(&page)
+	res.Body.Close()
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, "A text", page.Rooms[0].LastMessage.Text)
+	assert.Equal(t, userId, page.Rooms[0].LastMessage.SenderId)
+
+	res = request(http.MethodGet, roomUrl+"/messages", recipientJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func (s *RouterTestSuite) TestUserRooms_ShouldListTheRoomsWithTheirUnreadCount() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	room := createARoom(adminId, "A Game", "Game")
 	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+	roomUrl := "/api/v1/rooms/" + room.Id().Value()
 
-	payload := struct {
-		Text string `json:"text"`
-	}{
-		"A text",
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	res := request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	var messages []dto.MessageResponse
+
+	for _, text := range []string{"A text", "Another text"} {
+		body, _ := json.Marshal(map[string]string{"text": text})
+		res = request(http.MethodPost, roomUrl+"/send", adminJwt, body)
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+		var message dto.MessageResponse
+		json.NewDecoder(res.Body).Decode(&message)
+		res.Body.Close()
+
+		messages = append(messages, message)
 	}
 
-	url := fmt.Sprintf("/api/v1/rooms/%s/send", room.Id().Value())
-	body, _ := json.Marshal(payload)
+	listRooms := func(jwt string) *dto.UserRoomPage {
+		res := request(http.MethodGet, "/api/v1/me/rooms", jwt, nil)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
 
-	w := httptest.NewRecorder()
-	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
-	req.Header.Set("Authorization", "Bearer "+jwt)
+		var page dto.UserRoomPage
+		json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
 
-	r.ServeHTTP(w, req)
-	res := w.Result()
+		return &page
+	}
+
+	page := listRooms(userJwt)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, room.Id().Value(), page.Rooms[0].Id)
+	assert.Equal(t, valueobject.MemberRoleMember, page.Rooms[0].Role)
+	assert.Equal(t, int64(2), page.Rooms[0].UnreadCount)
+	assert.Equal(t, "Another text", page.Rooms[0].LastMessage.Text)
+	assert.Equal(t, page.Rooms[0].LastMessage.CreatedAt, page.Rooms[0].LastActivityAt)
+
+	page = listRooms(adminJwt)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, int64(0), page.Rooms[0].UnreadCount)
+
+	body, _ := json.Marshal(map[string]string{"message_id": messages[0].Id})
+	res = request(http.MethodPost, roomUrl+"/read", userJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	page = listRooms(userJwt)
+	assert.Equal(t, int64(1), page.Rooms[0].UnreadCount)
+
+	body, _ = json.Marshal(map[string]string{"message_id": messages[1].Id})
+	res = request(http.MethodPost, roomUrl+"/read", userJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	body, _ = json.Marshal(map[string]string{"message_id": messages[0].Id})
+	res = request(http.MethodPost, roomUrl+"/read", userJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	page = listRooms(userJwt)
+	assert.Equal(t, int64(0), page.Rooms[0].UnreadCount)
+
+	body, _ = json.Marshal(map[string]string{"message_id": valueobject.NewId().Value()})
+	res = request(http.MethodPost, roomUrl+"/read", userJwt, body)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	strangerJwt, _ := auth.GenerateJWT(auth.GenerateSub())
+
+	body, _ = json.Marshal(map[string]string{"message_id": messages[1].Id})
+	res = request(http.MethodPost, roomUrl+"/read", strangerJwt, body)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	page = listRooms(strangerJwt)
+	assert.Equal(t, int64(0), page.Total)
+}
+
+func (s *RouterTestSuite) TestReadReceipts_ShouldNotifyTheRoomAndListTheReaders() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
 
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	room := createARoom(adminId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+	roomUrl := "/api/v1/rooms/" + room.Id().Value()
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	res := request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	body, _ := json.Marshal(map[string]string{"text": "A text"})
+	res = request(http.MethodPost, roomUrl+"/send", adminJwt, body)
 	assert.Equal(t, http.StatusCreated, res.StatusCode)
 
-	msgs := make(chan *domain_event.MessageEvent)
-	defer close(msgs)
+	var message dto.MessageResponse
+	json.NewDecoder(res.Body).Decode(&message)
+	res.Body.Close()
+
+	readersUrl := roomUrl + "/messages/" + message.Id + "/readers"
+
+	listReaders := func(jwt string) *dto.ReaderPage {
+		res := request(http.MethodGet, readersUrl, jwt, nil)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var page dto.ReaderPage
+		json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+
+		return &page
+	}
+
+	assert.Equal(t, int64(0), listReaders(adminJwt).Total)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	msgs := make(chan *domain_event.MessageEvent, 4)
+	ready := make(chan struct{})
 
 	go func() {
-		err := s.messageEventGateway.Receive(s.ctx, msgs)
+		err := s.messageEventGateway.Receive(ctx, room.Id().Value(), msgs, func() { close(ready) })
 		if err != nil {
 			t.Error(err)
 		}
 	}()
 
 	select {
-	case msg := <-msgs:
-		assert.Equal(t, room.Id().Value(), msg.RoomId)
-		assert.Equal(t, userId, msg.SenderId)
-		assert.Equal(t, userName, msg.SenderName)
-		assert.Equal(t, payload.Text, msg.Text)
+	case <-ready:
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	// The message may still be relayed after the stream started.
+	receipts := make(chan *domain_event.MessageEvent, 2)
+
+	go func() {
+		for messageEvent := range msgs {
+			if messageEvent.Type == domain_event.MessageRead {
+				receipts <- messageEvent
+			}
+		}
+	}()
+
+	body, _ = json.Marshal(map[string]string{"message_id": message.Id})
+	res = request(http.MethodPost, roomUrl+"/read", userJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	select {
+	case receipt := <-receipts:
+		assert.Equal(t, message.Id, receipt.Id)
+		assert.Equal(t, userId, receipt.SenderId)
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	res = request(http.MethodPost, roomUrl+"/read", userJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	select {
+	case <-receipts:
+		t.Fatal("a receipt was sent when the last read message did not change")
+	case <-time.After(time.Second):
+	}
+
+	page := listReaders(adminJwt)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, userId, page.Readers[0].UserId)
+
+	res = request(http.MethodGet, readersUrl, userJwt, nil)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func (s *RouterTestSuite) TestTransferOwnership_ShouldChangeTheRoomAdmin() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	room := createARoom(adminId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	res := request(http.MethodPost, "/api/v1/rooms/"+room.Id().Value()+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	msgs := make(chan *domain_event.MessageEvent)
+	ready := make(chan struct{})
+
+	go func() {
+		err := s.messageEventGateway.Receive(ctx, room.Id().Value(), msgs, func() { close(ready) })
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	transfer := func(jwt, newAdminId string) int {
+		body, _ := json.Marshal(map[string]string{"admin_id": newAdminId})
+		return request(http.MethodPost, "/api/v1/rooms/"+room.Id().Value()+"/transfer", jwt, body).StatusCode
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, transfer(userJwt, userId))
+	assert.Equal(t, http.StatusUnprocessableEntity, transfer(adminJwt, auth.GenerateSub()))
+	assert.Equal(t, http.StatusNoContent, transfer(adminJwt, userId))
+
+	select {
+	case messageEvent := <-msgs:
+		assert.Equal(t, domain_event.RoomOwnershipTransferred, messageEvent.Type)
+		assert.Equal(t, adminId, messageEvent.PreviousAdminId)
+		assert.Equal(t, userId, messageEvent.AdminId)
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	savedRoom, _ := s.roomRepository.FindById(s.ctx, room.Id())
+	assert.Equal(t, userId, savedRoom.AdminId().Value())
+
+	assert.Equal(t, http.StatusUnauthorized, transfer(adminJwt, adminId))
+
+	platformAdminJwt, _ := auth.GenerateJWT(auth.GenerateSub(), middleware.PlatformAdminPermission)
+	assert.Equal(t, http.StatusNoContent, transfer(platformAdminJwt, adminId))
+
+	savedRoom, _ = s.roomRepository.FindById(s.ctx, room.Id())
+	assert.Equal(t, adminId, savedRoom.AdminId().Value())
+
+	res = request(http.MethodGet, "/api/v1/rooms/"+room.Id().Value()+"/transfers", userJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var transfers []*dto.OwnershipTransferResponse
+	err := json.NewDecoder(res.Body).Decode(&transfers)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(transfers))
+	assert.Equal(t, adminId, transfers[0].PreviousAdminId)
+	assert.Equal(t, userId, transfers[0].AdminId)
+	assert.Equal(t, adminId, transfers[1].AdminId)
+}
+
+func (s *RouterTestSuite) TestInvitations_ShouldLetTheInviteeJoinAPrivateRoom() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	body, _ := json.Marshal(map[string]string{"name": "A Secret", "category": "General", "visibility": "private"})
+	res := request(http.MethodPost, "/api/v1/rooms", adminJwt, body)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	roomUrl := res.Header.Get("Location")
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	searchTotal := func(jwt string) int64 {
+		res := request(http.MethodGet, "/api/v1/rooms?search=secret", jwt, nil)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var page dto.RoomPage
+		json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+
+		return page.Total
+	}
+
+	assert.Equal(t, int64(1), searchTotal(adminJwt))
+	assert.Equal(t, int64(0), searchTotal(userJwt))
+
+	res = request(http.MethodGet, roomUrl, userJwt, nil)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	invite := func(inviteeId string) dto.InvitationResponse {
+		body, _ := json.Marshal(map[string]string{"invitee_id": inviteeId})
+		res := request(http.MethodPost, roomUrl+"/invitations", adminJwt, body)
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+		var invitation dto.InvitationResponse
+		json.NewDecoder(res.Body).Decode(&invitation)
+		res.Body.Close()
+
+		return invitation
+	}
+
+	body, _ = json.Marshal(map[string]string{"invitee_id": adminId})
+	res = request(http.MethodPost, roomUrl+"/invitations", userJwt, body)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	invitation := invite(userId)
+	assert.Equal(t, userId, invitation.InviteeId)
+
+	res = request(http.MethodPost, roomUrl+"/invitations/"+invitation.Token+"/accept", adminJwt, nil)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/invitations/"+invitation.Token+"/decline", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = request(http.MethodPost, roomUrl+"/invitations/"+invitation.Token+"/accept", userJwt, nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	invitation = invite(userId)
+
+	res = request(http.MethodPost, roomUrl+"/invitations/"+invitation.Token+"/accept", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	assert.Equal(t, int64(1), searchTotal(userJwt))
+
+	res = request(http.MethodGet, roomUrl, userJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var room dto.RoomResponse
+	json.NewDecoder(res.Body).Decode(&room)
+	res.Body.Close()
+	assert.Equal(t, "private", room.Visibility)
+
+	body, _ = json.Marshal(map[string]string{"invitee_id": userId})
+	res = request(http.MethodPost, roomUrl+"/invitations", adminJwt, body)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+}
+
+func (s *RouterTestSuite) TestCreateMessage_ShouldCreateAMessage() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	userName := auth.GetNickname()
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	payload := struct {
+		Text string `json:"text"`
+	}{
+		"A text",
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	msgs := make(chan *domain_event.MessageEvent)
+	ready := make(chan struct{})
+
+	go func() {
+		err := s.messageEventGateway.Receive(ctx, room.Id().Value(), msgs, func() { close(ready) })
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	url := fmt.Sprintf("/api/v1/rooms/%s/send", room.Id().Value())
+	body, _ := json.Marshal(payload)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	res := w.Result()
+
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var created dto.MessageResponse
+	json.NewDecoder(res.Body).Decode(&created)
+	res.Body.Close()
+
+	assert.Equal(t, room.Id().Value(), created.RoomId)
+	assert.Equal(t, userId, created.SenderId)
+	assert.Equal(t, userName, created.SenderName)
+	assert.Equal(t, payload.Text, created.Text)
+	assert.NotEmpty(t, created.CreatedAt)
+
+	location := res.Header.Get("Location")
+	assert.Equal(t, fmt.Sprintf("/api/v1/rooms/%s/messages/%s", room.Id().Value(), created.Id), location)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, location, nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	res = w.Result()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var found dto.MessageResponse
+	json.NewDecoder(res.Body).Decode(&found)
+	res.Body.Close()
+
+	assert.Equal(t, created, found)
+
+	select {
+	case msg := <-msgs:
+		assert.Equal(t, created.Id, msg.Id)
+		assert.Equal(t, room.Id().Value(), msg.RoomId)
+		assert.Equal(t, userId, msg.SenderId)
+		assert.Equal(t, userName, msg.SenderName)
+		assert.Equal(t, payload.Text, msg.Text)
+	case <-time.After(30 * time.Second):
+		t.Fail()
+	}
+}
+
+func (s *RouterTestSuite) TestCreateMessage_ShouldReplayRequestsWithTheSameIdempotencyKey() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	sendMessage := func(text string) *http.Response {
+		url := fmt.Sprintf("/api/v1/rooms/%s/send", room.Id().Value())
+		body, _ := json.Marshal(map[string]string{"text": text})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+		req.Header.Set("Idempotency-Key", "a-key")
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	res := sendMessage("A text")
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	assert.Empty(t, res.Header.Get("Idempotent-Replayed"))
+
+	res = sendMessage("A text")
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	assert.Equal(t, "true", res.Header.Get("Idempotent-Replayed"))
+
+	res = sendMessage("Another text")
+	assert.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	url := fmt.Sprintf("/api/v1/rooms/%s/messages", room.Id().Value())
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	res = w.Result()
+
+	var page dto.MessagePage
+	json.NewDecoder(res.Body).Decode(&page)
+	res.Body.Close()
+
+	assert.Equal(t, 1, len(page.Messages))
+}
+
+func (s *RouterTestSuite) TestEditMessage_ShouldEditAMessageOfTheSender() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+
+	senderId, _ := valueobject.NewUserIdWith(userId)
+	senderName, _ := valueobject.NewUserNameWith(auth.GetNickname())
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), senderId, senderName, text)
+	s.messageRepository.Save(s.ctx, message)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	msgs := make(chan *domain_event.MessageEvent)
+	ready := make(chan struct{})
+
+	go func() {
+		err := s.messageEventGateway.Receive(ctx, room.Id().Value(), msgs, func() { close(ready) })
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(30 * time.Second):
+		t.FailNow()
+	}
+
+	editMessage := func(jwt string) *http.Response {
+		url := fmt.Sprintf("/api/v1/rooms/%s/messages/%s", room.Id().Value(), message.Id().Value())
+		body, _ := json.Marshal(map[string]string{"text": "An edited text"})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	otherJwt, _ := auth.GenerateJWT(auth.GenerateSub())
+
+	res := editMessage(otherJwt)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = editMessage(jwt)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var edited dto.MessageResponse
+	json.NewDecoder(res.Body).Decode(&edited)
+	res.Body.Close()
+
+	assert.Equal(t, message.Id().Value(), edited.Id)
+	assert.Equal(t, "An edited text", edited.Text)
+	assert.NotEmpty(t, edited.EditedAt)
+
+	select {
+	case msg := <-msgs:
+		assert.Equal(t, domain_event.MessageEdited, msg.Type)
+		assert.Equal(t, message.Id().Value(), msg.Id)
+		assert.Equal(t, "An edited text", msg.Text)
+		assert.Equal(t, edited.EditedAt, msg.EditedAt)
 	case <-time.After(30 * time.Second):
 		t.Fail()
 	}
+
+	w := httptest.NewRecorder()
+	url := fmt.Sprintf("/api/v1/rooms/%s/messages/%s/revisions", room.Id().Value(), message.Id().Value())
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var revisions []*dto.MessageRevisionResponse
+	err := json.Unmarshal(w.Body.Bytes(), &revisions)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(revisions))
+	assert.Equal(t, "A text", revisions[0].Text)
+}
+
+func (s *RouterTestSuite) TestDeleteMessage_ShouldListTheMessageAsATombstone() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	room := createARoom(adminId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	senderId, _ := valueobject.NewUserIdWith(userId)
+	senderName, _ := valueobject.NewUserNameWith(auth.GetNickname())
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	messages := []*entity.Message{
+		entity.NewMessage(room.Id(), senderId, senderName, text),
+		entity.NewMessage(room.Id(), senderId, senderName, text),
+	}
+
+	for _, message := range messages {
+		s.messageRepository.Save(s.ctx, message)
+	}
+
+	deleteMessage := func(message *entity.Message, jwt string) int {
+		url := fmt.Sprintf("/api/v1/rooms/%s/messages/%s", room.Id().Value(), message.Id().Value())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, url, nil)
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Result().StatusCode
+	}
+
+	otherJwt, _ := auth.GenerateJWT(auth.GenerateSub())
+
+	assert.Equal(t, http.StatusUnauthorized, deleteMessage(messages[0], otherJwt))
+	assert.Equal(t, http.StatusNoContent, deleteMessage(messages[0], userJwt))
+	assert.Equal(t, http.StatusNoContent, deleteMessage(messages[1], adminJwt))
+	assert.Equal(t, http.StatusNotFound, deleteMessage(messages[1], adminJwt))
+
+	url := fmt.Sprintf("/api/v1/rooms/%s/messages", room.Id().Value())
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+userJwt)
+
+	r.ServeHTTP(w, req)
+	res := w.Result()
+
+	var page dto.MessagePage
+	json.NewDecoder(res.Body).Decode(&page)
+	res.Body.Close()
+
+	assert.Equal(t, 2, len(page.Messages))
+	for _, message := range page.Messages {
+		assert.Empty(t, message.Text)
+		assert.NotEmpty(t, message.DeletedAt)
+	}
+}
+
+func (s *RouterTestSuite) TestListMessages_ShouldReturnMessagePages() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+
+	senderId, _ := valueobject.NewUserIdWith(userId)
+	senderName, _ := valueobject.NewUserNameWith(auth.GetNickname())
+
+	messages := make([]*entity.Message, 3)
+	for i := 0; i < len(messages); i++ {
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("A text %d", i))
+		messages[i] = entity.NewMessage(room.Id(), senderId, senderName, text)
+		s.messageRepository.Save(s.ctx, messages[i])
+	}
+
+	listMessages := func(query string) dto.MessagePage {
+		url := fmt.Sprintf("/api/v1/rooms/%s/messages%s", room.Id().Value(), query)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		res := w.Result()
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		assert.Nil(t, err)
+
+		var page dto.MessagePage
+		err = json.Unmarshal(body, &page)
+		assert.Nil(t, err)
+
+		return page
+	}
+
+	page := listMessages("?size=2")
+	assert.Equal(t, 2, page.Size)
+	assert.Equal(t, 2, len(page.Messages))
+	assert.Equal(t, messages[2].Id().Value(), page.Messages[0].Id)
+	assert.Equal(t, messages[2].Text().Value(), page.Messages[0].Text)
+	assert.Equal(t, messages[1].Id().Value(), page.Messages[1].Id)
+
+	page = listMessages("?size=2&cursor=" + page.Before)
+	assert.Equal(t, 1, len(page.Messages))
+	assert.Equal(t, messages[0].Id().Value(), page.Messages[0].Id)
+	assert.Empty(t, page.Before)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/rooms/"+room.Id().Value()+"/messages?direction=up", nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func (s *RouterTestSuite) TestListReplies_ShouldReturnTheRepliesOfAMessage() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+
+	senderId, _ := valueobject.NewUserIdWith(userId)
+	senderName, _ := valueobject.NewUserNameWith(auth.GetNickname())
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	parent := entity.NewMessage(room.Id(), senderId, senderName, text)
+	s.messageRepository.Save(s.ctx, parent)
+
+	replies := make([]*entity.Message, 2)
+	for i := 0; i < len(replies); i++ {
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("A reply %d", i))
+		replies[i] = entity.NewMessage(room.Id(), senderId, senderName, text)
+		replies[i].ReplyTo(parent)
+		s.messageRepository.Save(s.ctx, replies[i])
+	}
+
+	w := httptest.NewRecorder()
+	url := fmt.Sprintf("/api/v1/rooms/%s/messages/%s/replies?size=1", room.Id().Value(), parent.Id().Value())
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var page dto.MessagePage
+	err := json.Unmarshal(w.Body.Bytes(), &page)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Messages))
+	assert.Equal(t, replies[1].Id().Value(), page.Messages[0].Id)
+	assert.Equal(t, parent.Id().Value(), page.Messages[0].ParentId)
+	assert.NotEmpty(t, page.Before)
+
+	w = httptest.NewRecorder()
+	url = fmt.Sprintf("/api/v1/rooms/%s/messages/%s", room.Id().Value(), parent.Id().Value())
+	req, _ = http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var message dto.MessageResponse
+	err = json.Unmarshal(w.Body.Bytes(), &message)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, message.ReplyCount)
+	assert.Equal(t, replies[1].CreatedAt().Value(), message.LastReplyAt)
+
+	w = httptest.NewRecorder()
+	url = fmt.Sprintf("/api/v1/rooms/%s/messages/%s/replies", room.Id().Value(), valueobject.NewId().Value())
+	req, _ = http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func (s *RouterTestSuite) TestReactions_ShouldAddAndRemoveTheReactionsOfAMessage() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	senderId, _ := valueobject.NewUserIdWith(userId)
+	senderName, _ := valueobject.NewUserNameWith(auth.GetNickname())
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), senderId, senderName, text)
+	s.messageRepository.Save(s.ctx, message)
+
+	messageUrl := fmt.Sprintf("/api/v1/rooms/%s/messages/%s", room.Id().Value(), message.Id().Value())
+
+	react := func(method string, emoji string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, messageUrl+"/reactions/"+url.PathEscape(emoji), nil)
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	findMessage := func() dto.MessageResponse {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, messageUrl, nil)
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response dto.MessageResponse
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Nil(t, err)
+
+		return response
+	}
+
+	assert.Equal(t, http.StatusNoContent, react(http.MethodPut, "👍"))
+	assert.Equal(t, http.StatusNoContent, react(http.MethodPut, "👍"))
+	assert.Equal(t, http.StatusUnprocessableEntity, react(http.MethodPut, "like"))
+
+	response := findMessage()
+	assert.Equal(t, 1, len(response.Reactions))
+	assert.Equal(t, "👍", response.Reactions[0].Emoji)
+	assert.Equal(t, 1, response.Reactions[0].Count)
+
+	assert.Equal(t, http.StatusNoContent, react(http.MethodDelete, "👍"))
+	assert.Equal(t, http.StatusNoContent, react(http.MethodDelete, "👍"))
+
+	response = findMessage()
+	assert.Empty(t, response.Reactions)
+}
+
+func (s *RouterTestSuite) TestPins_ShouldPinAndUnpinTheMessagesOfARoom() {
+	defer db.Clear()
+	t := s.T()
+	r := s.router
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	senderId, _ := valueobject.NewUserIdWith(userId)
+	senderName, _ := valueobject.NewUserNameWith(auth.GetNickname())
+	text, _ := valueobject.NewMessageTextWith("An announcement")
+	message := entity.NewMessage(room.Id(), senderId, senderName, text)
+	s.messageRepository.Save(s.ctx, message)
+
+	pinsUrl := fmt.Sprintf("/api/v1/rooms/%s/pins", room.Id().Value())
+
+	pin := func(method string, messageId string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, pinsUrl+"/"+messageId, nil)
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	listPins := func() []*dto.PinResponse {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, pinsUrl, nil)
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []*dto.PinResponse
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Nil(t, err)
+
+		return response
+	}
+
+	assert.Equal(t, http.StatusNoContent, pin(http.MethodPut, message.Id().Value()))
+	assert.Equal(t, http.StatusNoContent, pin(http.MethodPut, message.Id().Value()))
+	assert.Equal(t, http.StatusNotFound, pin(http.MethodPut, valueobject.NewId().Value()))
+
+	response := listPins()
+	assert.Equal(t, 1, len(response))
+	assert.Equal(t, message.Id().Value(), response[0].Message.Id)
+	assert.Equal(t, "An announcement", response[0].Message.Text)
+	assert.Equal(t, userId, response[0].PinnedBy)
+
+	assert.Equal(t, http.StatusNoContent, pin(http.MethodDelete, message.Id().Value()))
+	assert.Equal(t, http.StatusNoContent, pin(http.MethodDelete, message.Id().Value()))
+
+	assert.Empty(t, listPins())
+}
+
+func (s *RouterTestSuite) TestWebSocket_ShouldStreamRoomMessages() {
+	defer db.Clear()
+	t := s.T()
+
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+jwt)
+
+	url := fmt.Sprintf("ws%s/api/v1/rooms/%s/ws", strings.TrimPrefix(server.URL, "http"), room.Id().Value())
+
+	conn, res, err := websocket.DefaultDialer.Dial(url, header)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+	defer conn.Close()
+
+	payload := struct {
+		Text string `json:"text"`
+	}{
+		"A text over websocket",
+	}
+
+	body, _ := json.Marshal(payload)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/rooms/"+room.Id().Value()+"/send", bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	s.router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+
+	for {
+		var msg domain_event.MessageEvent
+
+		err := conn.ReadJSON(&msg)
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		if msg.Text != payload.Text {
+			continue
+		}
+
+		assert.Equal(t, room.Id().Value(), msg.RoomId)
+		assert.Equal(t, userId, msg.SenderId)
+		return
+	}
+}
+
+func (s *RouterTestSuite) TestWebSocket_ShouldStreamTypingIndicators() {
+	defer db.Clear()
+	t := s.T()
+
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	room := createARoom(adminId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+	roomUrl := "/api/v1/rooms/" + room.Id().Value()
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	request := func(method, url, jwt string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, nil)
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		s.router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, request(http.MethodPost, roomUrl+"/join", userJwt))
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+adminJwt)
+
+	url := fmt.Sprintf("ws%s%s/ws", strings.TrimPrefix(server.URL, "http"), roomUrl)
+
+	conn, res, err := websocket.DefaultDialer.Dial(url, header)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+	defer conn.Close()
+
+	readTyping := func() *domain_event.TypingEvent {
+		conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+
+		for {
+			var typingEvent domain_event.TypingEvent
+
+			err := conn.ReadJSON(&typingEvent)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			if typingEvent.Type == domain_event.UserTyping {
+				return &typingEvent
+			}
+		}
+	}
+
+	assert.Equal(t, http.StatusNoContent, request(http.MethodPost, roomUrl+"/typing", userJwt))
+
+	typingEvent := readTyping()
+	assert.Equal(t, room.Id().Value(), typingEvent.RoomId)
+	assert.Equal(t, userId, typingEvent.UserId)
+	assert.NotEmpty(t, typingEvent.ExpiresAt)
+
+	// Repeated notifications within the throttle period are accepted but not sent.
+	assert.Equal(t, http.StatusNoContent, request(http.MethodPost, roomUrl+"/typing", userJwt))
+
+	err = conn.WriteJSON(dto.Frame{Type: dto.TypingFrame})
+	assert.Nil(t, err)
+
+	typingEvent = readTyping()
+	assert.Equal(t, adminId, typingEvent.UserId)
+
+	outsiderJwt, _ := auth.GenerateJWT(auth.GenerateSub())
+	assert.Equal(t, http.StatusUnauthorized, request(http.MethodPost, roomUrl+"/typing", outsiderJwt))
+}
+
+func (s *RouterTestSuite) TestPresence_ShouldTrackTheUsersWithALiveConnectionOrHeartbeats() {
+	defer db.Clear()
+	t := s.T()
+
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+
+	adminId := auth.GenerateSub()
+	adminJwt, _ := auth.GenerateJWT(adminId)
+
+	room := createARoom(adminId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+	roomUrl := "/api/v1/rooms/" + room.Id().Value()
+
+	userId := auth.GenerateSub()
+	userJwt, _ := auth.GenerateJWT(userId)
+
+	request := func(method, url, jwt string, body []byte) *http.Response {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+jwt)
+
+		s.router.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	res := request(http.MethodPost, roomUrl+"/join", userJwt, nil)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+adminJwt)
+
+	url := fmt.Sprintf("ws%s%s/ws", strings.TrimPrefix(server.URL, "http"), roomUrl)
+
+	conn, res, err := websocket.DefaultDialer.Dial(url, header)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+	defer conn.Close()
+
+	readPresence := func(userId string) *domain_event.PresenceEvent {
+		conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+
+		for {
+			var presenceEvent domain_event.PresenceEvent
+
+			err := conn.ReadJSON(&presenceEvent)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			if presenceEvent.Type == domain_event.PresenceChanged && presenceEvent.UserId == userId {
+				return &presenceEvent
+			}
+		}
+	}
+
+	presenceEvent := readPresence(adminId)
+	assert.Equal(t, valueobject.PresenceStatusOnline, presenceEvent.Status)
+
+	body, _ := json.Marshal(dto.PresenceRequest{Status: valueobject.PresenceStatusAway})
+	res = request(http.MethodPost, roomUrl+"/presence", userJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	presenceEvent = readPresence(userId)
+	assert.Equal(t, room.Id().Value(), presenceEvent.RoomId)
+	assert.Equal(t, valueobject.PresenceStatusAway, presenceEvent.Status)
+	assert.Empty(t, presenceEvent.SessionId)
+
+	res = request(http.MethodGet, roomUrl+"/presence", userJwt, nil)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var presences []*dto.PresenceResponse
+	json.NewDecoder(res.Body).Decode(&presences)
+	res.Body.Close()
+
+	statuses := make(map[string]string)
+	for _, p := range presences {
+		statuses[p.UserId] = p.Status
+	}
+
+	assert.Equal(t, map[string]string{
+		adminId: valueobject.PresenceStatusOnline,
+		userId:  valueobject.PresenceStatusAway,
+	}, statuses)
+
+	body, _ = json.Marshal(dto.PresenceRequest{Status: valueobject.PresenceStatusOffline})
+	res = request(http.MethodPost, roomUrl+"/presence", userJwt, body)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	presenceEvent = readPresence(userId)
+	assert.Equal(t, valueobject.PresenceStatusOffline, presenceEvent.Status)
+
+	err = conn.WriteJSON(dto.Frame{Type: dto.PresenceFrame, Status: valueobject.PresenceStatusAway})
+	assert.Nil(t, err)
+
+	presenceEvent = readPresence(adminId)
+	assert.Equal(t, valueobject.PresenceStatusAway, presenceEvent.Status)
+
+	outsiderJwt, _ := auth.GenerateJWT(auth.GenerateSub())
+	body, _ = json.Marshal(dto.PresenceRequest{Status: valueobject.PresenceStatusOnline})
+	res = request(http.MethodPost, roomUrl+"/presence", outsiderJwt, body)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func (s *RouterTestSuite) TestWebSocket_ShouldReturnNotFoundWhenRoomDoesNotExist() {
+	defer db.Clear()
+	t := s.T()
+
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+
+	jwt, _ := auth.GenerateJWT(auth.GenerateSub())
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+jwt)
+
+	url := fmt.Sprintf("ws%s/api/v1/rooms/%s/ws", strings.TrimPrefix(server.URL, "http"), valueobject.NewId().Value())
+
+	_, res, err := websocket.DefaultDialer.Dial(url, header)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func (s *RouterTestSuite) TestEvents_ShouldReplayMissedMessagesAndStreamNewOnes() {
+	defer db.Clear()
+	t := s.T()
+
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+
+	userId := auth.GenerateSub()
+	jwt, _ := auth.GenerateJWT(userId)
+
+	room := createARoom(userId, "A Game", "Game")
+	s.roomRepository.Save(s.ctx, room)
+	s.memberRepository.Save(s.ctx, entity.NewRoomOwner(room.Id(), room.AdminId()))
+
+	senderId, _ := valueobject.NewUserIdWith(userId)
+	senderName, _ := valueobject.NewUserNameWith(auth.GetNickname())
+
+	messages := make([]*entity.Message, 3)
+	for i := 0; i < len(messages); i++ {
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("A text %d", i))
+		messages[i] = entity.NewMessage(room.Id(), senderId, senderName, text)
+		s.messageRepository.Save(s.ctx, messages[i])
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, 30*time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/rooms/"+room.Id().Value()+"/events", nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Last-Event-ID", messages[0].Id().Value())
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(res.Body)
+
+	nextEvent := func() (string, domain_event.MessageEvent) {
+		var id string
+		var messageEvent domain_event.MessageEvent
+
+		for {
+			line, err := reader.ReadString('\n')
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			line = strings.TrimSuffix(line, "\n")
+
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &messageEvent)
+			case line == "" && id != "":
+				return id, messageEvent
+			}
+		}
+	}
+
+	for _, message := range messages[1:] {
+		id, messageEvent := nextEvent()
+		assert.Equal(t, message.Id().Value(), id)
+		assert.Equal(t, message.Text().Value(), messageEvent.Text)
+	}
+
+	payload := struct {
+		Text string `json:"text"`
+	}{
+		"A live text",
+	}
+
+	body, _ := json.Marshal(payload)
+
+	w := httptest.NewRecorder()
+	send, _ := http.NewRequest(http.MethodPost, "/api/v1/rooms/"+room.Id().Value()+"/send", bytes.NewReader(body))
+	send.Header.Set("Authorization", "Bearer "+jwt)
+
+	s.router.ServeHTTP(w, send)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	for {
+		id, messageEvent := nextEvent()
+		if messageEvent.Text != payload.Text {
+			continue
+		}
+
+		assert.Equal(t, messageEvent.Id, id)
+		assert.Equal(t, room.Id().Value(), messageEvent.RoomId)
+		return
+	}
 }
@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+func DirectRouter(
+	r *gin.RouterGroup,
+	directHandler handler.DirectHandler,
+) {
+	dms := r.Group("/dms")
+	{
+		dms.POST("", directHandler.OpenDirectRoom)
+		dms.GET("", directHandler.ListDirectRooms)
+	}
+}
@@ -17,6 +17,39 @@ func RoomRouter(
 		rooms.GET(":id", roomHandler.FindRoom)
 		rooms.PUT(":id", roomHandler.UpdateRoom)
 		rooms.DELETE(":id", roomHandler.DeleteRoom)
+		rooms.POST(":id/transfer", roomHandler.TransferOwnership)
+		rooms.GET(":id/transfers", roomHandler.ListOwnershipTransfers)
+		rooms.POST(":id/join", roomHandler.JoinRoom)
+		rooms.POST(":id/leave", roomHandler.LeaveRoom)
+		rooms.GET(":id/members", roomHandler.ListMembers)
+		rooms.POST(":id/members/:userId/promote", roomHandler.PromoteMember)
+		rooms.POST(":id/members/:userId/demote", roomHandler.DemoteMember)
+		rooms.DELETE(":id/members/:userId", roomHandler.KickMember)
+		rooms.POST(":id/bans", roomHandler.BanMember)
+		rooms.DELETE(":id/bans/:userId", roomHandler.UnbanMember)
+		rooms.POST(":id/mutes", roomHandler.MuteMember)
+		rooms.DELETE(":id/mutes/:userId", roomHandler.UnmuteMember)
+		rooms.POST(":id/invitations", roomHandler.CreateInvitation)
+		rooms.POST(":id/invitations/:token/accept", roomHandler.AcceptInvitation)
+		rooms.POST(":id/invitations/:token/decline", roomHandler.DeclineInvitation)
 		rooms.POST(":id/send", roomHandler.SendMessage)
+		rooms.GET(":id/messages", roomHandler.ListMessages)
+		rooms.GET(":id/messages/:messageId", roomHandler.FindMessage)
+		rooms.PUT(":id/messages/:messageId", roomHandler.EditMessage)
+		rooms.DELETE(":id/messages/:messageId", roomHandler.DeleteMessage)
+		rooms.GET(":id/messages/:messageId/readers", roomHandler.ListMessageReaders)
+		rooms.GET(":id/messages/:messageId/revisions", roomHandler.ListMessageRevisions)
+		rooms.GET(":id/messages/:messageId/replies", roomHandler.ListReplies)
+		rooms.PUT(":id/messages/:messageId/reactions/:emoji", roomHandler.AddReaction)
+		rooms.DELETE(":id/messages/:messageId/reactions/:emoji", roomHandler.RemoveReaction)
+		rooms.GET(":id/pins", roomHandler.ListPins)
+		rooms.PUT(":id/pins/:messageId", roomHandler.PinMessage)
+		rooms.DELETE(":id/pins/:messageId", roomHandler.UnpinMessage)
+		rooms.POST(":id/read", roomHandler.MarkRoomAsRead)
+		rooms.POST(":id/typing", roomHandler.SendTyping)
+		rooms.GET(":id/presence", roomHandler.ListPresence)
+		rooms.POST(":id/presence", roomHandler.UpdatePresence)
+		rooms.GET(":id/ws", roomHandler.WebSocket)
+		rooms.GET(":id/events", roomHandler.Events)
 	}
 }
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/infra/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+func UserRouter(
+	r *gin.RouterGroup,
+	userHandler handler.UserHandler,
+) {
+	me := r.Group("/me")
+	{
+		me.GET("/rooms", userHandler.ListUserRooms)
+	}
+}
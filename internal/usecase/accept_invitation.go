@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type AcceptInvitationUseCaseInput struct {
+	RoomId string
+	Token  string
+	UserId string
+}
+
+type AcceptInvitationUseCase interface {
+	Execute(ctx context.Context, input *AcceptInvitationUseCaseInput) error
+}
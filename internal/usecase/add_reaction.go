@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+)
+
+type AddReactionUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	UserId    string
+	Emoji     string
+}
+
+type AddReactionUseCase interface {
+	Execute(ctx context.Context, input *AddReactionUseCaseInput) error
+}
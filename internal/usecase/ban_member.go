@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+)
+
+type BanMemberUseCaseInput struct {
+	RoomId    string
+	UserId    string
+	MemberId  string
+	ExpiresAt string
+}
+
+type BanMemberUseCase interface {
+	Execute(ctx context.Context, input *BanMemberUseCaseInput) error
+}
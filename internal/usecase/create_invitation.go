@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+)
+
+type CreateInvitationUseCaseInput struct {
+	RoomId    string
+	InviterId string
+	InviteeId string
+}
+
+type CreateInvitationUseCaseOutput struct {
+	Id        string
+	RoomId    string
+	InviteeId string
+	Token     string
+	ExpiresAt string
+}
+
+type CreateInvitationUseCase interface {
+	Execute(ctx context.Context, input *CreateInvitationUseCaseInput) (*CreateInvitationUseCaseOutput, error)
+}
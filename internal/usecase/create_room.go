@@ -5,9 +5,10 @@ import (
 )
 
 type CreateRoomUseCaseInput struct {
-	AdminId  string
-	Name     string
-	Category string
+	AdminId    string
+	Name       string
+	Category   string
+	Visibility string
 }
 
 type CreateRoomUseCaseOutput struct {
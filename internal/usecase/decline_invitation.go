@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type DeclineInvitationUseCaseInput struct {
+	RoomId string
+	Token  string
+	UserId string
+}
+
+type DeclineInvitationUseCase interface {
+	Execute(ctx context.Context, input *DeclineInvitationUseCaseInput) error
+}
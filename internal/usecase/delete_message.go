@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type DeleteMessageUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	UserId    string
+}
+
+type DeleteMessageUseCase interface {
+	Execute(ctx context.Context, input *DeleteMessageUseCaseInput) error
+}
@@ -5,8 +5,8 @@ import (
 )
 
 type DeleteRoomUseCaseInput struct {
-	Id      string
-	AdminId string
+	Id     string
+	UserId string
 }
 
 type DeleteRoomUseCase interface {
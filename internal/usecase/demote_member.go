@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type DemoteMemberUseCaseInput struct {
+	RoomId   string
+	UserId   string
+	MemberId string
+}
+
+type DemoteMemberUseCase interface {
+	Execute(ctx context.Context, input *DemoteMemberUseCaseInput) error
+}
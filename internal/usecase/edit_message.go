@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+)
+
+type EditMessageUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	SenderId  string
+	Text      string
+}
+
+type EditMessageUseCaseOutput struct {
+	Id          string
+	RoomId      string
+	SenderId    string
+	SenderName  string
+	Text        string
+	CreatedAt   string
+	EditedAt    string
+	DeletedAt   string
+	ParentId    string
+	ReplyCount  int
+	LastReplyAt string
+	Reactions   []*MessageReactionOutput
+}
+
+type EditMessageUseCase interface {
+	Execute(ctx context.Context, input *EditMessageUseCaseInput) (*EditMessageUseCaseOutput, error)
+}
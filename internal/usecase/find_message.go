@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+)
+
+type FindMessageUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	UserId    string
+}
+
+type FindMessageUseCaseOutput struct {
+	Id          string
+	RoomId      string
+	SenderId    string
+	SenderName  string
+	Text        string
+	CreatedAt   string
+	EditedAt    string
+	DeletedAt   string
+	ParentId    string
+	ReplyCount  int
+	LastReplyAt string
+	Reactions   []*MessageReactionOutput
+}
+
+type FindMessageUseCase interface {
+	Execute(ctx context.Context, input *FindMessageUseCaseInput) (*FindMessageUseCaseOutput, error)
+}
@@ -5,16 +5,18 @@ import (
 )
 
 type FindRoomUseCaseInput struct {
-	Id string
+	Id     string
+	UserId string
 }
 
 type FindRoomUseCaseOutput struct {
-	Id        string
-	AdminId   string
-	Name      string
-	Category  string
-	CreatedAt string
-	UpdatedAt string
+	Id         string
+	AdminId    string
+	Name       string
+	Category   string
+	Visibility string
+	CreatedAt  string
+	UpdatedAt  string
 }
 
 type FindRoomUseCase interface {
@@ -0,0 +1,97 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type AcceptInvitationUseCase struct {
+	transactionManager   repository.TransactionManager
+	roomRepository       repository.RoomRepository
+	memberRepository     repository.MemberRepository
+	banRepository        repository.BanRepository
+	invitationRepository repository.InvitationRepository
+	logger               *log.Logger
+}
+
+func NewAcceptInvitationUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	invitationRepository repository.InvitationRepository,
+) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		transactionManager:   transactionManager,
+		roomRepository:       roomRepository,
+		memberRepository:     memberRepository,
+		banRepository:        banRepository,
+		invitationRepository: invitationRepository,
+		logger:               log.NewLogger("AcceptInvitationUseCase"),
+	}
+}
+
+func (u *AcceptInvitationUseCase) Execute(ctx context.Context, input *usecase.AcceptInvitationUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	token, err := valueobject.NewInvitationTokenWith(input.Token)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := findRoomInvitation(ctx, u.roomRepository, u.invitationRepository, roomId, token)
+	if err != nil {
+		if _, ok := err.(validation.NotFoundError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = checkRoomBan(ctx, u.banRepository, roomId, userId)
+	if err != nil {
+		if !errors.Is(err, entity.ErrUserBanned) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	member, err := invitation.Accept(userId)
+	if err != nil {
+		return err
+	}
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.invitationRepository.Update(ctx, invitation)
+		if err != nil {
+			return err
+		}
+
+		return u.memberRepository.Save(ctx, member)
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrAnsweredInvitation) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	return nil
+}
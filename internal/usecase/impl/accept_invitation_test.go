@@ -0,0 +1,202 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAcceptInvitationUseCase_ShouldAddTheInviteeAsMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(room.Id(), adminId, inviteeId)
+
+	ctx := context.Background()
+	input := &usecase.AcceptInvitationUseCaseInput{
+		RoomId: room.Id().Value(),
+		Token:  invitation.Token().Value(),
+		UserId: inviteeId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	invitationRepository.EXPECT().
+		FindByToken(mock.Anything, mock.Anything).
+		Run(func(c context.Context, token *valueobject.InvitationToken) {
+			assert.Equal(t, input.Token, token.Value())
+		}).
+		Return(invitation, nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	invitationRepository.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *entity.Invitation) {
+			assert.Equal(t, entity.InvitationAccepted, i.Status())
+		}).
+		Return(nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, input.RoomId, m.RoomId().Value())
+			assert.Equal(t, input.UserId, m.UserId().Value())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewAcceptInvitationUseCase(transactionManager, roomRepository, memberRepository, banRepository, invitationRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestAcceptInvitationUseCase_ShouldReturnAnErrorWhenTheUserIsNotTheInvitee(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(room.Id(), adminId, inviteeId)
+
+	ctx := context.Background()
+	input := &usecase.AcceptInvitationUseCaseInput{
+		RoomId: room.Id().Value(),
+		Token:  invitation.Token().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533d",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	invitationRepository.EXPECT().
+		FindByToken(mock.Anything, mock.Anything).
+		Return(invitation, nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	useCase := NewAcceptInvitationUseCase(transactionManager, roomRepository, memberRepository, banRepository, invitationRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrInvalidInvitationInvitee)
+}
+
+func TestAcceptInvitationUseCase_ShouldReturnAnErrorWhenTheInvitationIsOfAnotherRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(valueobject.NewId(), adminId, inviteeId)
+
+	ctx := context.Background()
+	input := &usecase.AcceptInvitationUseCaseInput{
+		RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+		Token:  invitation.Token().Value(),
+		UserId: inviteeId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	invitationRepository.EXPECT().
+		FindByToken(mock.Anything, mock.Anything).
+		Return(invitation, nil).
+		Once()
+
+	useCase := NewAcceptInvitationUseCase(transactionManager, roomRepository, memberRepository, banRepository, invitationRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundInvitation)
+}
+
+func TestAcceptInvitationUseCase_ShouldReturnAnErrorWhenTheInviteeIsBanned(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(room.Id(), adminId, inviteeId)
+	ban, _ := entity.NewBan(room.Id(), inviteeId, adminId, nil)
+
+	ctx := context.Background()
+	input := &usecase.AcceptInvitationUseCaseInput{
+		RoomId: room.Id().Value(),
+		Token:  invitation.Token().Value(),
+		UserId: inviteeId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	invitationRepository.EXPECT().
+		FindByToken(mock.Anything, mock.Anything).
+		Return(invitation, nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(ban, nil).
+		Once()
+
+	useCase := NewAcceptInvitationUseCase(transactionManager, roomRepository, memberRepository, banRepository, invitationRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrUserBanned)
+	assert.Equal(t, entity.InvitationPending, invitation.Status())
+}
@@ -0,0 +1,115 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type AddReactionUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	muteRepository      repository.MuteRepository
+	messageRepository   repository.MessageRepository
+	reactionRepository  repository.ReactionRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewAddReactionUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	muteRepository repository.MuteRepository,
+	messageRepository repository.MessageRepository,
+	reactionRepository repository.ReactionRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *AddReactionUseCase {
+	return &AddReactionUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		muteRepository:      muteRepository,
+		messageRepository:   messageRepository,
+		reactionRepository:  reactionRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("AddReactionUseCase"),
+	}
+}
+
+// Execute adds the reaction of the user to the message. Adding a reaction
+// again does nothing.
+func (u *AddReactionUseCase) Execute(ctx context.Context, input *usecase.AddReactionUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	emoji, err := valueobject.NewEmojiWith(input.Emoji)
+	if err != nil {
+		return err
+	}
+
+	message, err := findMemberMessage(ctx, u.roomRepository, u.memberRepository, u.messageRepository, roomId, messageId, userId)
+	if err != nil {
+		if _, ok := err.(validation.NotFoundError); ok {
+			return err
+		}
+
+		if errors.Is(err, entity.ErrNotRoomMember) || errors.Is(err, entity.ErrMessageAlreadyDeleted) {
+			return err
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	err = checkRoomMute(ctx, u.muteRepository, roomId, userId)
+	if err != nil {
+		if !errors.Is(err, entity.ErrUserMuted) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	reaction := entity.NewReaction(messageId, userId, emoji)
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.reactionRepository.Save(ctx, reaction)
+		if err != nil {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewReactionAddedEvent(message, reaction))
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicateReaction) {
+			return nil
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
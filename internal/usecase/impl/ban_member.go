@@ -0,0 +1,132 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type BanMemberUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	banRepository       repository.BanRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewBanMemberUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *BanMemberUseCase {
+	return &BanMemberUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		banRepository:       banRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("BanMemberUseCase"),
+	}
+}
+
+func (u *BanMemberUseCase) Execute(ctx context.Context, input *usecase.BanMemberUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	memberId, err := valueobject.NewUserIdWith(input.MemberId)
+	if err != nil {
+		return err
+	}
+
+	var expiresAt *valueobject.Timestamp
+
+	if input.ExpiresAt != "" {
+		expiresAt, err = valueobject.NewTimestampWith(input.ExpiresAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	moderator, err := checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionBanMember)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	// Users that are not members can be banned before joining the room.
+	member, err := u.memberRepository.FindByRoomAndUser(ctx, room.Id(), memberId)
+	if err != nil && !errors.Is(err, repository.ErrNotFoundMember) {
+		u.logger.Error(err)
+		return err
+	}
+
+	if member != nil {
+		err = moderator.ValidateModeration(member)
+		if err != nil {
+			return err
+		}
+	}
+
+	ban, err := entity.NewBan(room.Id(), memberId, userId, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.banRepository.Save(ctx, ban)
+		if err != nil {
+			return err
+		}
+
+		if member != nil {
+			err = u.memberRepository.Delete(ctx, member)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The event also closes the streams of public rooms opened by users
+		// that are not members.
+		return u.messageEventGateway.Send(ctx, event.NewMemberBannedEvent(ban))
+	})
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
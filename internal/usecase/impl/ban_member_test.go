@@ -0,0 +1,244 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBanMemberUseCase_ShouldBanAndRemoveTheMemberWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	member := entity.NewMember(room.Id(), memberId)
+	expiresAt := valueobject.NewTimestamp().Add(time.Hour)
+
+	ctx := context.Background()
+	input := &usecase.BanMemberUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    adminId.Value(),
+		MemberId:  memberId.Value(),
+		ExpiresAt: expiresAt.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(member, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	banRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, b *entity.Ban) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, room.Id().Value(), b.RoomId().Value())
+			assert.Equal(t, memberId.Value(), b.UserId().Value())
+			assert.Equal(t, adminId.Value(), b.BannedBy().Value())
+			assert.Equal(t, expiresAt.Value(), b.ExpiresAt().Value())
+		}).
+		Return(nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Delete(mock.Anything, member).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, event.MemberBanned, e.Type)
+			assert.Equal(t, input.RoomId, e.RoomId)
+			assert.Equal(t, input.UserId, e.SenderId)
+			assert.Equal(t, input.MemberId, e.MemberId)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewBanMemberUseCase(transactionManager, roomRepository, memberRepository, banRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestBanMemberUseCase_ShouldBanAUserThatIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.BanMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   adminId.Value(),
+		MemberId: userId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, userId).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	banRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, b *entity.Ban) {
+			assert.Nil(t, b.ExpiresAt())
+		}).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, event.MemberBanned, e.Type)
+			assert.Equal(t, input.MemberId, e.MemberId)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewBanMemberUseCase(transactionManager, roomRepository, memberRepository, banRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestBanMemberUseCase_ShouldReturnAnErrorWhenTheMemberHasAnEqualOrHigherRole(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	moderatorId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	moderatorRole, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+	moderator := entity.NewMemberWith(room.Id(), moderatorId, moderatorRole, valueobject.NewTimestamp(), nil)
+
+	ctx := context.Background()
+	input := &usecase.BanMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   moderatorId.Value(),
+		MemberId: adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, moderatorId).
+		Return(moderator, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	useCase := NewBanMemberUseCase(transactionManager, roomRepository, memberRepository, banRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrCannotModerateMember)
+}
+
+func TestBanMemberUseCase_ShouldReturnAnErrorWhenTheUserCannotBanMembers(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.BanMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   userId.Value(),
+		MemberId: "auth0|64c8457bb160e37c8c34533d",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), userId), nil).
+		Once()
+
+	useCase := NewBanMemberUseCase(transactionManager, roomRepository, memberRepository, banRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrMissingPermission)
+}
@@ -0,0 +1,108 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type CreateInvitationUseCase struct {
+	roomRepository       repository.RoomRepository
+	memberRepository     repository.MemberRepository
+	invitationRepository repository.InvitationRepository
+	logger               *log.Logger
+}
+
+func NewCreateInvitationUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	invitationRepository repository.InvitationRepository,
+) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{
+		roomRepository:       roomRepository,
+		memberRepository:     memberRepository,
+		invitationRepository: invitationRepository,
+		logger:               log.NewLogger("CreateInvitationUseCase"),
+	}
+}
+
+func (u *CreateInvitationUseCase) Execute(
+	ctx context.Context,
+	input *usecase.CreateInvitationUseCaseInput,
+) (*usecase.CreateInvitationUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	inviterId, err := valueobject.NewUserIdWith(input.InviterId)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteeId, err := valueobject.NewUserIdWith(input.InviteeId)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	if room.IsDirect() {
+		return nil, entity.ErrDirectRoomInvitation
+	}
+
+	_, err = checkMemberPermission(ctx, u.memberRepository, room, inviterId, entity.PermissionInviteMember)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	_, err = u.memberRepository.FindByRoomAndUser(ctx, roomId, inviteeId)
+	if err == nil {
+		return nil, entity.ErrAlreadyRoomMember
+	}
+
+	if !errors.Is(err, repository.ErrNotFoundMember) {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	invitation := entity.NewInvitation(roomId, inviterId, inviteeId)
+
+	err = u.invitationRepository.Save(ctx, invitation)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	output := &usecase.CreateInvitationUseCaseOutput{
+		Id:        invitation.Id().Value(),
+		RoomId:    invitation.RoomId().Value(),
+		InviteeId: invitation.InviteeId().Value(),
+		Token:     invitation.Token().Value(),
+		ExpiresAt: invitation.ExpiresAt().Value(),
+	}
+
+	return output, nil
+}
@@ -0,0 +1,178 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateInvitationUseCase_ShouldCreateAnInvitationWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	invitationCreated := &entity.Invitation{}
+
+	ctx := context.Background()
+	input := &usecase.CreateInvitationUseCaseInput{
+		RoomId:    room.Id().Value(),
+		InviterId: adminId.Value(),
+		InviteeId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	invitationRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *entity.Invitation) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.RoomId, i.RoomId().Value())
+			assert.Equal(t, input.InviterId, i.InviterId().Value())
+			assert.Equal(t, input.InviteeId, i.InviteeId().Value())
+			assert.Equal(t, entity.InvitationPending, i.Status())
+			invitationCreated = i
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewCreateInvitationUseCase(roomRepository, memberRepository, invitationRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, invitationCreated.Id().Value(), output.Id)
+	assert.Equal(t, input.RoomId, output.RoomId)
+	assert.Equal(t, input.InviteeId, output.InviteeId)
+	assert.Equal(t, invitationCreated.Token().Value(), output.Token)
+	assert.Equal(t, invitationCreated.ExpiresAt().Value(), output.ExpiresAt)
+}
+
+func TestCreateInvitationUseCase_ShouldReturnAnErrorWhenTheInviterCannotInviteMembers(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	inviterId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.CreateInvitationUseCaseInput{
+		RoomId:    room.Id().Value(),
+		InviterId: inviterId.Value(),
+		InviteeId: "auth0|64c8457bb160e37c8c34533d",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), inviterId), nil).
+		Once()
+
+	useCase := NewCreateInvitationUseCase(roomRepository, memberRepository, invitationRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrMissingPermission)
+}
+
+func TestCreateInvitationUseCase_ShouldReturnAnErrorWhenTheRoomIsDirect(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	otherUserId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	directRoom, _ := entity.NewDirectRoom(userId, otherUserId)
+
+	ctx := context.Background()
+	input := &usecase.CreateInvitationUseCaseInput{
+		RoomId:    directRoom.Room().Id().Value(),
+		InviterId: userId.Value(),
+		InviteeId: "auth0|64c8457bb160e37c8c34533d",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(directRoom.Room(), nil).
+		Once()
+
+	useCase := NewCreateInvitationUseCase(roomRepository, memberRepository, invitationRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrDirectRoomInvitation)
+}
+
+func TestCreateInvitationUseCase_ShouldReturnAnErrorWhenTheInviteeIsAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.CreateInvitationUseCaseInput{
+		RoomId:    room.Id().Value(),
+		InviterId: adminId.Value(),
+		InviteeId: inviteeId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), inviteeId), nil).
+		Once()
+
+	useCase := NewCreateInvitationUseCase(roomRepository, memberRepository, invitationRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrAlreadyRoomMember)
+}
@@ -11,14 +11,22 @@ import (
 )
 
 type CreateRoomUseCase struct {
-	roomRepository repository.RoomRepository
-	logger         *log.Logger
+	transactionManager repository.TransactionManager
+	roomRepository     repository.RoomRepository
+	memberRepository   repository.MemberRepository
+	logger             *log.Logger
 }
 
-func NewCreateRoomUseCase(roomRepository repository.RoomRepository) *CreateRoomUseCase {
+func NewCreateRoomUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+) *CreateRoomUseCase {
 	return &CreateRoomUseCase{
-		roomRepository: roomRepository,
-		logger:         log.NewLogger("CreateRoomUseCase"),
+		transactionManager: transactionManager,
+		roomRepository:     roomRepository,
+		memberRepository:   memberRepository,
+		logger:             log.NewLogger("CreateRoomUseCase"),
 	}
 }
 
@@ -44,7 +52,29 @@ func (u *CreateRoomUseCase) Execute(
 
 	room := entity.NewRoom(adminId, name, category)
 
-	err = u.roomRepository.Save(ctx, room)
+	if input.Visibility != "" {
+		visibility, err := valueobject.NewRoomVisibilityWith(input.Visibility)
+		if err != nil {
+			return nil, err
+		}
+
+		// Direct rooms are created between two users only.
+		if visibility.IsDirect() {
+			return nil, entity.ErrDirectRoomVisibility
+		}
+
+		room.UpdateVisibility(visibility)
+	}
+
+	// The admin is the owner and first member of the room.
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.roomRepository.Save(ctx, room)
+		if err != nil {
+			return err
+		}
+
+		return u.memberRepository.Save(ctx, entity.NewRoomOwner(room.Id(), adminId))
+	})
 	if err != nil {
 		u.logger.Error(err)
 		return nil, err
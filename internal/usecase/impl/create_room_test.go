@@ -19,12 +19,22 @@ func TestCreateRoomUseCase_ShouldCreateARoomWhenDataIsValid(t *testing.T) {
 
 	ctx := context.Background()
 	input := &usecase.CreateRoomUseCaseInput{
-		AdminId:  "auth0|64c8457bb160e37c8c34533b",
-		Name:     "A Game",
-		Category: "Game",
+		AdminId:    "auth0|64c8457bb160e37c8c34533b",
+		Name:       "A Game",
+		Category:   "Game",
+		Visibility: "private",
 	}
 
+	transactionManager := mocks.NewTransactionManagerMock(t)
 	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
 
 	roomRepository.
 		EXPECT().
@@ -34,12 +44,24 @@ func TestCreateRoomUseCase_ShouldCreateARoomWhenDataIsValid(t *testing.T) {
 			assert.Equal(t, input.AdminId, r.AdminId().Value())
 			assert.Equal(t, input.Name, r.Name().Value())
 			assert.Equal(t, input.Category, r.Category().Value())
+			assert.Equal(t, input.Visibility, r.Visibility().Value())
 			roomCreated = r
 		}).
 		Return(nil).
 		Once()
 
-	useCase := NewCreateRoomUseCase(roomRepository)
+	memberRepository.
+		EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, roomCreated.Id().Value(), m.RoomId().Value())
+			assert.Equal(t, input.AdminId, m.UserId().Value())
+			assert.True(t, m.IsOwner())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewCreateRoomUseCase(transactionManager, roomRepository, memberRepository)
 
 	output, err := useCase.Execute(ctx, input)
 	assert.NotNil(t, output)
@@ -82,10 +104,32 @@ func TestCreateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 			},
 			valueobject.ErrRequiredRoomCategory,
 		},
+		{
+			"invalid visibility",
+			&usecase.CreateRoomUseCaseInput{
+				AdminId:    "auth0|64c8457bb160e37c8c34533b",
+				Name:       "A Game",
+				Category:   "Game",
+				Visibility: "hidden",
+			},
+			valueobject.ErrInvalidRoomVisibility,
+		},
+		{
+			"direct visibility",
+			&usecase.CreateRoomUseCaseInput{
+				AdminId:    "auth0|64c8457bb160e37c8c34533b",
+				Name:       "A Game",
+				Category:   "Game",
+				Visibility: "direct",
+			},
+			entity.ErrDirectRoomVisibility,
+		},
 	}
 
+	transactionManager := mocks.NewTransactionManagerMock(t)
 	roomRepository := mocks.NewRoomRepositoryMock(t)
-	useCase := NewCreateRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	useCase := NewCreateRoomUseCase(transactionManager, roomRepository, memberRepository)
 
 	for _, tc := range testCases {
 		t.Run(tc.test, func(t *testing.T) {
@@ -105,7 +149,16 @@ func TestCreateRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 		Category: "Game",
 	}
 
+	transactionManager := mocks.NewTransactionManagerMock(t)
 	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
 
 	roomRepository.
 		EXPECT().
@@ -113,7 +166,7 @@ func TestCreateRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 		Return(errors.New("a repository error")).
 		Once()
 
-	useCase := NewCreateRoomUseCase(roomRepository)
+	useCase := NewCreateRoomUseCase(transactionManager, roomRepository, memberRepository)
 
 	output, err := useCase.Execute(ctx, input)
 	assert.Nil(t, output)
@@ -0,0 +1,71 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type DeclineInvitationUseCase struct {
+	roomRepository       repository.RoomRepository
+	invitationRepository repository.InvitationRepository
+	logger               *log.Logger
+}
+
+func NewDeclineInvitationUseCase(
+	roomRepository repository.RoomRepository,
+	invitationRepository repository.InvitationRepository,
+) *DeclineInvitationUseCase {
+	return &DeclineInvitationUseCase{
+		roomRepository:       roomRepository,
+		invitationRepository: invitationRepository,
+		logger:               log.NewLogger("DeclineInvitationUseCase"),
+	}
+}
+
+func (u *DeclineInvitationUseCase) Execute(ctx context.Context, input *usecase.DeclineInvitationUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	token, err := valueobject.NewInvitationTokenWith(input.Token)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := findRoomInvitation(ctx, u.roomRepository, u.invitationRepository, roomId, token)
+	if err != nil {
+		if _, ok := err.(validation.NotFoundError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = invitation.Decline(userId)
+	if err != nil {
+		return err
+	}
+
+	err = u.invitationRepository.Update(ctx, invitation)
+	if err != nil {
+		if !errors.Is(err, repository.ErrAnsweredInvitation) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	return nil
+}
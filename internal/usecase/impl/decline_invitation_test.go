@@ -0,0 +1,121 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeclineInvitationUseCase_ShouldDeclineTheInvitation(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(room.Id(), adminId, inviteeId)
+
+	ctx := context.Background()
+	input := &usecase.DeclineInvitationUseCaseInput{
+		RoomId: room.Id().Value(),
+		Token:  invitation.Token().Value(),
+		UserId: inviteeId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	invitationRepository.EXPECT().
+		FindByToken(mock.Anything, mock.Anything).
+		Return(invitation, nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	invitationRepository.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *entity.Invitation) {
+			assert.Equal(t, entity.InvitationDeclined, i.Status())
+			assert.NotNil(t, i.AnsweredAt())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewDeclineInvitationUseCase(roomRepository, invitationRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestDeclineInvitationUseCase_ShouldReturnAnErrorWhenTheInvitationWasAnsweredConcurrently(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	inviteeId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	invitation := entity.NewInvitation(room.Id(), adminId, inviteeId)
+
+	ctx := context.Background()
+	input := &usecase.DeclineInvitationUseCaseInput{
+		RoomId: room.Id().Value(),
+		Token:  invitation.Token().Value(),
+		UserId: inviteeId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	invitationRepository.EXPECT().
+		FindByToken(mock.Anything, mock.Anything).
+		Return(invitation, nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	invitationRepository.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(repository.ErrAnsweredInvitation).
+		Once()
+
+	useCase := NewDeclineInvitationUseCase(roomRepository, invitationRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrAnsweredInvitation)
+}
+
+func TestDeclineInvitationUseCase_ShouldReturnAnErrorWhenTheInvitationIsNotFound(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.DeclineInvitationUseCaseInput{
+		RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+		Token:  valueobject.NewInvitationToken().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	invitationRepository := mocks.NewInvitationRepositoryMock(t)
+
+	invitationRepository.EXPECT().
+		FindByToken(mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundInvitation).
+		Once()
+
+	useCase := NewDeclineInvitationUseCase(roomRepository, invitationRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundInvitation)
+}
@@ -0,0 +1,148 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type DeleteMessageUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	messageRepository   repository.MessageRepository
+	pinRepository       repository.PinRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewDeleteMessageUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	messageRepository repository.MessageRepository,
+	pinRepository repository.PinRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *DeleteMessageUseCase {
+	return &DeleteMessageUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		messageRepository:   messageRepository,
+		pinRepository:       pinRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("DeleteMessageUseCase"),
+	}
+}
+
+// Execute deletes the message and unpins it, so a deleted message does not
+// take a pin of the room.
+func (u *DeleteMessageUseCase) Execute(ctx context.Context, input *usecase.DeleteMessageUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	message, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if message.RoomId().Value() != room.Id().Value() {
+		return repository.ErrNotFoundMessage
+	}
+
+	if message.IsDeleted() {
+		return repository.ErrNotFoundMessage
+	}
+
+	// The sender or a member allowed to delete any message can delete a message.
+	if err := message.ValidateSender(userId); err != nil {
+		_, err = checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionDeleteAnyMessage)
+		if err != nil {
+			if _, ok := err.(validation.UnauthorizedError); !ok {
+				u.logger.Error(err)
+			}
+
+			return err
+		}
+	}
+
+	err = message.Delete()
+	if err != nil {
+		return err
+	}
+
+	pin := entity.NewPin(roomId, messageId, userId)
+
+	// The room is locked as when pinning, so a message is not pinned while it
+	// is deleted.
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.roomRepository.Lock(ctx, roomId)
+		if err != nil {
+			return err
+		}
+
+		err = u.messageRepository.Update(ctx, message)
+		if err != nil {
+			return err
+		}
+
+		err = u.messageEventGateway.Send(ctx, event.NewMessageDeletedEvent(message))
+		if err != nil {
+			return err
+		}
+
+		err = u.pinRepository.Delete(ctx, pin)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFoundPin) {
+				return nil
+			}
+
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewMessageUnpinnedEvent(pin))
+	})
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteMessageUseCase_ShouldDeleteAMessageWhenUserIsTheSenderOrCanDeleteAnyMessage(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	moderatorId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+	moderatorRole, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+
+	testCases := []struct {
+		test   string
+		userId *valueobject.UserId
+		role   *valueobject.MemberRole
+	}{
+		{"sender", senderId, nil},
+		{"room owner", adminId, nil},
+		{"room moderator", moderatorId, moderatorRole},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			name, _ := valueobject.NewRoomNameWith("A Game")
+			category, _ := valueobject.NewRoomCategoryWith("Game")
+			roomSaved := entity.NewRoom(adminId, name, category)
+
+			senderName, _ := valueobject.NewUserNameWith("An username")
+			text, _ := valueobject.NewMessageTextWith("A text")
+			messageSaved := entity.NewMessage(roomSaved.Id(), senderId, senderName, text)
+
+			ctx := context.Background()
+			input := &usecase.DeleteMessageUseCaseInput{
+				RoomId:    roomSaved.Id().Value(),
+				MessageId: messageSaved.Id().Value(),
+				UserId:    tc.userId.Value(),
+			}
+
+			transactionManager := mocks.NewTransactionManagerMock(t)
+			roomRepository := mocks.NewRoomRepositoryMock(t)
+			memberRepository := mocks.NewMemberRepositoryMock(t)
+			messageRepository := mocks.NewMessageRepositoryMock(t)
+			pinRepository := mocks.NewPinRepositoryMock(t)
+			messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+			roomRepository.EXPECT().
+				FindById(mock.Anything, mock.Anything).
+				Return(roomSaved, nil).
+				Once()
+
+			messageRepository.EXPECT().
+				FindById(mock.Anything, mock.Anything).
+				Return(messageSaved, nil).
+				Once()
+
+			if tc.userId != senderId {
+				member := entity.NewRoomOwner(roomSaved.Id(), tc.userId)
+				if tc.role != nil {
+					member = entity.NewMemberWith(roomSaved.Id(), tc.userId, tc.role, valueobject.NewTimestamp(), nil)
+				}
+
+				memberRepository.EXPECT().
+					FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+					Return(member, nil).
+					Once()
+			}
+
+			transactionManager.EXPECT().
+				Execute(mock.Anything, mock.Anything).
+				RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				}).
+				Once()
+
+			roomRepository.EXPECT().
+				Lock(mock.Anything, mock.Anything).
+				Return(nil).
+				Once()
+
+			messageRepository.EXPECT().
+				Update(mock.Anything, mock.Anything).
+				Run(func(c context.Context, m *entity.Message) {
+					assert.True(t, m.IsDeleted())
+				}).
+				Return(nil).
+				Once()
+
+			messageEventGateway.EXPECT().
+				Send(mock.Anything, mock.Anything).
+				Run(func(c context.Context, e *event.MessageEvent) {
+					assert.Equal(t, event.MessageDeleted, e.Type)
+					assert.Equal(t, input.MessageId, e.Id)
+					assert.Empty(t, e.Text)
+					assert.NotEmpty(t, e.DeletedAt)
+				}).
+				Return(nil).
+				Once()
+
+			pinRepository.EXPECT().
+				Delete(mock.Anything, mock.Anything).
+				Return(repository.ErrNotFoundPin).
+				Once()
+
+			useCase := NewDeleteMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+			err := useCase.Execute(ctx, input)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestDeleteMessageUseCase_ShouldUnpinADeletedMessage(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("An announcement")
+	messageSaved := entity.NewMessage(roomSaved.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.DeleteMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(messageSaved, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	messageRepository.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	pinRepository.EXPECT().
+		Delete(mock.Anything, mock.Anything).
+		Run(func(c context.Context, p *entity.Pin) {
+			assert.Equal(t, input.RoomId, p.RoomId().Value())
+			assert.Equal(t, input.MessageId, p.MessageId().Value())
+		}).
+		Return(nil).
+		Once()
+
+	events := make([]string, 0)
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			events = append(events, e.Type)
+		}).
+		Return(nil).
+		Times(2)
+
+	useCase := NewDeleteMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{event.MessageDeleted, event.MessageUnpinned}, events)
+}
+
+func TestDeleteMessageUseCase_ShouldReturnAnErrorWhenUserIsNotTheSenderNorCanDeleteAnyMessage(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), senderId, senderName, text)
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+
+	ctx := context.Background()
+	input := &usecase.DeleteMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		UserId:    userId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(messageSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(roomSaved.Id(), userId), nil).
+		Once()
+
+	useCase := NewDeleteMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrMissingPermission)
+	assert.False(t, messageSaved.IsDeleted())
+}
+
+func TestDeleteMessageUseCase_ShouldReturnAnErrorWhenTheMessageIsAlreadyDeleted(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), adminId, senderName, text)
+	messageSaved.Delete()
+
+	ctx := context.Background()
+	input := &usecase.DeleteMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(messageSaved, nil).
+		Once()
+
+	useCase := NewDeleteMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
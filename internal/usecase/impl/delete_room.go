@@ -4,21 +4,28 @@ import (
 	"context"
 	"errors"
 
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
 	"github.com/sesaquecruz/go-chat-api/pkg/log"
 )
 
 type DeleteRoomUseCase struct {
-	roomRepository repository.RoomRepository
-	logger         *log.Logger
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	logger           *log.Logger
 }
 
-func NewDeleteRoomUseCase(roomRepository repository.RoomRepository) *DeleteRoomUseCase {
+func NewDeleteRoomUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+) *DeleteRoomUseCase {
 	return &DeleteRoomUseCase{
-		roomRepository: roomRepository,
-		logger:         log.NewLogger("DeleteRoomUseCase"),
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		logger:           log.NewLogger("DeleteRoomUseCase"),
 	}
 }
 
@@ -28,7 +35,7 @@ func (u *DeleteRoomUseCase) Execute(ctx context.Context, input *usecase.DeleteRo
 		return err
 	}
 
-	adminId, err := valueobject.NewUserIdWith(input.AdminId)
+	userId, err := valueobject.NewUserIdWith(input.UserId)
 	if err != nil {
 		return err
 	}
@@ -46,8 +53,12 @@ func (u *DeleteRoomUseCase) Execute(ctx context.Context, input *usecase.DeleteRo
 		return repository.ErrNotFoundRoom
 	}
 
-	err = room.ValidateAdmin(adminId)
+	_, err = checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionDeleteRoom)
 	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
 		return err
 	}
 
@@ -22,8 +22,8 @@ func TestDeleteRoomUseCase_ShouldDeleteARoomWhenDataIsValid(t *testing.T) {
 
 	ctx := context.Background()
 	input := &usecase.DeleteRoomUseCaseInput{
-		Id:      savedRoom.Id().Value(),
-		AdminId: savedRoom.AdminId().Value(),
+		Id:     savedRoom.Id().Value(),
+		UserId: savedRoom.AdminId().Value(),
 	}
 
 	roomRepository := mocks.NewRoomRepositoryMock(t)
@@ -48,7 +48,20 @@ func TestDeleteRoomUseCase_ShouldDeleteARoomWhenDataIsValid(t *testing.T) {
 		Return(nil).
 		Once()
 
-	useCase := NewDeleteRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	memberRepository.
+		EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.Id, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(entity.NewRoomOwner(savedRoom.Id(), savedRoom.AdminId()), nil).
+		Once()
+
+	useCase := NewDeleteRoomUseCase(roomRepository, memberRepository)
 
 	err := useCase.Execute(ctx, input)
 	assert.Nil(t, err)
@@ -59,6 +72,7 @@ func TestDeleteRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 	name, _ := valueobject.NewRoomNameWith("A Game")
 	category, _ := valueobject.NewRoomCategoryWith("Game")
 	savedRoom := entity.NewRoom(adminId, name, category)
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
 
 	ctx := context.Background()
 
@@ -70,42 +84,42 @@ func TestDeleteRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		{
 			"empty id",
 			&usecase.DeleteRoomUseCaseInput{
-				Id:      "",
-				AdminId: "auth0|64c8457bb160e37c8c34533b",
+				Id:     "",
+				UserId: "auth0|64c8457bb160e37c8c34533b",
 			},
 			valueobject.ErrRequiredId,
 		},
 		{
-			"empty admin id",
+			"empty user id",
 			&usecase.DeleteRoomUseCaseInput{
-				Id:      "b3588483-4795-434a-877c-dcd158d6caa7",
-				AdminId: "",
+				Id:     "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId: "",
 			},
 			valueobject.ErrRequiredUserId,
 		},
 		{
 			"invalid id",
 			&usecase.DeleteRoomUseCaseInput{
-				Id:      "dfaioewurqredfa",
-				AdminId: "auth0|64c8457bb160e37c8c34533b",
+				Id:     "dfaioewurqredfa",
+				UserId: "auth0|64c8457bb160e37c8c34533b",
 			},
 			valueobject.ErrInvalidId,
 		},
 		{
-			"invalid admin id",
+			"invalid user id",
 			&usecase.DeleteRoomUseCaseInput{
-				Id:      "b3588483-4795-434a-877c-dcd158d6caa7",
-				AdminId: "fdafiuero3c8c34533b",
+				Id:     "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId: "fdafiuero3c8c34533b",
 			},
 			valueobject.ErrInvalidUserId,
 		},
 		{
-			"invalid room admin",
+			"member without permission",
 			&usecase.DeleteRoomUseCaseInput{
-				Id:      savedRoom.Id().Value(),
-				AdminId: "auth0|64c8457bb160e37c8c34533c",
+				Id:     savedRoom.Id().Value(),
+				UserId: "auth0|64c8457bb160e37c8c34533c",
 			},
-			entity.ErrInvalidRoomAdmin,
+			entity.ErrMissingPermission,
 		},
 	}
 
@@ -121,7 +135,15 @@ func TestDeleteRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		Return(savedRoom, nil).
 		Once()
 
-	useCase := NewDeleteRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	memberRepository.
+		EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(savedRoom.Id(), userId), nil).
+		Once()
+
+	useCase := NewDeleteRoomUseCase(roomRepository, memberRepository)
 
 	for _, tc := range testCases {
 		t.Run(tc.test, func(t *testing.T) {
@@ -135,8 +157,8 @@ func TestDeleteRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 func TestDeleteRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 	ctx := context.Background()
 	input := &usecase.DeleteRoomUseCaseInput{
-		Id:      "b3588483-4795-434a-877c-dcd158d6caa7",
-		AdminId: "auth0|64c8457bb160e37c8c34533b",
+		Id:     "b3588483-4795-434a-877c-dcd158d6caa7",
+		UserId: "auth0|64c8457bb160e37c8c34533b",
 	}
 
 	roomRepository := mocks.NewRoomRepositoryMock(t)
@@ -147,7 +169,9 @@ func TestDeleteRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 		Return(nil, repository.ErrNotFoundRoom).
 		Once()
 
-	useCase := NewDeleteRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	useCase := NewDeleteRoomUseCase(roomRepository, memberRepository)
 
 	err := useCase.Execute(ctx, input)
 	assert.NotNil(t, err)
@@ -0,0 +1,91 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type DemoteMemberUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	logger           *log.Logger
+}
+
+func NewDemoteMemberUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+) *DemoteMemberUseCase {
+	return &DemoteMemberUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		logger:           log.NewLogger("DemoteMemberUseCase"),
+	}
+}
+
+func (u *DemoteMemberUseCase) Execute(ctx context.Context, input *usecase.DemoteMemberUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	memberId, err := valueobject.NewUserIdWith(input.MemberId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	_, err = checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionManageRoles)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	member, err := u.memberRepository.FindByRoomAndUser(ctx, room.Id(), memberId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMember) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = member.Demote()
+	if err != nil {
+		return err
+	}
+
+	err = u.memberRepository.Update(ctx, member)
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDemoteMemberUseCase_ShouldDemoteTheModeratorWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	moderatorRole, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+	member := entity.NewMemberWith(room.Id(), memberId, moderatorRole, valueobject.NewTimestamp(), nil)
+
+	ctx := context.Background()
+	input := &usecase.DemoteMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   adminId.Value(),
+		MemberId: memberId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(member, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Update(mock.Anything, member).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, valueobject.MemberRoleMember, m.Role().Value())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewDemoteMemberUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestDemoteMemberUseCase_ShouldReturnAnErrorWhenTheModeratorCannotManageRoles(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	moderatorRole, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+
+	ctx := context.Background()
+	input := &usecase.DemoteMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   userId.Value(),
+		MemberId: "auth0|64c8457bb160e37c8c34533d",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMemberWith(room.Id(), userId, moderatorRole, valueobject.NewTimestamp(), nil), nil).
+		Once()
+
+	useCase := NewDemoteMemberUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrMissingPermission)
+}
+
+func TestDemoteMemberUseCase_ShouldReturnAnErrorWhenTheMemberIsNotAModerator(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.DemoteMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   adminId.Value(),
+		MemberId: memberId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(entity.NewMember(room.Id(), memberId), nil).
+		Once()
+
+	useCase := NewDemoteMemberUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrInvalidMemberDemotion)
+}
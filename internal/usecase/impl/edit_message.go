@@ -0,0 +1,163 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type EditMessageUseCase struct {
+	transactionManager        repository.TransactionManager
+	roomRepository            repository.RoomRepository
+	memberRepository          repository.MemberRepository
+	muteRepository            repository.MuteRepository
+	messageRepository         repository.MessageRepository
+	messageRevisionRepository repository.MessageRevisionRepository
+	messageEventGateway       gateway.MessageEventGateway
+	logger                    *log.Logger
+}
+
+func NewEditMessageUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	muteRepository repository.MuteRepository,
+	messageRepository repository.MessageRepository,
+	messageRevisionRepository repository.MessageRevisionRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *EditMessageUseCase {
+	return &EditMessageUseCase{
+		transactionManager:        transactionManager,
+		roomRepository:            roomRepository,
+		memberRepository:          memberRepository,
+		muteRepository:            muteRepository,
+		messageRepository:         messageRepository,
+		messageRevisionRepository: messageRevisionRepository,
+		messageEventGateway:       messageEventGateway,
+		logger:                    log.NewLogger("EditMessageUseCase"),
+	}
+}
+
+func (u *EditMessageUseCase) Execute(
+	ctx context.Context,
+	input *usecase.EditMessageUseCaseInput,
+) (*usecase.EditMessageUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	senderId, err := valueobject.NewUserIdWith(input.SenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := valueobject.NewMessageTextWith(input.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	_, err = u.memberRepository.FindByRoomAndUser(ctx, roomId, senderId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return nil, entity.ErrNotRoomMember
+		}
+
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	err = checkRoomMute(ctx, u.muteRepository, roomId, senderId)
+	if err != nil {
+		if !errors.Is(err, entity.ErrUserMuted) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	message, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if message.RoomId().Value() != room.Id().Value() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	if message.IsDeleted() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	err = message.ValidateSender(senderId)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := message.Edit(text)
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.messageRepository.Update(ctx, message)
+		if err != nil {
+			return err
+		}
+
+		err = u.messageRevisionRepository.Save(ctx, revision)
+		if err != nil {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewMessageEditedEvent(message))
+	})
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	output := &usecase.EditMessageUseCaseOutput{
+		Id:          message.Id().Value(),
+		RoomId:      message.RoomId().Value(),
+		SenderId:    message.SenderId().Value(),
+		SenderName:  message.SenderName().Value(),
+		Text:        messageTextValue(message),
+		CreatedAt:   message.CreatedAt().Value(),
+		EditedAt:    timestampValue(message.EditedAt()),
+		DeletedAt:   timestampValue(message.DeletedAt()),
+		ParentId:    idValue(message.ParentId()),
+		ReplyCount:  message.ReplyCount(),
+		LastReplyAt: timestampValue(message.LastReplyAt()),
+		Reactions:   reactionValues(message),
+	}
+
+	return output, nil
+}
@@ -0,0 +1,320 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEditMessageUseCase_ShouldEditAMessageWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.EditMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		SenderId:  adminId.Value(),
+		Text:      "An edited text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.SenderId, u.Value())
+		}).
+		Return(entity.NewMember(roomSaved.Id(), adminId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.MessageId, i.Value())
+		}).
+		Return(messageSaved, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	messageRepository.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Message) {
+			assert.Equal(t, input.Text, m.Text().Value())
+			assert.True(t, m.IsEdited())
+		}).
+		Return(nil).
+		Once()
+
+	messageRevisionRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *entity.MessageRevision) {
+			assert.Equal(t, input.MessageId, r.MessageId().Value())
+			assert.Equal(t, text.Value(), r.Text().Value())
+		}).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, event.MessageEdited, e.Type)
+			assert.Equal(t, input.MessageId, e.Id)
+			assert.Equal(t, input.Text, e.Text)
+			assert.NotEmpty(t, e.EditedAt)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewEditMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, messageRevisionRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, input.MessageId, output.Id)
+	assert.Equal(t, input.Text, output.Text)
+	assert.Equal(t, messageSaved.EditedAt().Value(), output.EditedAt)
+}
+
+func TestEditMessageUseCase_ShouldReturnAnErrorWhenUserIsNotTheSender(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.EditMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		SenderId:  "auth0|64c8457bb160e37c8c34533c",
+		Text:      "An edited text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.SenderId, u.Value())
+		}).
+		Return(entity.NewMember(roomSaved.Id(), adminId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(messageSaved, nil).
+		Once()
+
+	useCase := NewEditMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, messageRevisionRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrInvalidMessageSender)
+}
+
+func TestEditMessageUseCase_ShouldReturnAnErrorWhenTheMessageIsFromAnotherRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(valueobject.NewId(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.EditMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		SenderId:  adminId.Value(),
+		Text:      "An edited text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.SenderId, u.Value())
+		}).
+		Return(entity.NewMember(roomSaved.Id(), adminId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(messageSaved, nil).
+		Once()
+
+	useCase := NewEditMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, messageRevisionRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
+
+func TestEditMessageUseCase_ShouldReturnAnErrorWhenTheSenderIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), senderId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.EditMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		SenderId:  senderId.Value(),
+		Text:      "An edited text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewEditMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, messageRevisionRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrNotRoomMember)
+}
+
+func TestEditMessageUseCase_ShouldReturnAnErrorWhenTheSenderIsMuted(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), senderId, senderName, text)
+	mute, _ := entity.NewMute(roomSaved.Id(), senderId, adminId, valueobject.NewTimestamp().Add(time.Hour))
+
+	ctx := context.Background()
+	input := &usecase.EditMessageUseCaseInput{
+		RoomId:    roomSaved.Id().Value(),
+		MessageId: messageSaved.Id().Value(),
+		SenderId:  senderId.Value(),
+		Text:      "An edited text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(roomSaved.Id(), senderId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(mute, nil).
+		Once()
+
+	useCase := NewEditMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, messageRevisionRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrUserMuted)
+}
@@ -0,0 +1,107 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type FindMessageUseCase struct {
+	roomRepository    repository.RoomRepository
+	messageRepository repository.MessageRepository
+	memberRepository  repository.MemberRepository
+	banRepository     repository.BanRepository
+	logger            *log.Logger
+}
+
+func NewFindMessageUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	messageRepository repository.MessageRepository,
+) *FindMessageUseCase {
+	return &FindMessageUseCase{
+		roomRepository:    roomRepository,
+		memberRepository:  memberRepository,
+		banRepository:     banRepository,
+		messageRepository: messageRepository,
+		logger:            log.NewLogger("FindMessageUseCase"),
+	}
+}
+
+func (u *FindMessageUseCase) Execute(
+	ctx context.Context,
+	input *usecase.FindMessageUseCaseInput,
+) (*usecase.FindMessageUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	message, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if message.RoomId().Value() != room.Id().Value() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	output := &usecase.FindMessageUseCaseOutput{
+		Id:          message.Id().Value(),
+		RoomId:      message.RoomId().Value(),
+		SenderId:    message.SenderId().Value(),
+		SenderName:  message.SenderName().Value(),
+		Text:        messageTextValue(message),
+		CreatedAt:   message.CreatedAt().Value(),
+		EditedAt:    timestampValue(message.EditedAt()),
+		DeletedAt:   timestampValue(message.DeletedAt()),
+		ParentId:    idValue(message.ParentId()),
+		ReplyCount:  message.ReplyCount(),
+		LastReplyAt: timestampValue(message.LastReplyAt()),
+		Reactions:   reactionValues(message),
+	}
+
+	return output, nil
+}
@@ -0,0 +1,176 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindMessageUseCase_ShouldReturnAMessageWhenItExists(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	emoji, _ := valueobject.NewEmojiWith("👍")
+	message := entity.NewMessageWith(
+		valueobject.NewId(),
+		room.Id(),
+		adminId,
+		senderName,
+		text,
+		valueobject.NewTimestamp(),
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		[]*entity.ReactionCount{entity.NewReactionCountWith(emoji, 2)},
+	)
+
+	ctx := context.Background()
+	input := &usecase.FindMessageUseCaseInput{
+		UserId:    "auth0|64c8457bb160e37c8c34533b",
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.RoomId, i.Value())
+		}).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.MessageId, i.Value())
+		}).
+		Return(message, nil).
+		Once()
+
+	useCase := NewFindMessageUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, message.Id().Value(), output.Id)
+	assert.Equal(t, message.RoomId().Value(), output.RoomId)
+	assert.Equal(t, message.SenderId().Value(), output.SenderId)
+	assert.Equal(t, message.SenderName().Value(), output.SenderName)
+	assert.Equal(t, message.Text().Value(), output.Text)
+	assert.Equal(t, message.CreatedAt().Value(), output.CreatedAt)
+	assert.Equal(t, 1, len(output.Reactions))
+	assert.Equal(t, emoji.Value(), output.Reactions[0].Emoji)
+	assert.Equal(t, 2, output.Reactions[0].Count)
+}
+
+func TestFindMessageUseCase_ShouldReturnAnErrorWhenTheMessageIsFromAnotherRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	otherRoom := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(otherRoom.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.FindMessageUseCaseInput{
+		UserId:    "auth0|64c8457bb160e37c8c34533b",
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	useCase := NewFindMessageUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
+
+func TestFindMessageUseCase_ShouldReturnAnErrorWhenIdsAreInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test  string
+		input *usecase.FindMessageUseCaseInput
+		err   error
+	}{
+		{
+			"empty room id",
+			&usecase.FindMessageUseCaseInput{
+				UserId:    "auth0|64c8457bb160e37c8c34533b",
+				RoomId:    "",
+				MessageId: "b3588483-4795-434a-877c-dcd158d6caa7",
+			},
+			valueobject.ErrRequiredId,
+		},
+		{
+			"invalid message id",
+			&usecase.FindMessageUseCaseInput{
+				UserId:    "auth0|64c8457bb160e37c8c34533b",
+				RoomId:    "b3588483-4795-434a-877c-dcd158d6caa7",
+				MessageId: "a-message",
+			},
+			valueobject.ErrInvalidId,
+		},
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	useCase := NewFindMessageUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			output, err := useCase.Execute(ctx, tc.input)
+			assert.Nil(t, output)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
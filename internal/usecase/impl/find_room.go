@@ -11,14 +11,22 @@ import (
 )
 
 type FindRoomUseCase struct {
-	roomRepository repository.RoomRepository
-	logger         *log.Logger
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	banRepository    repository.BanRepository
+	logger           *log.Logger
 }
 
-func NewFindRoomUseCase(roomRepository repository.RoomRepository) *FindRoomUseCase {
+func NewFindRoomUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+) *FindRoomUseCase {
 	return &FindRoomUseCase{
-		roomRepository: roomRepository,
-		logger:         log.NewLogger("FindRoomUseCase"),
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		banRepository:    banRepository,
+		logger:           log.NewLogger("FindRoomUseCase"),
 	}
 }
 
@@ -32,6 +40,11 @@ func (u *FindRoomUseCase) Execute(
 		return nil, err
 	}
 
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	room, err := u.roomRepository.FindById(ctx, id)
 	if err != nil {
 		if !errors.Is(err, repository.ErrNotFoundRoom) {
@@ -45,13 +58,23 @@ func (u *FindRoomUseCase) Execute(
 		return nil, repository.ErrNotFoundRoom
 	}
 
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
 	output := &usecase.FindRoomUseCaseOutput{
-		Id:        room.Id().Value(),
-		AdminId:   room.AdminId().Value(),
-		Name:      room.Name().Value(),
-		Category:  room.Category().Value(),
-		CreatedAt: room.CreatedAt().Value(),
-		UpdatedAt: room.UpdatedAt().Value(),
+		Id:         room.Id().Value(),
+		AdminId:    room.AdminId().Value(),
+		Name:       room.Name().Value(),
+		Category:   room.Category().Value(),
+		Visibility: room.Visibility().Value(),
+		CreatedAt:  room.CreatedAt().Value(),
+		UpdatedAt:  room.UpdatedAt().Value(),
 	}
 
 	return output, nil
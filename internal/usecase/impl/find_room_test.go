@@ -22,10 +22,13 @@ func TestFindRoomUseCase_ShouldReturnARoomWhenDataIsValid(t *testing.T) {
 
 	ctx := context.Background()
 	input := &usecase.FindRoomUseCaseInput{
-		Id: savedRoom.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		Id:     savedRoom.Id().Value(),
 	}
 
 	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
 
 	roomRepository.
 		EXPECT().
@@ -37,7 +40,12 @@ func TestFindRoomUseCase_ShouldReturnARoomWhenDataIsValid(t *testing.T) {
 		Return(savedRoom, nil).
 		Once()
 
-	useCase := NewFindRoomUseCase(roomRepository)
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	useCase := NewFindRoomUseCase(roomRepository, memberRepository, banRepository)
 	output, err := useCase.Execute(ctx, input)
 	assert.NotNil(t, output)
 	assert.Nil(t, err)
@@ -60,21 +68,25 @@ func TestFindRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		{
 			"empty id",
 			&usecase.FindRoomUseCaseInput{
-				Id: "",
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				Id:     "",
 			},
 			valueobject.ErrRequiredId,
 		},
 		{
 			"invalid id",
 			&usecase.FindRoomUseCaseInput{
-				Id: "dfaioewurqredfa",
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				Id:     "dfaioewurqredfa",
 			},
 			valueobject.ErrInvalidId,
 		},
 	}
 
 	roomRepository := mocks.NewRoomRepositoryMock(t)
-	useCase := NewFindRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	useCase := NewFindRoomUseCase(roomRepository, memberRepository, banRepository)
 
 	for _, tc := range testCases {
 		t.Run(tc.test, func(t *testing.T) {
@@ -89,10 +101,13 @@ func TestFindRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 func TestFindRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 	ctx := context.Background()
 	input := &usecase.FindRoomUseCaseInput{
-		Id: "b3588483-4795-434a-877c-dcd158d6caa7",
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		Id:     "b3588483-4795-434a-877c-dcd158d6caa7",
 	}
 
 	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
 
 	roomRepository.
 		EXPECT().
@@ -100,10 +115,93 @@ func TestFindRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 		Return(nil, repository.ErrNotFoundRoom).
 		Once()
 
-	useCase := NewFindRoomUseCase(roomRepository)
+	useCase := NewFindRoomUseCase(roomRepository, memberRepository, banRepository)
 
 	output, err := useCase.Execute(ctx, input)
 	assert.Nil(t, output)
 	assert.NotNil(t, err)
 	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
 }
+
+func TestFindRoomUseCase_ShouldReturnAnErrorWhenThePrivateRoomIsNotVisible(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	visibility, _ := valueobject.NewRoomVisibilityWith("private")
+	savedRoom := entity.NewRoom(adminId, name, category)
+	savedRoom.UpdateVisibility(visibility)
+
+	ctx := context.Background()
+	input := &usecase.FindRoomUseCaseInput{
+		Id:     savedRoom.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.
+		EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(savedRoom, nil).
+		Once()
+
+	memberRepository.
+		EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.Id, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewFindRoomUseCase(roomRepository, memberRepository, banRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
+
+func TestFindRoomUseCase_ShouldReturnAnErrorWhenTheUserIsBanned(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	savedRoom := entity.NewRoom(adminId, name, category)
+
+	ban, _ := entity.NewBan(savedRoom.Id(), userId, adminId, nil)
+
+	ctx := context.Background()
+	input := &usecase.FindRoomUseCaseInput{
+		Id:     savedRoom.Id().Value(),
+		UserId: userId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.
+		EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(savedRoom, nil).
+		Once()
+
+	banRepository.
+		EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.Id, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(ban, nil).
+		Once()
+
+	useCase := NewFindRoomUseCase(roomRepository, memberRepository, banRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
@@ -0,0 +1,84 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type JoinRoomUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	banRepository    repository.BanRepository
+	logger           *log.Logger
+}
+
+func NewJoinRoomUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+) *JoinRoomUseCase {
+	return &JoinRoomUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		banRepository:    banRepository,
+		logger:           log.NewLogger("JoinRoomUseCase"),
+	}
+}
+
+func (u *JoinRoomUseCase) Execute(ctx context.Context, input *usecase.JoinRoomUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	// Private rooms are joined by accepting an invitation.
+	err = checkRoomVisibility(ctx, u.memberRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = checkRoomBan(ctx, u.banRepository, room.Id(), userId)
+	if err != nil {
+		if !errors.Is(err, entity.ErrUserBanned) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = u.memberRepository.Save(ctx, entity.NewMember(room.Id(), userId))
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
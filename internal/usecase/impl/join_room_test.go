@@ -0,0 +1,190 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJoinRoomUseCase_ShouldAddTheMemberWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.JoinRoomUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	memberRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.RoomId, m.RoomId().Value())
+			assert.Equal(t, input.UserId, m.UserId().Value())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewJoinRoomUseCase(roomRepository, memberRepository, banRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestJoinRoomUseCase_ShouldReturnAnErrorWhenTheRoomIsNotFound(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.JoinRoomUseCaseInput{
+		RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundRoom).
+		Once()
+
+	useCase := NewJoinRoomUseCase(roomRepository, memberRepository, banRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
+
+func TestJoinRoomUseCase_ShouldReturnAnErrorWhenTheRoomIsPrivate(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	visibility, _ := valueobject.NewRoomVisibilityWith("private")
+	room := entity.NewRoom(adminId, name, category)
+	room.UpdateVisibility(visibility)
+
+	ctx := context.Background()
+	input := &usecase.JoinRoomUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewJoinRoomUseCase(roomRepository, memberRepository, banRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
+
+func TestJoinRoomUseCase_ShouldReturnAnErrorWhenTheUserIsBanned(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	ban, _ := entity.NewBan(room.Id(), userId, adminId, nil)
+
+	ctx := context.Background()
+	input := &usecase.JoinRoomUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: userId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(ban, nil).
+		Once()
+
+	useCase := NewJoinRoomUseCase(roomRepository, memberRepository, banRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrUserBanned)
+}
+
+func TestJoinRoomUseCase_ShouldAddTheMemberWhenTheBanIsExpired(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	createdAt := valueobject.NewTimestamp().Add(-2 * time.Hour)
+	ban := entity.NewBanWith(room.Id(), userId, adminId, createdAt, createdAt.Add(time.Hour))
+
+	ctx := context.Background()
+	input := &usecase.JoinRoomUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: userId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(ban, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	useCase := NewJoinRoomUseCase(roomRepository, memberRepository, banRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
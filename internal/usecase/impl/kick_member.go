@@ -0,0 +1,106 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type KickMemberUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewKickMemberUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *KickMemberUseCase {
+	return &KickMemberUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("KickMemberUseCase"),
+	}
+}
+
+func (u *KickMemberUseCase) Execute(ctx context.Context, input *usecase.KickMemberUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	memberId, err := valueobject.NewUserIdWith(input.MemberId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	moderator, err := checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionKickMember)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	member, err := u.memberRepository.FindByRoomAndUser(ctx, room.Id(), memberId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMember) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = moderator.ValidateModeration(member)
+	if err != nil {
+		return err
+	}
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.memberRepository.Delete(ctx, member)
+		if err != nil {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewMemberKickedEvent(member, userId))
+	})
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestKickMemberUseCase_ShouldRemoveTheMemberWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	moderatorId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	moderatorRole, _ := valueobject.NewMemberRoleWith(valueobject.MemberRoleModerator)
+	moderator := entity.NewMemberWith(room.Id(), moderatorId, moderatorRole, valueobject.NewTimestamp(), nil)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+	member := entity.NewMember(room.Id(), memberId)
+
+	ctx := context.Background()
+	input := &usecase.KickMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   moderatorId.Value(),
+		MemberId: memberId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, moderatorId).
+		Return(moderator, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(member, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	memberRepository.EXPECT().
+		Delete(mock.Anything, member).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, event.MemberKicked, e.Type)
+			assert.Equal(t, input.RoomId, e.RoomId)
+			assert.Equal(t, input.UserId, e.SenderId)
+			assert.Equal(t, input.MemberId, e.MemberId)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewKickMemberUseCase(transactionManager, roomRepository, memberRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestKickMemberUseCase_ShouldReturnAnErrorWhenTheUserKicksItself(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.KickMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   adminId.Value(),
+		MemberId: adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Twice()
+
+	useCase := NewKickMemberUseCase(transactionManager, roomRepository, memberRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrCannotModerateMember)
+}
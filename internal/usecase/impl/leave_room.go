@@ -0,0 +1,75 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type LeaveRoomUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	logger           *log.Logger
+}
+
+func NewLeaveRoomUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+) *LeaveRoomUseCase {
+	return &LeaveRoomUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		logger:           log.NewLogger("LeaveRoomUseCase"),
+	}
+}
+
+func (u *LeaveRoomUseCase) Execute(ctx context.Context, input *usecase.LeaveRoomUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	member, err := u.memberRepository.FindByRoomAndUser(ctx, room.Id(), userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMember) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if member.IsOwner() {
+		return entity.ErrRoomOwnerCannotLeave
+	}
+
+	err = u.memberRepository.Delete(ctx, member)
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
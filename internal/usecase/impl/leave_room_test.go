@@ -0,0 +1,120 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLeaveRoomUseCase_ShouldRemoveTheMemberWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	member := entity.NewMember(room.Id(), userId)
+
+	ctx := context.Background()
+	input := &usecase.LeaveRoomUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: userId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.RoomId, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(member, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Delete(mock.Anything, member).
+		Return(nil).
+		Once()
+
+	useCase := NewLeaveRoomUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestLeaveRoomUseCase_ShouldReturnAnErrorWhenTheUserIsTheOwner(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.LeaveRoomUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: adminId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	useCase := NewLeaveRoomUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrRoomOwnerCannotLeave)
+}
+
+func TestLeaveRoomUseCase_ShouldReturnAnErrorWhenTheUserIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.LeaveRoomUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewLeaveRoomUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMember)
+}
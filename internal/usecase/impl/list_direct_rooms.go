@@ -0,0 +1,72 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListDirectRoomsUseCase struct {
+	directRoomRepository repository.DirectRoomRepository
+	logger               *log.Logger
+}
+
+func NewListDirectRoomsUseCase(directRoomRepository repository.DirectRoomRepository) *ListDirectRoomsUseCase {
+	return &ListDirectRoomsUseCase{
+		directRoomRepository: directRoomRepository,
+		logger:               log.NewLogger("ListDirectRoomsUseCase"),
+	}
+}
+
+func (u *ListDirectRoomsUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListDirectRoomsUseCaseInput,
+) (*pagination.Page[*usecase.ListDirectRoomsUseCaseOutput], error) {
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := pagination.NewQuery(input.Page, input.Size, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.directRoomRepository.ListByUser(ctx, userId, query)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	mapper := func(d *entity.DirectRoom) *usecase.ListDirectRoomsUseCaseOutput {
+		output := &usecase.ListDirectRoomsUseCaseOutput{
+			RoomId:      d.Room().Id().Value(),
+			RecipientId: d.OtherUserId(userId).Value(),
+			CreatedAt:   d.Room().CreatedAt().Value(),
+		}
+
+		if message := d.LastMessage(); message != nil {
+			output.LastMessage = &usecase.LastMessageOutput{
+				MessageId:  message.Id().Value(),
+				SenderId:   message.SenderId().Value(),
+				SenderName: message.SenderName().Value(),
+				Text:       messageTextValue(message),
+				CreatedAt:  message.CreatedAt().Value(),
+				EditedAt:   timestampValue(message.EditedAt()),
+				DeletedAt:  timestampValue(message.DeletedAt()),
+			}
+		}
+
+		return output
+	}
+
+	output := pagination.MapPage[*entity.DirectRoom, *usecase.ListDirectRoomsUseCaseOutput](page, mapper)
+
+	return output, nil
+}
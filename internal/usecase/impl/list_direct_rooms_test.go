@@ -0,0 +1,81 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListDirectRoomsUseCase_ShouldListTheDirectRoomsWithTheirLastMessage(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	recipientId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	otherRecipientId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+
+	directRoom, _ := entity.NewDirectRoom(userId, recipientId)
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(directRoom.Room().Id(), recipientId, senderName, text)
+	activeRoom := entity.NewDirectRoomWith(directRoom.Room(), userId, recipientId, message)
+
+	emptyRoom, _ := entity.NewDirectRoom(otherRecipientId, userId)
+
+	ctx := context.Background()
+	input := &usecase.ListDirectRoomsUseCaseInput{
+		UserId: userId.Value(),
+		Page:   "0",
+		Size:   "10",
+	}
+
+	directRoomRepository := mocks.NewDirectRoomRepositoryMock(t)
+
+	directRoomRepository.EXPECT().
+		ListByUser(mock.Anything, userId, mock.Anything).
+		Run(func(c context.Context, u *valueobject.UserId, q *pagination.Query) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, 0, q.Page())
+			assert.Equal(t, 10, q.Size())
+		}).
+		Return(pagination.NewPage[*entity.DirectRoom](0, 10, 2, []*entity.DirectRoom{activeRoom, emptyRoom}), nil).
+		Once()
+
+	useCase := NewListDirectRoomsUseCase(directRoomRepository)
+
+	page, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), page.Total)
+	assert.Len(t, page.Items, 2)
+
+	assert.Equal(t, activeRoom.Room().Id().Value(), page.Items[0].RoomId)
+	assert.Equal(t, recipientId.Value(), page.Items[0].RecipientId)
+	assert.Equal(t, message.Id().Value(), page.Items[0].LastMessage.MessageId)
+	assert.Equal(t, recipientId.Value(), page.Items[0].LastMessage.SenderId)
+	assert.Equal(t, "A text", page.Items[0].LastMessage.Text)
+	assert.Equal(t, message.CreatedAt().Value(), page.Items[0].LastMessage.CreatedAt)
+
+	assert.Equal(t, emptyRoom.Room().Id().Value(), page.Items[1].RoomId)
+	assert.Equal(t, otherRecipientId.Value(), page.Items[1].RecipientId)
+	assert.Nil(t, page.Items[1].LastMessage)
+}
+
+func TestListDirectRoomsUseCase_ShouldReturnAnErrorWhenTheQueryIsInvalid(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.ListDirectRoomsUseCaseInput{
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		Size:   "100",
+	}
+
+	directRoomRepository := mocks.NewDirectRoomRepositoryMock(t)
+	useCase := NewListDirectRoomsUseCase(directRoomRepository)
+
+	page, err := useCase.Execute(ctx, input)
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, pagination.ErrInvalidQuerySize)
+}
@@ -0,0 +1,95 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListMembersUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	banRepository    repository.BanRepository
+	logger           *log.Logger
+}
+
+func NewListMembersUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+) *ListMembersUseCase {
+	return &ListMembersUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		banRepository:    banRepository,
+		logger:           log.NewLogger("ListMembersUseCase"),
+	}
+}
+
+func (u *ListMembersUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListMembersUseCaseInput,
+) (*pagination.Page[*usecase.ListMembersUseCaseOutput], error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := pagination.NewQuery(input.Page, input.Size, input.Sort, input.Search)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	page, err := u.memberRepository.ListByRoom(ctx, room.Id(), query)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	mapper := func(m *entity.Member) *usecase.ListMembersUseCaseOutput {
+		return &usecase.ListMembersUseCaseOutput{
+			RoomId:   m.RoomId().Value(),
+			UserId:   m.UserId().Value(),
+			Role:     m.Role().Value(),
+			JoinedAt: m.JoinedAt().Value(),
+		}
+	}
+
+	output := pagination.MapPage[*entity.Member, *usecase.ListMembersUseCaseOutput](page, mapper)
+
+	return output, nil
+}
@@ -0,0 +1,99 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListMembersUseCase_ShouldReturnAPageWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	member := entity.NewMember(room.Id(), adminId)
+
+	ctx := context.Background()
+	input := &usecase.ListMembersUseCaseInput{
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		RoomId: room.Id().Value(),
+		Page:   "0",
+		Size:   "10",
+		Sort:   "asc",
+		Search: "auth0",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	memberRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id, q *pagination.Query) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.RoomId, i.Value())
+			assert.Equal(t, input.Page, strconv.Itoa(q.Page()))
+			assert.Equal(t, input.Size, strconv.Itoa(q.Size()))
+			assert.Equal(t, strings.ToUpper(input.Sort), q.Sort())
+			assert.Equal(t, strings.ToUpper(input.Search), q.Search())
+		}).
+		Return(pagination.NewPage[*entity.Member](0, 10, int64(1), []*entity.Member{member}), nil).
+		Once()
+
+	useCase := NewListMembersUseCase(roomRepository, memberRepository, banRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), output.Total)
+	assert.Equal(t, 1, len(output.Items))
+	assert.Equal(t, member.UserId().Value(), output.Items[0].UserId)
+	assert.Equal(t, member.Role().Value(), output.Items[0].Role)
+	assert.Equal(t, member.JoinedAt().Value(), output.Items[0].JoinedAt)
+}
+
+func TestListMembersUseCase_ShouldReturnAnErrorWhenTheRoomIsNotFound(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.ListMembersUseCaseInput{
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+		Page:   "0",
+		Size:   "10",
+		Sort:   "asc",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundRoom).
+		Once()
+
+	useCase := NewListMembersUseCase(roomRepository, memberRepository, banRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
@@ -0,0 +1,130 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListMessageReadersUseCase struct {
+	roomRepository    repository.RoomRepository
+	memberRepository  repository.MemberRepository
+	banRepository     repository.BanRepository
+	messageRepository repository.MessageRepository
+	logger            *log.Logger
+}
+
+func NewListMessageReadersUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	messageRepository repository.MessageRepository,
+) *ListMessageReadersUseCase {
+	return &ListMessageReadersUseCase{
+		roomRepository:    roomRepository,
+		memberRepository:  memberRepository,
+		banRepository:     banRepository,
+		messageRepository: messageRepository,
+		logger:            log.NewLogger("ListMessageReadersUseCase"),
+	}
+}
+
+func (u *ListMessageReadersUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListMessageReadersUseCaseInput,
+) (*pagination.Page[*usecase.ListMessageReadersUseCaseOutput], error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := pagination.NewQuery(input.Page, input.Size, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	message, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if message.RoomId().Value() != room.Id().Value() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	err = message.ValidateSender(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.memberRepository.CountByRoom(ctx, room.Id())
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	err = entity.ValidateReadReceipts(members)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.memberRepository.ListReaders(ctx, message, query)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	mapper := func(m *entity.Member) *usecase.ListMessageReadersUseCaseOutput {
+		return &usecase.ListMessageReadersUseCaseOutput{
+			UserId: m.UserId().Value(),
+			Role:   m.Role().Value(),
+		}
+	}
+
+	output := pagination.MapPage[*entity.Member, *usecase.ListMessageReadersUseCaseOutput](page, mapper)
+
+	return output, nil
+}
@@ -0,0 +1,175 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListMessageReadersUseCase_ShouldListTheReadersWhenUserIsTheSender(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	readerId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	reader := entity.NewMember(room.Id(), readerId)
+	reader.MarkAsRead(message.Id())
+
+	ctx := context.Background()
+	input := &usecase.ListMessageReadersUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    adminId.Value(),
+		MessageId: message.Id().Value(),
+		Page:      "0",
+		Size:      "10",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		CountByRoom(mock.Anything, room.Id()).
+		Return(2, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		ListReaders(mock.Anything, message, mock.Anything).
+		Run(func(c context.Context, m *entity.Message, q *pagination.Query) {
+			assert.Equal(t, 0, q.Page())
+			assert.Equal(t, 10, q.Size())
+		}).
+		Return(pagination.NewPage[*entity.Member](0, 10, 1, []*entity.Member{reader}), nil).
+		Once()
+
+	useCase := NewListMessageReadersUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	page, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), page.Total)
+	assert.Equal(t, readerId.Value(), page.Items[0].UserId)
+	assert.Equal(t, valueobject.MemberRoleMember, page.Items[0].Role)
+}
+
+func TestListMessageReadersUseCase_ShouldReturnAnErrorWhenUserIsNotTheSender(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.ListMessageReadersUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    "auth0|64c8457bb160e37c8c34533c",
+		MessageId: message.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	useCase := NewListMessageReadersUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	page, err := useCase.Execute(ctx, input)
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, entity.ErrInvalidMessageSender)
+}
+
+func TestListMessageReadersUseCase_ShouldReturnAnErrorWhenTheRoomIsLarge(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.ListMessageReadersUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    adminId.Value(),
+		MessageId: message.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		CountByRoom(mock.Anything, room.Id()).
+		Return(entity.MaxReadReceiptRoomMembers+1, nil).
+		Once()
+
+	useCase := NewListMessageReadersUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	page, err := useCase.Execute(ctx, input)
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, entity.ErrReadReceiptsUnavailable)
+}
@@ -0,0 +1,117 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListMessageRevisionsUseCase struct {
+	roomRepository            repository.RoomRepository
+	memberRepository          repository.MemberRepository
+	banRepository             repository.BanRepository
+	messageRepository         repository.MessageRepository
+	messageRevisionRepository repository.MessageRevisionRepository
+	logger                    *log.Logger
+}
+
+func NewListMessageRevisionsUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	messageRepository repository.MessageRepository,
+	messageRevisionRepository repository.MessageRevisionRepository,
+) *ListMessageRevisionsUseCase {
+	return &ListMessageRevisionsUseCase{
+		roomRepository:            roomRepository,
+		memberRepository:          memberRepository,
+		banRepository:             banRepository,
+		messageRepository:         messageRepository,
+		messageRevisionRepository: messageRevisionRepository,
+		logger:                    log.NewLogger("ListMessageRevisionsUseCase"),
+	}
+}
+
+// Execute lists the previous texts of the message from the oldest. The texts
+// of a deleted message are not listed.
+func (u *ListMessageRevisionsUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListMessageRevisionsUseCaseInput,
+) ([]*usecase.ListMessageRevisionsUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	message, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if message.RoomId().Value() != room.Id().Value() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	output := make([]*usecase.ListMessageRevisionsUseCaseOutput, 0)
+
+	if message.IsDeleted() {
+		return output, nil
+	}
+
+	revisions, err := u.messageRevisionRepository.ListByMessage(ctx, messageId)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	for _, r := range revisions {
+		output = append(output, &usecase.ListMessageRevisionsUseCaseOutput{
+			Id:        r.Id().Value(),
+			Text:      r.Text().Value(),
+			CreatedAt: r.CreatedAt().Value(),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,164 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListMessageRevisionsUseCase_ShouldListTheRevisionsWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	editedText, _ := valueobject.NewMessageTextWith("An edited text")
+	revision := message.Edit(editedText)
+
+	ctx := context.Background()
+	input := &usecase.ListMessageRevisionsUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	messageRevisionRepository.EXPECT().
+		ListByMessage(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.MessageId, i.Value())
+		}).
+		Return([]*entity.MessageRevision{revision}, nil).
+		Once()
+
+	useCase := NewListMessageRevisionsUseCase(roomRepository, memberRepository, banRepository, messageRepository, messageRevisionRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(output))
+	assert.Equal(t, revision.Id().Value(), output[0].Id)
+	assert.Equal(t, text.Value(), output[0].Text)
+}
+
+func TestListMessageRevisionsUseCase_ShouldNotListTheRevisionsOfADeletedMessage(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+	message.Delete()
+
+	ctx := context.Background()
+	input := &usecase.ListMessageRevisionsUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	useCase := NewListMessageRevisionsUseCase(roomRepository, memberRepository, banRepository, messageRepository, messageRevisionRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Empty(t, output)
+}
+
+func TestListMessageRevisionsUseCase_ShouldReturnAnErrorWhenTheMessageIsFromAnotherRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(valueobject.NewId(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.ListMessageRevisionsUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageRevisionRepository := mocks.NewMessageRevisionRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	useCase := NewListMessageRevisionsUseCase(roomRepository, memberRepository, banRepository, messageRepository, messageRevisionRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
@@ -0,0 +1,106 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListMessagesUseCase struct {
+	roomRepository    repository.RoomRepository
+	messageRepository repository.MessageRepository
+	memberRepository  repository.MemberRepository
+	banRepository     repository.BanRepository
+	logger            *log.Logger
+}
+
+func NewListMessagesUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	messageRepository repository.MessageRepository,
+) *ListMessagesUseCase {
+	return &ListMessagesUseCase{
+		roomRepository:    roomRepository,
+		memberRepository:  memberRepository,
+		banRepository:     banRepository,
+		messageRepository: messageRepository,
+		logger:            log.NewLogger("ListMessagesUseCase"),
+	}
+}
+
+func (u *ListMessagesUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListMessagesUseCaseInput,
+) (*pagination.CursorPage[*usecase.ListMessagesUseCaseOutput], error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := pagination.NewCursorQuery(input.Cursor, input.Size, input.Direction)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	page, err := u.messageRepository.ListByRoom(ctx, roomId, query)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	mapper := func(m *entity.Message) *usecase.ListMessagesUseCaseOutput {
+		return &usecase.ListMessagesUseCaseOutput{
+			Id:          m.Id().Value(),
+			RoomId:      m.RoomId().Value(),
+			SenderId:    m.SenderId().Value(),
+			SenderName:  m.SenderName().Value(),
+			Text:        messageTextValue(m),
+			CreatedAt:   m.CreatedAt().Value(),
+			EditedAt:    timestampValue(m.EditedAt()),
+			DeletedAt:   timestampValue(m.DeletedAt()),
+			ParentId:    idValue(m.ParentId()),
+			ReplyCount:  m.ReplyCount(),
+			LastReplyAt: timestampValue(m.LastReplyAt()),
+			Reactions:   reactionValues(m),
+		}
+	}
+
+	output := pagination.MapCursorPage[*entity.Message, *usecase.ListMessagesUseCaseOutput](page, mapper)
+
+	return output, nil
+}
@@ -0,0 +1,183 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListMessagesUseCase_ShouldReturnAPageWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+	deletedMessage := entity.NewMessage(room.Id(), adminId, senderName, text)
+	deletedMessage.Delete()
+
+	cursor := pagination.NewCursor(message.CreatedAt(), message.Id())
+
+	ctx := context.Background()
+	input := &usecase.ListMessagesUseCaseInput{
+		UserId:    "auth0|64c8457bb160e37c8c34533b",
+		RoomId:    room.Id().Value(),
+		Cursor:    cursor.Value(),
+		Size:      "5",
+		Direction: "after",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.RoomId, i.Value())
+		}).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id, q *pagination.CursorQuery) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.RoomId, i.Value())
+			assert.Equal(t, input.Cursor, q.Cursor().Value())
+			assert.Equal(t, input.Size, strconv.Itoa(q.Size()))
+			assert.Equal(t, pagination.DirectionAfter, q.Direction())
+		}).
+		Return(&pagination.CursorPage[*entity.Message]{
+			Size:   5,
+			Before: "before",
+			After:  "after",
+			Items:  []*entity.Message{message, deletedMessage},
+		}, nil).
+		Once()
+
+	useCase := NewListMessagesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.NotNil(t, output)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, output.Size)
+	assert.Equal(t, "before", output.Before)
+	assert.Equal(t, "after", output.After)
+	assert.Equal(t, 2, len(output.Items))
+	assert.Equal(t, message.Id().Value(), output.Items[0].Id)
+	assert.Equal(t, message.RoomId().Value(), output.Items[0].RoomId)
+	assert.Equal(t, message.SenderId().Value(), output.Items[0].SenderId)
+	assert.Equal(t, message.SenderName().Value(), output.Items[0].SenderName)
+	assert.Equal(t, message.Text().Value(), output.Items[0].Text)
+	assert.Equal(t, message.CreatedAt().Value(), output.Items[0].CreatedAt)
+	assert.Empty(t, output.Items[0].DeletedAt)
+	assert.Equal(t, deletedMessage.Id().Value(), output.Items[1].Id)
+	assert.Empty(t, output.Items[1].Text)
+	assert.Equal(t, deletedMessage.DeletedAt().Value(), output.Items[1].DeletedAt)
+}
+
+func TestListMessagesUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test  string
+		input *usecase.ListMessagesUseCaseInput
+		err   error
+	}{
+		{
+			"empty room id",
+			&usecase.ListMessagesUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				RoomId: "",
+			},
+			valueobject.ErrRequiredId,
+		},
+		{
+			"invalid cursor",
+			&usecase.ListMessagesUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+				Cursor: "dfaioewurqredfa",
+			},
+			pagination.ErrInvalidQueryCursor,
+		},
+		{
+			"invalid size",
+			&usecase.ListMessagesUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+				Size:   "51",
+			},
+			pagination.ErrInvalidQuerySize,
+		},
+		{
+			"invalid direction",
+			&usecase.ListMessagesUseCaseInput{
+				UserId:    "auth0|64c8457bb160e37c8c34533b",
+				RoomId:    "b3588483-4795-434a-877c-dcd158d6caa7",
+				Direction: "around",
+			},
+			pagination.ErrInvalidQueryDirection,
+		},
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	useCase := NewListMessagesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			output, err := useCase.Execute(ctx, tc.input)
+			assert.Nil(t, output)
+			assert.NotNil(t, err)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestListMessagesUseCase_ShouldReturnAnErrorWhenRoomIsNotFound(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.ListMessagesUseCaseInput{
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundRoom).
+		Once()
+
+	useCase := NewListMessagesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
@@ -0,0 +1,93 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListOwnershipTransfersUseCase struct {
+	roomRepository              repository.RoomRepository
+	memberRepository            repository.MemberRepository
+	banRepository               repository.BanRepository
+	ownershipTransferRepository repository.OwnershipTransferRepository
+	logger                      *log.Logger
+}
+
+func NewListOwnershipTransfersUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	ownershipTransferRepository repository.OwnershipTransferRepository,
+) *ListOwnershipTransfersUseCase {
+	return &ListOwnershipTransfersUseCase{
+		roomRepository:              roomRepository,
+		memberRepository:            memberRepository,
+		banRepository:               banRepository,
+		ownershipTransferRepository: ownershipTransferRepository,
+		logger:                      log.NewLogger("ListOwnershipTransfersUseCase"),
+	}
+}
+
+// Execute lists the ownership transfers of the room from the oldest.
+func (u *ListOwnershipTransfersUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListOwnershipTransfersUseCaseInput,
+) ([]*usecase.ListOwnershipTransfersUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	transfers, err := u.ownershipTransferRepository.ListByRoom(ctx, roomId)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	output := make([]*usecase.ListOwnershipTransfersUseCaseOutput, 0, len(transfers))
+
+	for _, t := range transfers {
+		output = append(output, &usecase.ListOwnershipTransfersUseCaseOutput{
+			Id:              t.Id().Value(),
+			PreviousAdminId: t.PreviousAdminId().Value(),
+			AdminId:         t.AdminId().Value(),
+			TransferredBy:   t.TransferredBy().Value(),
+			TransferredAt:   t.TransferredAt().Value(),
+		})
+	}
+
+	return output, nil
+}
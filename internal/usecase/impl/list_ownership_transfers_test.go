@@ -0,0 +1,101 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListOwnershipTransfersUseCase_ShouldListTheTransfersWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(newAdminId, name, category)
+
+	transfer := entity.NewOwnershipTransfer(room.Id(), adminId, newAdminId, adminId)
+
+	ctx := context.Background()
+	input := &usecase.ListOwnershipTransfersUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: adminId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	ownershipTransferRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.RoomId, i.Value())
+		}).
+		Return([]*entity.OwnershipTransfer{transfer}, nil).
+		Once()
+
+	useCase := NewListOwnershipTransfersUseCase(roomRepository, memberRepository, banRepository, ownershipTransferRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(output))
+	assert.Equal(t, transfer.Id().Value(), output[0].Id)
+	assert.Equal(t, adminId.Value(), output[0].PreviousAdminId)
+	assert.Equal(t, newAdminId.Value(), output[0].AdminId)
+	assert.Equal(t, adminId.Value(), output[0].TransferredBy)
+	assert.Equal(t, transfer.TransferredAt().Value(), output[0].TransferredAt)
+}
+
+func TestListOwnershipTransfersUseCase_ShouldReturnAnErrorWhenThePrivateRoomIsNotVisible(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	visibility, _ := valueobject.NewRoomVisibilityWith(valueobject.RoomVisibilityPrivate)
+	room := entity.NewRoom(adminId, name, category)
+	room.UpdateVisibility(visibility)
+
+	ctx := context.Background()
+	input := &usecase.ListOwnershipTransfersUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewListOwnershipTransfersUseCase(roomRepository, memberRepository, banRepository, ownershipTransferRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
@@ -0,0 +1,99 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListPinsUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	banRepository    repository.BanRepository
+	pinRepository    repository.PinRepository
+	logger           *log.Logger
+}
+
+func NewListPinsUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	pinRepository repository.PinRepository,
+) *ListPinsUseCase {
+	return &ListPinsUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		banRepository:    banRepository,
+		pinRepository:    pinRepository,
+		logger:           log.NewLogger("ListPinsUseCase"),
+	}
+}
+
+// Execute lists the pinned messages of the room from the last pinned.
+func (u *ListPinsUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListPinsUseCaseInput,
+) ([]*usecase.ListPinsUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	pinnedMessages, err := u.pinRepository.ListByRoom(ctx, roomId)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	output := make([]*usecase.ListPinsUseCaseOutput, 0, len(pinnedMessages))
+
+	for _, p := range pinnedMessages {
+		message := p.Message()
+
+		output = append(output, &usecase.ListPinsUseCaseOutput{
+			MessageId:  message.Id().Value(),
+			SenderId:   message.SenderId().Value(),
+			SenderName: message.SenderName().Value(),
+			Text:       messageTextValue(message),
+			CreatedAt:  message.CreatedAt().Value(),
+			EditedAt:   timestampValue(message.EditedAt()),
+			DeletedAt:  timestampValue(message.DeletedAt()),
+			PinnedBy:   p.Pin().PinnedBy().Value(),
+			PinnedAt:   p.Pin().CreatedAt().Value(),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,63 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListPinsUseCase_ShouldListThePinnedMessagesWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+	pin := entity.NewPin(room.Id(), message.Id(), adminId)
+
+	ctx := context.Background()
+	input := &usecase.ListPinsUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: adminId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	pinRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything).
+		Return([]*entity.PinnedMessage{entity.NewPinnedMessageWith(pin, message)}, nil).
+		Once()
+
+	useCase := NewListPinsUseCase(roomRepository, memberRepository, banRepository, pinRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Len(t, output, 1)
+	assert.Equal(t, message.Id().Value(), output[0].MessageId)
+	assert.Equal(t, message.Text().Value(), output[0].Text)
+	assert.Equal(t, adminId.Value(), output[0].PinnedBy)
+	assert.Equal(t, pin.CreatedAt().Value(), output[0].PinnedAt)
+}
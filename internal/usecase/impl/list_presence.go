@@ -0,0 +1,97 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListPresenceUseCase struct {
+	roomRepository     repository.RoomRepository
+	memberRepository   repository.MemberRepository
+	banRepository      repository.BanRepository
+	presenceRepository repository.PresenceRepository
+	logger             *log.Logger
+}
+
+func NewListPresenceUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	presenceRepository repository.PresenceRepository,
+) *ListPresenceUseCase {
+	return &ListPresenceUseCase{
+		roomRepository:     roomRepository,
+		memberRepository:   memberRepository,
+		banRepository:      banRepository,
+		presenceRepository: presenceRepository,
+		logger:             log.NewLogger("ListPresenceUseCase"),
+	}
+}
+
+// Execute lists the users that are online or away in the room. The users that
+// are not listed are offline.
+func (u *ListPresenceUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListPresenceUseCaseInput,
+) ([]*usecase.ListPresenceUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	presences, err := u.presenceRepository.ListByRoom(ctx, room.Id())
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	output := make([]*usecase.ListPresenceUseCaseOutput, 0, len(presences))
+
+	for _, p := range presences {
+		// Expired sessions are removed periodically, skip them meanwhile.
+		if p.IsExpired() {
+			continue
+		}
+
+		output = append(output, &usecase.ListPresenceUseCaseOutput{
+			UserId: p.UserId().Value(),
+			Status: p.Status().Value(),
+			SeenAt: p.SeenAt().Value(),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,102 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListPresenceUseCase_ShouldListTheUsersThatAreNotOffline(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	away, _ := valueobject.NewPresenceStatusWith(valueobject.PresenceStatusAway)
+
+	online := entity.NewPresence(room.Id(), adminId, "", valueobject.NewPresenceStatus())
+	expired := entity.NewPresenceWith(room.Id(), userId, "", away, valueobject.NewTimestamp().Add(-entity.PresenceTimeout))
+
+	ctx := context.Background()
+	input := &usecase.ListPresenceUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: userId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	presenceRepository := mocks.NewPresenceRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	presenceRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id) {
+			assert.Equal(t, input.RoomId, r.Value())
+		}).
+		Return([]*entity.Presence{online, expired}, nil).
+		Once()
+
+	useCase := NewListPresenceUseCase(roomRepository, memberRepository, banRepository, presenceRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Len(t, output, 1)
+	assert.Equal(t, adminId.Value(), output[0].UserId)
+	assert.Equal(t, valueobject.PresenceStatusOnline, output[0].Status)
+	assert.Equal(t, online.SeenAt().Value(), output[0].SeenAt)
+}
+
+func TestListPresenceUseCase_ShouldReturnAnErrorWhenThePrivateRoomIsNotVisible(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	visibility, _ := valueobject.NewRoomVisibilityWith(valueobject.RoomVisibilityPrivate)
+	room := entity.NewRoom(adminId, name, category)
+	room.UpdateVisibility(visibility)
+
+	ctx := context.Background()
+	input := &usecase.ListPresenceUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	presenceRepository := mocks.NewPresenceRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewListPresenceUseCase(roomRepository, memberRepository, banRepository, presenceRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundRoom)
+}
@@ -0,0 +1,122 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListRepliesUseCase struct {
+	roomRepository    repository.RoomRepository
+	memberRepository  repository.MemberRepository
+	banRepository     repository.BanRepository
+	messageRepository repository.MessageRepository
+	logger            *log.Logger
+}
+
+func NewListRepliesUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	messageRepository repository.MessageRepository,
+) *ListRepliesUseCase {
+	return &ListRepliesUseCase{
+		roomRepository:    roomRepository,
+		memberRepository:  memberRepository,
+		banRepository:     banRepository,
+		messageRepository: messageRepository,
+		logger:            log.NewLogger("ListRepliesUseCase"),
+	}
+}
+
+func (u *ListRepliesUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListRepliesUseCaseInput,
+) (*pagination.CursorPage[*usecase.ListRepliesUseCaseOutput], error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := pagination.NewCursorQuery(input.Cursor, input.Size, input.Direction)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	err = checkRoomAccess(ctx, u.memberRepository, u.banRepository, room, userId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	parent, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if parent.RoomId().Value() != room.Id().Value() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	page, err := u.messageRepository.ListReplies(ctx, messageId, query)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	mapper := func(m *entity.Message) *usecase.ListRepliesUseCaseOutput {
+		return &usecase.ListRepliesUseCaseOutput{
+			Id:         m.Id().Value(),
+			RoomId:     m.RoomId().Value(),
+			ParentId:   idValue(m.ParentId()),
+			SenderId:   m.SenderId().Value(),
+			SenderName: m.SenderName().Value(),
+			Text:       messageTextValue(m),
+			CreatedAt:  m.CreatedAt().Value(),
+			EditedAt:   timestampValue(m.EditedAt()),
+			DeletedAt:  timestampValue(m.DeletedAt()),
+			Reactions:  reactionValues(m),
+		}
+	}
+
+	output := pagination.MapCursorPage[*entity.Message, *usecase.ListRepliesUseCaseOutput](page, mapper)
+
+	return output, nil
+}
@@ -0,0 +1,168 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListRepliesUseCase_ShouldReturnAPageWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	parent := entity.NewMessage(room.Id(), adminId, senderName, text)
+	reply := entity.NewMessage(room.Id(), adminId, senderName, text)
+	_ = reply.ReplyTo(parent)
+
+	ctx := context.Background()
+	input := &usecase.ListRepliesUseCaseInput{
+		UserId:    adminId.Value(),
+		RoomId:    room.Id().Value(),
+		MessageId: parent.Id().Value(),
+		Size:      "5",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(parent, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		ListReplies(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id, q *pagination.CursorQuery) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.MessageId, i.Value())
+			assert.Equal(t, 5, q.Size())
+		}).
+		Return(&pagination.CursorPage[*entity.Message]{
+			Size:  5,
+			Items: []*entity.Message{reply},
+		}, nil).
+		Once()
+
+	useCase := NewListRepliesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.NotNil(t, output)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(output.Items))
+	assert.Equal(t, reply.Id().Value(), output.Items[0].Id)
+	assert.Equal(t, parent.Id().Value(), output.Items[0].ParentId)
+	assert.Equal(t, reply.Text().Value(), output.Items[0].Text)
+}
+
+func TestListRepliesUseCase_ShouldReturnAnErrorWhenTheParentIsNotInTheRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	otherRoom := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	parent := entity.NewMessage(otherRoom.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.ListRepliesUseCaseInput{
+		UserId:    adminId.Value(),
+		RoomId:    room.Id().Value(),
+		MessageId: parent.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	banRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundBan).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(parent, nil).
+		Once()
+
+	useCase := NewListRepliesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
+
+func TestListRepliesUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test  string
+		input *usecase.ListRepliesUseCaseInput
+		err   error
+	}{
+		{
+			"empty message id",
+			&usecase.ListRepliesUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+			},
+			valueobject.ErrRequiredId,
+		},
+		{
+			"invalid size",
+			&usecase.ListRepliesUseCaseInput{
+				UserId:    "auth0|64c8457bb160e37c8c34533b",
+				RoomId:    "b3588483-4795-434a-877c-dcd158d6caa7",
+				MessageId: "b3588483-4795-434a-877c-dcd158d6caa8",
+				Size:      "51",
+			},
+			pagination.ErrInvalidQuerySize,
+		},
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	banRepository := mocks.NewBanRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	useCase := NewListRepliesUseCase(roomRepository, memberRepository, banRepository, messageRepository)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			output, err := useCase.Execute(ctx, tc.input)
+			assert.Nil(t, output)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type ListUserRoomsUseCase struct {
+	userRoomRepository repository.UserRoomRepository
+	logger             *log.Logger
+}
+
+func NewListUserRoomsUseCase(userRoomRepository repository.UserRoomRepository) *ListUserRoomsUseCase {
+	return &ListUserRoomsUseCase{
+		userRoomRepository: userRoomRepository,
+		logger:             log.NewLogger("ListUserRoomsUseCase"),
+	}
+}
+
+func (u *ListUserRoomsUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ListUserRoomsUseCaseInput,
+) (*pagination.Page[*usecase.ListUserRoomsUseCaseOutput], error) {
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := pagination.NewQuery(input.Page, input.Size, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.userRoomRepository.ListByUser(ctx, userId, query)
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	mapper := func(r *entity.UserRoom) *usecase.ListUserRoomsUseCaseOutput {
+		output := &usecase.ListUserRoomsUseCaseOutput{
+			RoomId:         r.Room().Id().Value(),
+			Name:           r.Room().Name().Value(),
+			Category:       r.Room().Category().Value(),
+			Visibility:     r.Room().Visibility().Value(),
+			Role:           r.Member().Role().Value(),
+			LastActivityAt: r.LastActivityAt().Value(),
+			UnreadCount:    r.UnreadCount(),
+		}
+
+		if message := r.LastMessage(); message != nil {
+			output.LastMessage = &usecase.LastMessageOutput{
+				MessageId:  message.Id().Value(),
+				SenderId:   message.SenderId().Value(),
+				SenderName: message.SenderName().Value(),
+				Text:       messageTextValue(message),
+				CreatedAt:  message.CreatedAt().Value(),
+				EditedAt:   timestampValue(message.EditedAt()),
+				DeletedAt:  timestampValue(message.DeletedAt()),
+			}
+		}
+
+		return output
+	}
+
+	output := pagination.MapPage[*entity.UserRoom, *usecase.ListUserRoomsUseCaseOutput](page, mapper)
+
+	return output, nil
+}
@@ -0,0 +1,88 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListUserRoomsUseCase_ShouldListTheRoomsWithTheirUnreadCount(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+
+	activeRoom := entity.NewRoom(senderId, name, category)
+	message := entity.NewMessage(activeRoom.Id(), senderId, senderName, text)
+	activeUserRoom := entity.NewUserRoomWith(activeRoom, entity.NewMember(activeRoom.Id(), userId), message, 2)
+
+	emptyRoom := entity.NewRoom(userId, name, category)
+	emptyUserRoom := entity.NewUserRoomWith(emptyRoom, entity.NewRoomOwner(emptyRoom.Id(), userId), nil, 0)
+
+	ctx := context.Background()
+	input := &usecase.ListUserRoomsUseCaseInput{
+		UserId: userId.Value(),
+		Page:   "0",
+		Size:   "10",
+	}
+
+	userRoomRepository := mocks.NewUserRoomRepositoryMock(t)
+
+	userRoomRepository.EXPECT().
+		ListByUser(mock.Anything, userId, mock.Anything).
+		Run(func(c context.Context, u *valueobject.UserId, q *pagination.Query) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, 0, q.Page())
+			assert.Equal(t, 10, q.Size())
+		}).
+		Return(pagination.NewPage[*entity.UserRoom](0, 10, 2, []*entity.UserRoom{activeUserRoom, emptyUserRoom}), nil).
+		Once()
+
+	useCase := NewListUserRoomsUseCase(userRoomRepository)
+
+	page, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), page.Total)
+	assert.Len(t, page.Items, 2)
+
+	assert.Equal(t, activeRoom.Id().Value(), page.Items[0].RoomId)
+	assert.Equal(t, name.Value(), page.Items[0].Name)
+	assert.Equal(t, category.Value(), page.Items[0].Category)
+	assert.Equal(t, valueobject.RoomVisibilityPublic, page.Items[0].Visibility)
+	assert.Equal(t, valueobject.MemberRoleMember, page.Items[0].Role)
+	assert.Equal(t, message.CreatedAt().Value(), page.Items[0].LastActivityAt)
+	assert.Equal(t, int64(2), page.Items[0].UnreadCount)
+	assert.Equal(t, message.Id().Value(), page.Items[0].LastMessage.MessageId)
+	assert.Equal(t, "A text", page.Items[0].LastMessage.Text)
+
+	assert.Equal(t, emptyRoom.Id().Value(), page.Items[1].RoomId)
+	assert.Equal(t, valueobject.MemberRoleOwner, page.Items[1].Role)
+	assert.Equal(t, emptyRoom.UpdatedAt().Value(), page.Items[1].LastActivityAt)
+	assert.Equal(t, int64(0), page.Items[1].UnreadCount)
+	assert.Nil(t, page.Items[1].LastMessage)
+}
+
+func TestListUserRoomsUseCase_ShouldReturnAnErrorWhenTheQueryIsInvalid(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.ListUserRoomsUseCaseInput{
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		Size:   "100",
+	}
+
+	userRoomRepository := mocks.NewUserRoomRepositoryMock(t)
+	useCase := NewListUserRoomsUseCase(userRoomRepository)
+
+	page, err := useCase.Execute(ctx, input)
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, pagination.ErrInvalidQuerySize)
+}
@@ -0,0 +1,112 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type MarkRoomAsReadUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	messageRepository   repository.MessageRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewMarkRoomAsReadUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	messageRepository repository.MessageRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *MarkRoomAsReadUseCase {
+	return &MarkRoomAsReadUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		messageRepository:   messageRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("MarkRoomAsReadUseCase"),
+	}
+}
+
+// Execute moves the last read message of the member forward. The receipt is
+// sent only when it moved.
+func (u *MarkRoomAsReadUseCase) Execute(ctx context.Context, input *usecase.MarkRoomAsReadUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	member, err := u.memberRepository.FindByRoomAndUser(ctx, roomId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return entity.ErrNotRoomMember
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	message, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if message.RoomId().Value() != room.Id().Value() {
+		return repository.ErrNotFoundMessage
+	}
+
+	member.MarkAsRead(message.Id())
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		changed, err := u.memberRepository.UpdateLastRead(ctx, member)
+		if err != nil || !changed {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewReadReceiptEvent(member))
+	})
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
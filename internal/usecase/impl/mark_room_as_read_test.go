@@ -0,0 +1,236 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMarkRoomAsReadUseCase_ShouldUpdateTheLastReadMessageWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	member := entity.NewMember(room.Id(), userId)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.MarkRoomAsReadUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    userId.Value(),
+		MessageId: message.Id().Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.RoomId, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(member, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	memberRepository.EXPECT().
+		UpdateLastRead(mock.Anything, member).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, message.Id().Value(), m.LastReadMessageId().Value())
+		}).
+		Return(true, nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, event.MessageRead, e.Type)
+			assert.Equal(t, message.Id().Value(), e.Id)
+			assert.Equal(t, room.Id().Value(), e.RoomId)
+			assert.Equal(t, userId.Value(), e.SenderId)
+			assert.NotEmpty(t, e.CreatedAt)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewMarkRoomAsReadUseCase(transactionManager, roomRepository, memberRepository, messageRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestMarkRoomAsReadUseCase_ShouldNotSendAReceiptWhenTheLastReadMessageDidNotChange(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	member := entity.NewMember(room.Id(), userId)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.MarkRoomAsReadUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    userId.Value(),
+		MessageId: message.Id().Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.RoomId, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(member, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	memberRepository.EXPECT().
+		UpdateLastRead(mock.Anything, member).
+		Return(false, nil).
+		Once()
+
+	useCase := NewMarkRoomAsReadUseCase(transactionManager, roomRepository, memberRepository, messageRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestMarkRoomAsReadUseCase_ShouldReturnAnErrorWhenTheUserIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.MarkRoomAsReadUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    "auth0|64c8457bb160e37c8c34533c",
+		MessageId: valueobject.NewId().Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewMarkRoomAsReadUseCase(transactionManager, roomRepository, memberRepository, messageRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrNotRoomMember)
+}
+
+func TestMarkRoomAsReadUseCase_ShouldReturnAnErrorWhenTheMessageIsFromAnotherRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(valueobject.NewId(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.MarkRoomAsReadUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    adminId.Value(),
+		MessageId: message.Id().Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	useCase := NewMarkRoomAsReadUseCase(transactionManager, roomRepository, memberRepository, messageRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
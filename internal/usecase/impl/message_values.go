@@ -0,0 +1,49 @@
+package impl
+
+import (
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+)
+
+// timestampValue returns the value of an optional timestamp.
+func timestampValue(timestamp *valueobject.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+
+	return timestamp.Value()
+}
+
+// idValue returns the value of an optional id.
+func idValue(id *valueobject.Id) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.Value()
+}
+
+// reactionValues returns the reaction counts of the message.
+func reactionValues(message *entity.Message) []*usecase.MessageReactionOutput {
+	reactions := make([]*usecase.MessageReactionOutput, len(message.Reactions()))
+
+	for i, r := range message.Reactions() {
+		reactions[i] = &usecase.MessageReactionOutput{
+			Emoji: r.Emoji().Value(),
+			Count: r.Count(),
+		}
+	}
+
+	return reactions
+}
+
+// messageTextValue returns the message text, or an empty text for a deleted
+// message so it is listed as a tombstone.
+func messageTextValue(message *entity.Message) string {
+	if message.IsDeleted() {
+		return ""
+	}
+
+	return message.Text().Value()
+}
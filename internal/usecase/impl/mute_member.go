@@ -0,0 +1,104 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type MuteMemberUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	muteRepository   repository.MuteRepository
+	logger           *log.Logger
+}
+
+func NewMuteMemberUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	muteRepository repository.MuteRepository,
+) *MuteMemberUseCase {
+	return &MuteMemberUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		muteRepository:   muteRepository,
+		logger:           log.NewLogger("MuteMemberUseCase"),
+	}
+}
+
+func (u *MuteMemberUseCase) Execute(ctx context.Context, input *usecase.MuteMemberUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	memberId, err := valueobject.NewUserIdWith(input.MemberId)
+	if err != nil {
+		return err
+	}
+
+	expiresAt, err := valueobject.NewTimestampWith(input.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	moderator, err := checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionMuteMember)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	member, err := u.memberRepository.FindByRoomAndUser(ctx, room.Id(), memberId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMember) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = moderator.ValidateModeration(member)
+	if err != nil {
+		return err
+	}
+
+	mute, err := entity.NewMute(room.Id(), memberId, userId, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	err = u.muteRepository.Save(ctx, mute)
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
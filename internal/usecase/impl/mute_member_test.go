@@ -0,0 +1,142 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMuteMemberUseCase_ShouldMuteTheMemberWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	expiresAt := valueobject.NewTimestamp().Add(time.Hour)
+
+	ctx := context.Background()
+	input := &usecase.MuteMemberUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    adminId.Value(),
+		MemberId:  memberId.Value(),
+		ExpiresAt: expiresAt.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(entity.NewMember(room.Id(), memberId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Mute) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, room.Id().Value(), m.RoomId().Value())
+			assert.Equal(t, memberId.Value(), m.UserId().Value())
+			assert.Equal(t, adminId.Value(), m.MutedBy().Value())
+			assert.Equal(t, expiresAt.Value(), m.ExpiresAt().Value())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewMuteMemberUseCase(roomRepository, memberRepository, muteRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestMuteMemberUseCase_ShouldReturnAnErrorWhenTheExpirationIsInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test      string
+		expiresAt string
+		err       error
+	}{
+		{"empty expiration", "", valueobject.ErrRequiredTimestamp},
+		{"invalid expiration", "tomorrow", valueobject.ErrInvalidTimestamp},
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+
+	useCase := NewMuteMemberUseCase(roomRepository, memberRepository, muteRepository)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			err := useCase.Execute(ctx, &usecase.MuteMemberUseCaseInput{
+				RoomId:    "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId:    "auth0|64c8457bb160e37c8c34533b",
+				MemberId:  "auth0|64c8457bb160e37c8c34533c",
+				ExpiresAt: tc.expiresAt,
+			})
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestMuteMemberUseCase_ShouldReturnAnErrorWhenTheMemberIsNotFound(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.MuteMemberUseCaseInput{
+		RoomId:    room.Id().Value(),
+		UserId:    adminId.Value(),
+		MemberId:  memberId.Value(),
+		ExpiresAt: valueobject.NewTimestamp().Add(time.Hour).Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewMuteMemberUseCase(roomRepository, memberRepository, muteRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMember)
+}
@@ -0,0 +1,130 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type OpenDirectRoomUseCase struct {
+	transactionManager   repository.TransactionManager
+	roomRepository       repository.RoomRepository
+	memberRepository     repository.MemberRepository
+	directRoomRepository repository.DirectRoomRepository
+	logger               *log.Logger
+}
+
+func NewOpenDirectRoomUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	directRoomRepository repository.DirectRoomRepository,
+) *OpenDirectRoomUseCase {
+	return &OpenDirectRoomUseCase{
+		transactionManager:   transactionManager,
+		roomRepository:       roomRepository,
+		memberRepository:     memberRepository,
+		directRoomRepository: directRoomRepository,
+		logger:               log.NewLogger("OpenDirectRoomUseCase"),
+	}
+}
+
+func (u *OpenDirectRoomUseCase) Execute(
+	ctx context.Context,
+	input *usecase.OpenDirectRoomUseCaseInput,
+) (*usecase.OpenDirectRoomUseCaseOutput, error) {
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientId, err := valueobject.NewUserIdWith(input.RecipientId)
+	if err != nil {
+		return nil, err
+	}
+
+	directRoom, err := entity.NewDirectRoom(userId, recipientId)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := u.find(ctx, userId, recipientId)
+	if err != nil || output != nil {
+		return output, err
+	}
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.roomRepository.Save(ctx, directRoom.Room())
+		if err != nil {
+			return err
+		}
+
+		for _, member := range directRoom.Members() {
+			err := u.memberRepository.Save(ctx, member)
+			if err != nil {
+				return err
+			}
+		}
+
+		return u.directRoomRepository.Save(ctx, directRoom)
+	})
+	if err != nil {
+		// A concurrent request for the same users was saved first.
+		if errors.Is(err, repository.ErrDuplicateDirectRoom) {
+			output, err := u.find(ctx, userId, recipientId)
+			if err == nil && output == nil {
+				err = repository.ErrDuplicateDirectRoom
+			}
+
+			return output, err
+		}
+
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	return &usecase.OpenDirectRoomUseCaseOutput{
+		RoomId:      directRoom.Room().Id().Value(),
+		RecipientId: recipientId.Value(),
+		CreatedAt:   directRoom.Room().CreatedAt().Value(),
+		Created:     true,
+	}, nil
+}
+
+// find returns the existing direct room of the users, or nil when there is
+// none. The user joins the room again when it has left it.
+func (u *OpenDirectRoomUseCase) find(
+	ctx context.Context,
+	userId *valueobject.UserId,
+	recipientId *valueobject.UserId,
+) (*usecase.OpenDirectRoomUseCaseOutput, error) {
+
+	directRoom, err := u.directRoomRepository.FindByUsers(ctx, userId, recipientId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundDirectRoom) {
+			return nil, nil
+		}
+
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	err = u.memberRepository.Save(ctx, entity.NewMember(directRoom.Room().Id(), userId))
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	return &usecase.OpenDirectRoomUseCaseOutput{
+		RoomId:      directRoom.Room().Id().Value(),
+		RecipientId: recipientId.Value(),
+		CreatedAt:   directRoom.Room().CreatedAt().Value(),
+		Created:     false,
+	}, nil
+}
@@ -0,0 +1,208 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOpenDirectRoomUseCase_ShouldCreateADirectRoomWhenTheUsersHaveNone(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	recipientId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	var roomCreated *entity.Room
+	var membersCreated []string
+
+	ctx := context.Background()
+	input := &usecase.OpenDirectRoomUseCaseInput{
+		UserId:      userId.Value(),
+		RecipientId: recipientId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	directRoomRepository := mocks.NewDirectRoomRepositoryMock(t)
+
+	directRoomRepository.EXPECT().
+		FindByUsers(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundDirectRoom).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *entity.Room) {
+			assert.True(t, r.IsDirect())
+			roomCreated = r
+		}).
+		Return(nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, roomCreated.Id().Value(), m.RoomId().Value())
+			assert.False(t, m.IsOwner())
+			membersCreated = append(membersCreated, m.UserId().Value())
+		}).
+		Return(nil).
+		Twice()
+
+	directRoomRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, d *entity.DirectRoom) {
+			assert.Equal(t, roomCreated, d.Room())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewOpenDirectRoomUseCase(transactionManager, roomRepository, memberRepository, directRoomRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.True(t, output.Created)
+	assert.Equal(t, roomCreated.Id().Value(), output.RoomId)
+	assert.Equal(t, recipientId.Value(), output.RecipientId)
+	assert.Equal(t, roomCreated.CreatedAt().Value(), output.CreatedAt)
+	assert.ElementsMatch(t, []string{userId.Value(), recipientId.Value()}, membersCreated)
+}
+
+func TestOpenDirectRoomUseCase_ShouldReturnTheExistingDirectRoom(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	recipientId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	directRoom, _ := entity.NewDirectRoom(recipientId, userId)
+
+	ctx := context.Background()
+	input := &usecase.OpenDirectRoomUseCaseInput{
+		UserId:      userId.Value(),
+		RecipientId: recipientId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	directRoomRepository := mocks.NewDirectRoomRepositoryMock(t)
+
+	directRoomRepository.EXPECT().
+		FindByUsers(mock.Anything, userId, recipientId).
+		Return(directRoom, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, directRoom.Room().Id().Value(), m.RoomId().Value())
+			assert.Equal(t, userId.Value(), m.UserId().Value())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewOpenDirectRoomUseCase(transactionManager, roomRepository, memberRepository, directRoomRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.False(t, output.Created)
+	assert.Equal(t, directRoom.Room().Id().Value(), output.RoomId)
+	assert.Equal(t, recipientId.Value(), output.RecipientId)
+}
+
+func TestOpenDirectRoomUseCase_ShouldReturnTheConcurrentlyCreatedDirectRoom(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	recipientId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	directRoom, _ := entity.NewDirectRoom(recipientId, userId)
+
+	ctx := context.Background()
+	input := &usecase.OpenDirectRoomUseCaseInput{
+		UserId:      userId.Value(),
+		RecipientId: recipientId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	directRoomRepository := mocks.NewDirectRoomRepositoryMock(t)
+
+	directRoomRepository.EXPECT().
+		FindByUsers(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundDirectRoom).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(repository.ErrDuplicateDirectRoom).
+		Once()
+
+	directRoomRepository.EXPECT().
+		FindByUsers(mock.Anything, mock.Anything, mock.Anything).
+		Return(directRoom, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	useCase := NewOpenDirectRoomUseCase(transactionManager, roomRepository, memberRepository, directRoomRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.False(t, output.Created)
+	assert.Equal(t, directRoom.Room().Id().Value(), output.RoomId)
+}
+
+func TestOpenDirectRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test  string
+		input *usecase.OpenDirectRoomUseCaseInput
+		err   error
+	}{
+		{
+			"empty recipient id",
+			&usecase.OpenDirectRoomUseCaseInput{
+				UserId:      "auth0|64c8457bb160e37c8c34533b",
+				RecipientId: "",
+			},
+			valueobject.ErrRequiredUserId,
+		},
+		{
+			"same user",
+			&usecase.OpenDirectRoomUseCaseInput{
+				UserId:      "auth0|64c8457bb160e37c8c34533b",
+				RecipientId: "auth0|64c8457bb160e37c8c34533b",
+			},
+			entity.ErrInvalidDirectRoomUser,
+		},
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	directRoomRepository := mocks.NewDirectRoomRepositoryMock(t)
+
+	useCase := NewOpenDirectRoomUseCase(transactionManager, roomRepository, memberRepository, directRoomRepository)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			output, err := useCase.Execute(ctx, tc.input)
+			assert.Nil(t, output)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
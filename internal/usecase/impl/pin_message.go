@@ -0,0 +1,154 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type PinMessageUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	messageRepository   repository.MessageRepository
+	pinRepository       repository.PinRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewPinMessageUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	messageRepository repository.MessageRepository,
+	pinRepository repository.PinRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *PinMessageUseCase {
+	return &PinMessageUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		messageRepository:   messageRepository,
+		pinRepository:       pinRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("PinMessageUseCase"),
+	}
+}
+
+// Execute pins the message to the room. Pinning a pinned message does nothing.
+// The room is locked before its pins are counted, so concurrent pins cannot
+// exceed the limit.
+func (u *PinMessageUseCase) Execute(ctx context.Context, input *usecase.PinMessageUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	_, err = checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionPinMessage)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	message, err := u.messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if message.RoomId().Value() != room.Id().Value() {
+		return repository.ErrNotFoundMessage
+	}
+
+	if message.IsDeleted() {
+		return entity.ErrMessageAlreadyDeleted
+	}
+
+	pin := entity.NewPin(roomId, messageId, userId)
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.roomRepository.Lock(ctx, roomId)
+		if err != nil {
+			return err
+		}
+
+		// The message is read again under the lock, a deletion of the message
+		// holds the same lock.
+		message, err := u.messageRepository.FindById(ctx, messageId)
+		if err != nil {
+			return err
+		}
+
+		if message.IsDeleted() {
+			return entity.ErrMessageAlreadyDeleted
+		}
+
+		err = u.pinRepository.Save(ctx, pin)
+		if err != nil {
+			return err
+		}
+
+		pins, err := u.pinRepository.CountByRoom(ctx, roomId)
+		if err != nil {
+			return err
+		}
+
+		err = entity.ValidatePinLimit(pins)
+		if err != nil {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewMessagePinnedEvent(pin))
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicatePin) {
+			return nil
+		}
+
+		if errors.Is(err, entity.ErrRoomPinLimit) || errors.Is(err, entity.ErrMessageAlreadyDeleted) {
+			return err
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPinMessageUseCase_ShouldPinTheMessageWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.PinMessageUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Twice()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.RoomId, i.Value())
+		}).
+		Return(nil).
+		Once()
+
+	pinRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, p *entity.Pin) {
+			assert.Equal(t, input.RoomId, p.RoomId().Value())
+			assert.Equal(t, input.MessageId, p.MessageId().Value())
+			assert.Equal(t, input.UserId, p.PinnedBy().Value())
+		}).
+		Return(nil).
+		Once()
+
+	pinRepository.EXPECT().
+		CountByRoom(mock.Anything, mock.Anything).
+		Return(int64(1), nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, event.MessagePinned, e.Type)
+			assert.Equal(t, input.MessageId, e.Id)
+			assert.Equal(t, input.RoomId, e.RoomId)
+			assert.Equal(t, input.UserId, e.SenderId)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewPinMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestPinMessageUseCase_ShouldNotSendAnEventWhenTheMessageWasPinned(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.PinMessageUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Twice()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.RoomId, i.Value())
+		}).
+		Return(nil).
+		Once()
+
+	pinRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Return(repository.ErrDuplicatePin).
+		Once()
+
+	useCase := NewPinMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestPinMessageUseCase_ShouldReturnAnErrorWhenTheRoomHasTooManyPins(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.PinMessageUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Twice()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.RoomId, i.Value())
+		}).
+		Return(nil).
+		Once()
+
+	pinRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	pinRepository.EXPECT().
+		CountByRoom(mock.Anything, mock.Anything).
+		Return(int64(entity.MaxRoomPins+1), nil).
+		Once()
+
+	useCase := NewPinMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrRoomPinLimit)
+}
+
+func TestPinMessageUseCase_ShouldReturnAnErrorWhenTheMessageIsDeletedBeforeTheRoomIsLocked(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	deleted := entity.NewMessage(room.Id(), adminId, senderName, text)
+	deleted.Delete()
+
+	ctx := context.Background()
+	input := &usecase.PinMessageUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(deleted, nil).
+		Once()
+
+	useCase := NewPinMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrMessageAlreadyDeleted)
+}
+
+func TestPinMessageUseCase_ShouldReturnAnErrorWhenTheUserIsNotTheOwner(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.PinMessageUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: valueobject.NewId().Value(),
+		UserId:    userId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	pinRepository := mocks.NewPinRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), userId), nil).
+		Once()
+
+	useCase := NewPinMessageUseCase(transactionManager, roomRepository, memberRepository, messageRepository, pinRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrMissingPermission)
+}
@@ -0,0 +1,169 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPromoteMemberUseCase_ShouldPromoteTheMemberWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	member := entity.NewMember(room.Id(), memberId)
+
+	ctx := context.Background()
+	input := &usecase.PromoteMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   adminId.Value(),
+		MemberId: memberId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(member, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Update(mock.Anything, member).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, valueobject.MemberRoleModerator, m.Role().Value())
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewPromoteMemberUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestPromoteMemberUseCase_ShouldReturnAnErrorWhenTheUserCannotManageRoles(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.PromoteMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   userId.Value(),
+		MemberId: "auth0|64c8457bb160e37c8c34533d",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), userId), nil).
+		Once()
+
+	useCase := NewPromoteMemberUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrMissingPermission)
+}
+
+func TestPromoteMemberUseCase_ShouldReturnAnErrorWhenTheMemberCannotBePromoted(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.PromoteMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   adminId.Value(),
+		MemberId: adminId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Twice()
+
+	useCase := NewPromoteMemberUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrInvalidMemberPromotion)
+}
+
+func TestPromoteMemberUseCase_ShouldReturnAnErrorWhenTheMemberIsNotFound(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	memberId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.PromoteMemberUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   adminId.Value(),
+		MemberId: memberId.Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(entity.NewRoomOwner(room.Id(), adminId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, memberId).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewPromoteMemberUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMember)
+}
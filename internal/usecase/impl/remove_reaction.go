@@ -0,0 +1,103 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type RemoveReactionUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	messageRepository   repository.MessageRepository
+	reactionRepository  repository.ReactionRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewRemoveReactionUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	messageRepository repository.MessageRepository,
+	reactionRepository repository.ReactionRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *RemoveReactionUseCase {
+	return &RemoveReactionUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		messageRepository:   messageRepository,
+		reactionRepository:  reactionRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("RemoveReactionUseCase"),
+	}
+}
+
+// Execute removes the reaction of the user from the message. Removing a
+// reaction the user has not added does nothing.
+func (u *RemoveReactionUseCase) Execute(ctx context.Context, input *usecase.RemoveReactionUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	emoji, err := valueobject.NewEmojiWith(input.Emoji)
+	if err != nil {
+		return err
+	}
+
+	message, err := findMemberMessage(ctx, u.roomRepository, u.memberRepository, u.messageRepository, roomId, messageId, userId)
+	if err != nil {
+		if _, ok := err.(validation.NotFoundError); ok {
+			return err
+		}
+
+		if errors.Is(err, entity.ErrNotRoomMember) || errors.Is(err, entity.ErrMessageAlreadyDeleted) {
+			return err
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	reaction := entity.NewReaction(messageId, userId, emoji)
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.reactionRepository.Delete(ctx, reaction)
+		if err != nil {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewReactionRemovedEvent(message, reaction))
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundReaction) {
+			return nil
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRemoveReactionUseCase_ShouldRemoveTheReactionWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.RemoveReactionUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+		Emoji:     "👍",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	reactionRepository := mocks.NewReactionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), adminId), nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	reactionRepository.EXPECT().
+		Delete(mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *entity.Reaction) {
+			assert.Equal(t, input.MessageId, r.MessageId().Value())
+			assert.Equal(t, input.UserId, r.UserId().Value())
+			assert.Equal(t, input.Emoji, r.Emoji().Value())
+		}).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, event.ReactionRemoved, e.Type)
+			assert.Equal(t, input.MessageId, e.Id)
+			assert.Equal(t, input.Emoji, e.Emoji)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewRemoveReactionUseCase(transactionManager, roomRepository, memberRepository, messageRepository, reactionRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestRemoveReactionUseCase_ShouldNotSendAnEventWhenTheReactionWasNotAdded(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.RemoveReactionUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: message.Id().Value(),
+		UserId:    adminId.Value(),
+		Emoji:     "👍",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	reactionRepository := mocks.NewReactionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), adminId), nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	reactionRepository.EXPECT().
+		Delete(mock.Anything, mock.Anything).
+		Return(repository.ErrNotFoundReaction).
+		Once()
+
+	useCase := NewRemoveReactionUseCase(transactionManager, roomRepository, memberRepository, messageRepository, reactionRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestRemoveReactionUseCase_ShouldReturnAnErrorWhenTheUserIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.RemoveReactionUseCaseInput{
+		RoomId:    room.Id().Value(),
+		MessageId: "b3588483-4795-434a-877c-dcd158d6caa8",
+		UserId:    "auth0|64c8457bb160e37c8c34533c",
+		Emoji:     "👍",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	reactionRepository := mocks.NewReactionRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewRemoveReactionUseCase(transactionManager, roomRepository, memberRepository, messageRepository, reactionRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrNotRoomMember)
+}
@@ -0,0 +1,129 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+const (
+	replayPageSize = 50
+	replayMaxPages = 4
+)
+
+type ReplayMessagesUseCase struct {
+	roomRepository    repository.RoomRepository
+	messageRepository repository.MessageRepository
+	logger            *log.Logger
+}
+
+func NewReplayMessagesUseCase(
+	roomRepository repository.RoomRepository,
+	messageRepository repository.MessageRepository,
+) *ReplayMessagesUseCase {
+	return &ReplayMessagesUseCase{
+		roomRepository:    roomRepository,
+		messageRepository: messageRepository,
+		logger:            log.NewLogger("ReplayMessagesUseCase"),
+	}
+}
+
+// Execute returns the messages of the room sent after the last message, from
+// the oldest to the newest. The replay stops after a few pages and returns the
+// cursor to continue from, so an old last message does not load the whole
+// history of the room.
+func (u *ReplayMessagesUseCase) Execute(
+	ctx context.Context,
+	input *usecase.ReplayMessagesUseCaseInput,
+) (*usecase.ReplayMessagesUseCaseOutput, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	lastMessageId, err := valueobject.NewIdWith(input.LastMessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	lastMessage, err := u.messageRepository.FindById(ctx, lastMessageId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMessage) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
+	if lastMessage.RoomId().Value() != roomId.Value() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	cursor := pagination.NewCursor(lastMessage.CreatedAt(), lastMessage.Id())
+	output := &usecase.ReplayMessagesUseCaseOutput{
+		Messages: make([]*usecase.ReplayedMessageOutput, 0),
+	}
+
+	for pages := 1; ; pages++ {
+		query, err := pagination.NewCursorQuery(cursor.Value(), strconv.Itoa(replayPageSize), pagination.DirectionAfter)
+		if err != nil {
+			u.logger.Error(err)
+			return nil, err
+		}
+
+		page, err := u.messageRepository.ListByRoom(ctx, roomId, query)
+		if err != nil {
+			u.logger.Error(err)
+			return nil, err
+		}
+
+		for i := len(page.Items) - 1; i >= 0; i-- {
+			m := page.Items[i]
+
+			output.Messages = append(output.Messages, &usecase.ReplayedMessageOutput{
+				Id:         m.Id().Value(),
+				RoomId:     m.RoomId().Value(),
+				SenderId:   m.SenderId().Value(),
+				SenderName: m.SenderName().Value(),
+				Text:       messageTextValue(m),
+				CreatedAt:  m.CreatedAt().Value(),
+				EditedAt:   timestampValue(m.EditedAt()),
+				DeletedAt:  timestampValue(m.DeletedAt()),
+				ParentId:   idValue(m.ParentId()),
+			})
+		}
+
+		if !page.More {
+			break
+		}
+
+		cursor = pagination.NewCursor(page.Items[0].CreatedAt(), page.Items[0].Id())
+
+		if pages == replayMaxPages {
+			output.Cursor = cursor.Value()
+			break
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,290 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReplayMessagesUseCase_ShouldReturnTheMessagesAfterTheLastOne(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+
+	messages := make([]*entity.Message, replayPageSize+2)
+	for i := 0; i < len(messages); i++ {
+		text, _ := valueobject.NewMessageTextWith(fmt.Sprintf("A text %d", i))
+		messages[i] = entity.NewMessage(room.Id(), adminId, senderName, text)
+	}
+
+	messages[2].ReplyTo(messages[1])
+
+	newestFirst := func(messages []*entity.Message) []*entity.Message {
+		items := make([]*entity.Message, len(messages))
+		for i := range messages {
+			items[len(messages)-1-i] = messages[i]
+		}
+		return items
+	}
+
+	ctx := context.Background()
+	input := &usecase.ReplayMessagesUseCaseInput{
+		RoomId:        room.Id().Value(),
+		LastMessageId: messages[0].Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.LastMessageId, i.Value())
+		}).
+		Return(messages[0], nil).
+		Once()
+
+	messageRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id, q *pagination.CursorQuery) {
+			assert.Equal(t, messages[0].Id().Value(), q.Cursor().Id().Value())
+			assert.Equal(t, replayPageSize, q.Size())
+			assert.Equal(t, pagination.DirectionAfter, q.Direction())
+		}).
+		Return(&pagination.CursorPage[*entity.Message]{
+			Size:  replayPageSize,
+			Items: newestFirst(messages[1 : replayPageSize+1]),
+			More:  true,
+		}, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id, q *pagination.CursorQuery) {
+			assert.Equal(t, messages[replayPageSize].Id().Value(), q.Cursor().Id().Value())
+		}).
+		Return(&pagination.CursorPage[*entity.Message]{
+			Size:  replayPageSize,
+			Items: newestFirst(messages[replayPageSize+1:]),
+		}, nil).
+		Once()
+
+	useCase := NewReplayMessagesUseCase(roomRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, len(messages)-1, len(output.Messages))
+	assert.Empty(t, output.Cursor)
+
+	for i, o := range output.Messages {
+		assert.Equal(t, messages[i+1].Id().Value(), o.Id)
+		assert.Equal(t, messages[i+1].Text().Value(), o.Text)
+	}
+
+	assert.Empty(t, output.Messages[0].ParentId)
+	assert.Equal(t, messages[1].Id().Value(), output.Messages[1].ParentId)
+}
+
+func TestReplayMessagesUseCase_ShouldStopAtTheReplayLimit(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	lastMessage := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	page := make([]*entity.Message, replayPageSize)
+	for i := range page {
+		page[i] = entity.NewMessage(room.Id(), adminId, senderName, text)
+	}
+
+	ctx := context.Background()
+	input := &usecase.ReplayMessagesUseCaseInput{
+		RoomId:        room.Id().Value(),
+		LastMessageId: lastMessage.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(lastMessage, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything, mock.Anything).
+		Return(&pagination.CursorPage[*entity.Message]{
+			Size:  replayPageSize,
+			Items: page,
+			More:  true,
+		}, nil).
+		Times(replayMaxPages)
+
+	useCase := NewReplayMessagesUseCase(roomRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, replayMaxPages*replayPageSize, len(output.Messages))
+	assert.Equal(t, pagination.NewCursor(page[0].CreatedAt(), page[0].Id()).Value(), output.Cursor)
+}
+
+func TestReplayMessagesUseCase_ShouldNotReturnACursorWhenTheLastPageIsFull(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	lastMessage := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	page := make([]*entity.Message, replayPageSize)
+	for i := range page {
+		page[i] = entity.NewMessage(room.Id(), adminId, senderName, text)
+	}
+
+	ctx := context.Background()
+	input := &usecase.ReplayMessagesUseCaseInput{
+		RoomId:        room.Id().Value(),
+		LastMessageId: lastMessage.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(lastMessage, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything, mock.Anything).
+		Return(&pagination.CursorPage[*entity.Message]{
+			Size:  replayPageSize,
+			Items: page,
+			More:  true,
+		}, nil).
+		Times(replayMaxPages - 1)
+
+	messageRepository.EXPECT().
+		ListByRoom(mock.Anything, mock.Anything, mock.Anything).
+		Return(&pagination.CursorPage[*entity.Message]{
+			Size:  replayPageSize,
+			Items: page,
+		}, nil).
+		Once()
+
+	useCase := NewReplayMessagesUseCase(roomRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, replayMaxPages*replayPageSize, len(output.Messages))
+	assert.Empty(t, output.Cursor)
+}
+
+func TestReplayMessagesUseCase_ShouldReturnAnErrorWhenTheLastMessageIsFromAnotherRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	otherRoom := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	message := entity.NewMessage(otherRoom.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.ReplayMessagesUseCaseInput{
+		RoomId:        room.Id().Value(),
+		LastMessageId: message.Id().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(message, nil).
+		Once()
+
+	useCase := NewReplayMessagesUseCase(roomRepository, messageRepository)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
+
+func TestReplayMessagesUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test  string
+		input *usecase.ReplayMessagesUseCaseInput
+		err   error
+	}{
+		{
+			"invalid room id",
+			&usecase.ReplayMessagesUseCaseInput{
+				RoomId:        "dfaioewurqredfa",
+				LastMessageId: "b3588483-4795-434a-877c-dcd158d6caa7",
+			},
+			valueobject.ErrInvalidId,
+		},
+		{
+			"invalid last message id",
+			&usecase.ReplayMessagesUseCaseInput{
+				RoomId:        "b3588483-4795-434a-877c-dcd158d6caa7",
+				LastMessageId: "dfaioewurqredfa",
+			},
+			valueobject.ErrInvalidId,
+		},
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	useCase := NewReplayMessagesUseCase(roomRepository, messageRepository)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			output, err := useCase.Execute(ctx, tc.input)
+			assert.Nil(t, output)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
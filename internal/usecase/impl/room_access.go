@@ -0,0 +1,250 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// checkRoomVisibility hides a private room from the users that are not its
+// members, so the room is reported as not found to them.
+func checkRoomVisibility(
+	ctx context.Context,
+	memberRepository repository.MemberRepository,
+	room *entity.Room,
+	userId *valueobject.UserId,
+) error {
+
+	if !room.IsPrivate() {
+		return nil
+	}
+
+	_, err := memberRepository.FindByRoomAndUser(ctx, room.Id(), userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return repository.ErrNotFoundRoom
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// checkRoomAccess hides the room from the users that cannot see it or are
+// banned from it. Banned users are not members, so only the bans of public
+// rooms are looked up.
+func checkRoomAccess(
+	ctx context.Context,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+	room *entity.Room,
+	userId *valueobject.UserId,
+) error {
+
+	if room.IsPrivate() {
+		return checkRoomVisibility(ctx, memberRepository, room, userId)
+	}
+
+	err := checkRoomBan(ctx, banRepository, room.Id(), userId)
+	if err != nil {
+		if errors.Is(err, entity.ErrUserBanned) {
+			return repository.ErrNotFoundRoom
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// findRoomInvitation returns the invitation with the token when it belongs
+// to the room and the room was not deleted.
+func findRoomInvitation(
+	ctx context.Context,
+	roomRepository repository.RoomRepository,
+	invitationRepository repository.InvitationRepository,
+	roomId *valueobject.Id,
+	token *valueobject.InvitationToken,
+) (*entity.Invitation, error) {
+
+	invitation, err := invitationRepository.FindByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.RoomId().Value() != roomId.Value() {
+		return nil, repository.ErrNotFoundInvitation
+	}
+
+	room, err := roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	return invitation, nil
+}
+
+// checkMemberPermission returns the membership of the user when its role
+// grants the permission in the room.
+func checkMemberPermission(
+	ctx context.Context,
+	memberRepository repository.MemberRepository,
+	room *entity.Room,
+	userId *valueobject.UserId,
+	permission entity.Permission,
+) (*entity.Member, error) {
+
+	member, err := memberRepository.FindByRoomAndUser(ctx, room.Id(), userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return nil, entity.ErrNotRoomMember
+		}
+
+		return nil, err
+	}
+
+	err = member.ValidatePermission(permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// checkRoomBan rejects the users with an active ban in the room. Expired bans
+// are ignored.
+func checkRoomBan(
+	ctx context.Context,
+	banRepository repository.BanRepository,
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) error {
+
+	ban, err := banRepository.FindByRoomAndUser(ctx, roomId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundBan) {
+			return nil
+		}
+
+		return err
+	}
+
+	if ban.IsActive() {
+		return entity.ErrUserBanned
+	}
+
+	return nil
+}
+
+// checkModerationRevocation allows the moderator to lift a ban or a mute only
+// when the target and the user that issued it have a lower role, as when they
+// are moderated. The users that are no longer members are not checked.
+func checkModerationRevocation(
+	ctx context.Context,
+	memberRepository repository.MemberRepository,
+	moderator *entity.Member,
+	roomId *valueobject.Id,
+	userIds ...*valueobject.UserId,
+) error {
+
+	for _, userId := range userIds {
+		if userId.Value() == moderator.UserId().Value() {
+			continue
+		}
+
+		member, err := memberRepository.FindByRoomAndUser(ctx, roomId, userId)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFoundMember) {
+				continue
+			}
+
+			return err
+		}
+
+		err = moderator.ValidateModeration(member)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRoomMute rejects the users with an active mute in the room. Expired
+// mutes are ignored.
+func checkRoomMute(
+	ctx context.Context,
+	muteRepository repository.MuteRepository,
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) error {
+
+	mute, err := muteRepository.FindByRoomAndUser(ctx, roomId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMute) {
+			return nil
+		}
+
+		return err
+	}
+
+	if mute.IsActive() {
+		return entity.ErrUserMuted
+	}
+
+	return nil
+}
+
+// findMemberMessage returns the message of the room when the user is a member
+// of the room and the message was not deleted.
+func findMemberMessage(
+	ctx context.Context,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	messageRepository repository.MessageRepository,
+	roomId *valueobject.Id,
+	messageId *valueobject.Id,
+	userId *valueobject.UserId,
+) (*entity.Message, error) {
+
+	room, err := roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	if room.IsDeleted() {
+		return nil, repository.ErrNotFoundRoom
+	}
+
+	_, err = memberRepository.FindByRoomAndUser(ctx, roomId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return nil, entity.ErrNotRoomMember
+		}
+
+		return nil, err
+	}
+
+	message, err := messageRepository.FindById(ctx, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.RoomId().Value() != roomId.Value() {
+		return nil, repository.ErrNotFoundMessage
+	}
+
+	if message.IsDeleted() {
+		return nil, entity.ErrMessageAlreadyDeleted
+	}
+
+	return message, nil
+}
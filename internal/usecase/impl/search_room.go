@@ -6,6 +6,7 @@ import (
 	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
 	"github.com/sesaquecruz/go-chat-api/pkg/log"
 )
@@ -27,12 +28,17 @@ func (u *SearchRoomUseCase) Execute(
 	input *usecase.SearchRoomUseCaseInput,
 ) (*pagination.Page[*usecase.SearchRoomUseCaseOutput], error) {
 
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	query, err := pagination.NewQuery(input.Page, input.Size, input.Sort, input.Search)
 	if err != nil {
 		return nil, err
 	}
 
-	page, err := u.roomRepository.Search(ctx, query)
+	page, err := u.roomRepository.Search(ctx, userId, query)
 	if err != nil {
 		u.logger.Error(err)
 		return nil, err
@@ -40,12 +46,13 @@ func (u *SearchRoomUseCase) Execute(
 
 	mapper := func(r *entity.Room) *usecase.SearchRoomUseCaseOutput {
 		return &usecase.SearchRoomUseCaseOutput{
-			Id:        r.Id().Value(),
-			AdminId:   r.AdminId().Value(),
-			Name:      r.Name().Value(),
-			Category:  r.Category().Value(),
-			CreatedAt: r.CreatedAt().Value(),
-			UpdatedAt: r.UpdatedAt().Value(),
+			Id:         r.Id().Value(),
+			AdminId:    r.AdminId().Value(),
+			Name:       r.Name().Value(),
+			Category:   r.Category().Value(),
+			Visibility: r.Visibility().Value(),
+			CreatedAt:  r.CreatedAt().Value(),
+			UpdatedAt:  r.UpdatedAt().Value(),
 		}
 	}
 
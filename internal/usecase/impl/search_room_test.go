@@ -9,6 +9,7 @@ import (
 
 	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
 	"github.com/sesaquecruz/go-chat-api/test/mocks"
 
@@ -19,6 +20,7 @@ import (
 func TestSearchRoomUseCase_ShouldReturnAPageWhenDataIsValid(t *testing.T) {
 	ctx := context.Background()
 	input := &usecase.SearchRoomUseCaseInput{
+		UserId: "auth0|64c8457bb160e37c8c34533b",
 		Page:   "0",
 		Size:   "2",
 		Sort:   "asc",
@@ -28,9 +30,10 @@ func TestSearchRoomUseCase_ShouldReturnAPageWhenDataIsValid(t *testing.T) {
 	roomRepository := mocks.NewRoomRepositoryMock(t)
 
 	roomRepository.EXPECT().
-		Search(mock.Anything, mock.Anything).
-		Run(func(c context.Context, q *pagination.Query) {
+		Search(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, u *valueobject.UserId, q *pagination.Query) {
 			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.UserId, u.Value())
 			assert.Equal(t, input.Page, strconv.Itoa(q.Page()))
 			assert.Equal(t, input.Size, strconv.Itoa(q.Size()))
 			assert.Equal(t, strings.ToUpper(input.Sort), q.Sort())
@@ -61,6 +64,7 @@ func TestSearchRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		{
 			"invalid page",
 			&usecase.SearchRoomUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
 				Page:   "-1",
 				Size:   "2",
 				Sort:   "asc",
@@ -71,6 +75,7 @@ func TestSearchRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		{
 			"invalid size",
 			&usecase.SearchRoomUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
 				Page:   "0",
 				Size:   "0",
 				Sort:   "asc",
@@ -81,6 +86,7 @@ func TestSearchRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		{
 			"invalid sort",
 			&usecase.SearchRoomUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
 				Page:   "0",
 				Size:   "1",
 				Sort:   "dfoierewr",
@@ -91,6 +97,7 @@ func TestSearchRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		{
 			"invalid search",
 			&usecase.SearchRoomUseCaseInput{
+				UserId: "auth0|64c8457bb160e37c8c34533b",
 				Page:   "0",
 				Size:   "2",
 				Sort:   "asc",
@@ -116,6 +123,7 @@ func TestSearchRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 func TestSearchRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 	ctx := context.Background()
 	input := &usecase.SearchRoomUseCaseInput{
+		UserId: "auth0|64c8457bb160e37c8c34533b",
 		Page:   "0",
 		Size:   "2",
 		Sort:   "asc",
@@ -125,7 +133,7 @@ func TestSearchRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 	roomRepository := mocks.NewRoomRepositoryMock(t)
 
 	roomRepository.EXPECT().
-		Search(mock.Anything, mock.Anything).
+		Search(mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, errors.New("a repository error")).
 		Once()
 
@@ -14,22 +14,34 @@ import (
 )
 
 type SendMessageUseCase struct {
-	roomRepository      repository.RoomRepository
-	messageRepository   repository.MessageRepository
-	messageEventGateway gateway.MessageEventGateway
-	logger              *log.Logger
+	transactionManager          repository.TransactionManager
+	roomRepository              repository.RoomRepository
+	memberRepository            repository.MemberRepository
+	muteRepository              repository.MuteRepository
+	messageRepository           repository.MessageRepository
+	idempotentRequestRepository repository.IdempotentRequestRepository
+	messageEventGateway         gateway.MessageEventGateway
+	logger                      *log.Logger
 }
 
 func NewSendMessageUseCase(
+	transactionManager repository.TransactionManager,
 	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	muteRepository repository.MuteRepository,
 	messageRepository repository.MessageRepository,
+	idempotentRequestRepository repository.IdempotentRequestRepository,
 	messageEventGateway gateway.MessageEventGateway,
 ) *SendMessageUseCase {
 	return &SendMessageUseCase{
-		roomRepository:      roomRepository,
-		messageRepository:   messageRepository,
-		messageEventGateway: messageEventGateway,
-		logger:              log.NewLogger("SendMessageUseCase"),
+		transactionManager:          transactionManager,
+		roomRepository:              roomRepository,
+		memberRepository:            memberRepository,
+		muteRepository:              muteRepository,
+		messageRepository:           messageRepository,
+		idempotentRequestRepository: idempotentRequestRepository,
+		messageEventGateway:         messageEventGateway,
+		logger:                      log.NewLogger("SendMessageUseCase"),
 	}
 }
 
@@ -58,6 +70,30 @@ func (u *SendMessageUseCase) Execute(
 		return nil, err
 	}
 
+	var parentId *valueobject.Id
+
+	if input.ParentId != "" {
+		parentId, err = valueobject.NewIdWith(input.ParentId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var key *valueobject.IdempotencyKey
+
+	if input.IdempotencyKey != "" {
+		key, err = valueobject.NewIdempotencyKeyWith(input.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// A retry returns the stored response, whatever changed in the room since.
+		output, err := u.replay(ctx, senderId, key, roomId, text, parentId)
+		if err != nil || output != nil {
+			return output, err
+		}
+	}
+
 	room, err := u.roomRepository.FindById(ctx, roomId)
 	if err != nil {
 		if !errors.Is(err, repository.ErrNotFoundRoom) {
@@ -71,24 +107,132 @@ func (u *SendMessageUseCase) Execute(
 		return nil, repository.ErrNotFoundRoom
 	}
 
+	_, err = u.memberRepository.FindByRoomAndUser(ctx, roomId, senderId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return nil, entity.ErrNotRoomMember
+		}
+
+		u.logger.Error(err)
+		return nil, err
+	}
+
+	err = checkRoomMute(ctx, u.muteRepository, roomId, senderId)
+	if err != nil {
+		if !errors.Is(err, entity.ErrUserMuted) {
+			u.logger.Error(err)
+		}
+
+		return nil, err
+	}
+
 	message := entity.NewMessage(roomId, senderId, senderName, text)
+
+	if parentId != nil {
+		parent, err := u.messageRepository.FindById(ctx, parentId)
+		if err != nil {
+			if !errors.Is(err, repository.ErrNotFoundMessage) {
+				u.logger.Error(err)
+			}
+
+			return nil, err
+		}
+
+		// A parent of another room is not found, so its room is not revealed.
+		if parent.RoomId().Value() != roomId.Value() {
+			return nil, repository.ErrNotFoundMessage
+		}
+
+		err = message.ReplyTo(parent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	messageEvent := event.NewMessageEvent(message)
 
-	err = u.messageRepository.Save(ctx, message)
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.messageRepository.Save(ctx, message)
+		if err != nil {
+			return err
+		}
+
+		if key != nil {
+			err := u.idempotentRequestRepository.Save(ctx, entity.NewIdempotentRequest(key, message))
+			if err != nil {
+				return err
+			}
+		}
+
+		return u.messageEventGateway.Send(ctx, messageEvent)
+	})
 	if err != nil {
+		// A concurrent request with the same key was saved first.
+		if errors.Is(err, repository.ErrDuplicateIdempotentRequest) {
+			output, err := u.replay(ctx, senderId, key, roomId, text, parentId)
+			if err == nil && output == nil {
+				err = repository.ErrDuplicateIdempotentRequest
+			}
+
+			return output, err
+		}
+
 		u.logger.Error(err)
 		return nil, err
 	}
 
-	err = u.messageEventGateway.Send(ctx, messageEvent)
+	return newSendMessageUseCaseOutput(message, false), nil
+}
+
+// replay returns the output of the request previously sent with the key, or
+// nil when there is no valid request for it.
+func (u *SendMessageUseCase) replay(
+	ctx context.Context,
+	senderId *valueobject.UserId,
+	key *valueobject.IdempotencyKey,
+	roomId *valueobject.Id,
+	text *valueobject.MessageText,
+	parentId *valueobject.Id,
+) (*usecase.SendMessageUseCaseOutput, error) {
+
+	request, err := u.idempotentRequestRepository.FindByKey(ctx, senderId, key)
 	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundIdempotentRequest) {
+			return nil, nil
+		}
+
 		u.logger.Error(err)
 		return nil, err
 	}
 
-	output := &usecase.SendMessageUseCaseOutput{
-		MessageId: message.Id().Value(),
+	if request.IsExpired() {
+		return nil, nil
+	}
+
+	if !request.Matches(roomId, text, parentId) {
+		return nil, entity.ErrIdempotencyKeyReused
+	}
+
+	original, err := u.messageRepository.FindById(ctx, request.MessageId())
+	if err != nil {
+		u.logger.Error(err)
+		return nil, err
 	}
 
-	return output, nil
+	return newSendMessageUseCaseOutput(original, true), nil
+}
+
+func newSendMessageUseCaseOutput(message *entity.Message, replayed bool) *usecase.SendMessageUseCaseOutput {
+	return &usecase.SendMessageUseCaseOutput{
+		MessageId:  message.Id().Value(),
+		RoomId:     message.RoomId().Value(),
+		SenderId:   message.SenderId().Value(),
+		SenderName: message.SenderName().Value(),
+		Text:       messageTextValue(message),
+		CreatedAt:  message.CreatedAt().Value(),
+		EditedAt:   timestampValue(message.EditedAt()),
+		DeletedAt:  timestampValue(message.DeletedAt()),
+		ParentId:   idValue(message.ParentId()),
+		Replayed:   replayed,
+	}
 }
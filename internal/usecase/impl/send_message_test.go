@@ -2,7 +2,9 @@ package impl
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
@@ -31,8 +33,12 @@ func TestSendMessageUseCase_ShouldCreateAMessageWhenDataIsValid(t *testing.T) {
 		Text:       "A text",
 	}
 
+	transactionManager := mocks.NewTransactionManagerMock(t)
 	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
 	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
 	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
 
 	roomRepository.EXPECT().
@@ -44,6 +50,23 @@ func TestSendMessageUseCase_ShouldCreateAMessageWhenDataIsValid(t *testing.T) {
 		Return(roomSaved, nil).
 		Once()
 
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(roomSaved.Id(), roomSaved.AdminId()), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
 	messageRepository.
 		EXPECT().
 		Save(mock.Anything, mock.Anything).
@@ -73,12 +96,18 @@ func TestSendMessageUseCase_ShouldCreateAMessageWhenDataIsValid(t *testing.T) {
 		Return(nil).
 		Once()
 
-	useCase := NewSendMessageUseCase(roomRepository, messageRepository, messageEventGateway)
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
 
 	output, err := useCase.Execute(ctx, input)
 	assert.NotNil(t, output)
 	assert.Nil(t, err)
 	assert.Equal(t, messageCreated.Id().Value(), output.MessageId)
+	assert.Equal(t, messageCreated.RoomId().Value(), output.RoomId)
+	assert.Equal(t, messageCreated.SenderId().Value(), output.SenderId)
+	assert.Equal(t, messageCreated.SenderName().Value(), output.SenderName)
+	assert.Equal(t, messageCreated.Text().Value(), output.Text)
+	assert.Equal(t, messageCreated.CreatedAt().Value(), output.CreatedAt)
+	assert.False(t, output.Replayed)
 }
 
 func TestSendMessageUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
@@ -131,11 +160,15 @@ func TestSendMessageUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		},
 	}
 
+	transactionManager := mocks.NewTransactionManagerMock(t)
 	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
 	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
 	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
 
-	useCase := NewSendMessageUseCase(roomRepository, messageRepository, messageEventGateway)
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
 
 	for _, tc := range testCases {
 		t.Run(tc.test, func(t *testing.T) {
@@ -156,8 +189,12 @@ func TestSendMessageUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 		Text:       "A text",
 	}
 
+	transactionManager := mocks.NewTransactionManagerMock(t)
 	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
 	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
 	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
 
 	roomRepository.EXPECT().
@@ -165,10 +202,508 @@ func TestSendMessageUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 		Return(nil, repository.ErrNotFoundMessage).
 		Once()
 
-	useCase := NewSendMessageUseCase(roomRepository, messageRepository, messageEventGateway)
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
+}
+
+func TestSendMessageUseCase_ShouldReturnAnErrorWhenTheEventIsNotSaved(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:     roomSaved.Id().Value(),
+		SenderId:   roomSaved.AdminId().Value(),
+		SenderName: "An username",
+		Text:       "A text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(roomSaved.Id(), roomSaved.AdminId()), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	messageRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Return(errors.New("an error")).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
 
 	output, err := useCase.Execute(ctx, input)
 	assert.Nil(t, output)
 	assert.NotNil(t, err)
+}
+
+func TestSendMessageUseCase_ShouldReplayTheMessageWhenTheIdempotencyKeyWasUsed(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), adminId, senderName, text)
+
+	key, _ := valueobject.NewIdempotencyKeyWith("a-key")
+	requestSaved := entity.NewIdempotentRequest(key, messageSaved)
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:         roomSaved.Id().Value(),
+		SenderId:       adminId.Value(),
+		SenderName:     senderName.Value(),
+		Text:           text.Value(),
+		IdempotencyKey: key.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	idempotentRequestRepository.EXPECT().
+		FindByKey(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, s *valueobject.UserId, k *valueobject.IdempotencyKey) {
+			assert.Equal(t, input.SenderId, s.Value())
+			assert.Equal(t, input.IdempotencyKey, k.Value())
+		}).
+		Return(requestSaved, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, messageSaved.Id().Value(), i.Value())
+		}).
+		Return(messageSaved, nil).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, messageSaved.Id().Value(), output.MessageId)
+	assert.True(t, output.Replayed)
+}
+
+func TestSendMessageUseCase_ShouldReplayTheMessageWhenItWasEditedAfterTheRequest(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), adminId, senderName, text)
+
+	key, _ := valueobject.NewIdempotencyKeyWith("a-key")
+	requestSaved := entity.NewIdempotentRequest(key, messageSaved)
+
+	editedText, _ := valueobject.NewMessageTextWith("An edited text")
+	messageSaved.Edit(editedText)
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:         roomSaved.Id().Value(),
+		SenderId:       adminId.Value(),
+		SenderName:     senderName.Value(),
+		Text:           text.Value(),
+		IdempotencyKey: key.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	idempotentRequestRepository.EXPECT().
+		FindByKey(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, s *valueobject.UserId, k *valueobject.IdempotencyKey) {
+			assert.Equal(t, input.SenderId, s.Value())
+			assert.Equal(t, input.IdempotencyKey, k.Value())
+		}).
+		Return(requestSaved, nil).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, messageSaved.Id().Value(), i.Value())
+		}).
+		Return(messageSaved, nil).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, messageSaved.Id().Value(), output.MessageId)
+	assert.Equal(t, editedText.Value(), output.Text)
+	assert.True(t, output.Replayed)
+}
+
+func TestSendMessageUseCase_ShouldReturnAnErrorWhenTheIdempotencyKeyWasUsedForAnotherMessage(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	messageSaved := entity.NewMessage(roomSaved.Id(), adminId, senderName, text)
+
+	key, _ := valueobject.NewIdempotencyKeyWith("a-key")
+	requestSaved := entity.NewIdempotentRequest(key, messageSaved)
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:         roomSaved.Id().Value(),
+		SenderId:       adminId.Value(),
+		SenderName:     senderName.Value(),
+		Text:           "Another text",
+		IdempotencyKey: key.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	idempotentRequestRepository.EXPECT().
+		FindByKey(mock.Anything, mock.Anything, mock.Anything).
+		Return(requestSaved, nil).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrIdempotencyKeyReused)
+}
+
+func TestSendMessageUseCase_ShouldReturnAnErrorWhenTheSenderIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	roomSaved := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:     roomSaved.Id().Value(),
+		SenderId:   "auth0|64c8457bb160e37c8c34533c",
+		SenderName: "An username",
+		Text:       "A text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(roomSaved, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.RoomId, r.Value())
+			assert.Equal(t, input.SenderId, u.Value())
+		}).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrNotRoomMember)
+}
+
+func TestSendMessageUseCase_ShouldReturnAnErrorWhenTheSenderIsMuted(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	mute, _ := entity.NewMute(room.Id(), senderId, adminId, valueobject.NewTimestamp().Add(time.Hour))
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:     room.Id().Value(),
+		SenderId:   senderId.Value(),
+		SenderName: "An username",
+		Text:       "A text",
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), senderId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(mute, nil).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrUserMuted)
+}
+
+func TestSendMessageUseCase_ShouldReplyToAMessageOfTheRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	parent := entity.NewMessage(room.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:     room.Id().Value(),
+		SenderId:   adminId.Value(),
+		SenderName: "An username",
+		Text:       "A reply",
+		ParentId:   parent.Id().Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), adminId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Run(func(c context.Context, i *valueobject.Id) {
+			assert.Equal(t, input.ParentId, i.Value())
+		}).
+		Return(parent, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	messageRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Run(func(c context.Context, m *entity.Message) {
+			assert.Equal(t, input.ParentId, m.ParentId().Value())
+		}).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.MessageEvent) {
+			assert.Equal(t, input.ParentId, e.ParentId)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.NotNil(t, output)
+	assert.Nil(t, err)
+	assert.Equal(t, input.ParentId, output.ParentId)
+}
+
+func TestSendMessageUseCase_ShouldReturnAnErrorWhenTheParentIsNotInTheRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+	otherRoom := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	parent := entity.NewMessage(otherRoom.Id(), adminId, senderName, text)
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:     room.Id().Value(),
+		SenderId:   adminId.Value(),
+		SenderName: "An username",
+		Text:       "A reply",
+		ParentId:   parent.Id().Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), adminId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(parent, nil).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
 	assert.ErrorIs(t, err, repository.ErrNotFoundMessage)
 }
+
+func TestSendMessageUseCase_ShouldReturnAnErrorWhenTheParentIsDeleted(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	senderName, _ := valueobject.NewUserNameWith("An username")
+	text, _ := valueobject.NewMessageTextWith("A text")
+	parent := entity.NewMessage(room.Id(), adminId, senderName, text)
+	parent.Delete()
+
+	ctx := context.Background()
+	input := &usecase.SendMessageUseCaseInput{
+		RoomId:     room.Id().Value(),
+		SenderId:   adminId.Value(),
+		SenderName: "An username",
+		Text:       "A reply",
+		ParentId:   parent.Id().Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	messageRepository := mocks.NewMessageRepositoryMock(t)
+	idempotentRequestRepository := mocks.NewIdempotentRequestRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), adminId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	messageRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(parent, nil).
+		Once()
+
+	useCase := NewSendMessageUseCase(transactionManager, roomRepository, memberRepository, muteRepository, messageRepository, idempotentRequestRepository, messageEventGateway)
+
+	output, err := useCase.Execute(ctx, input)
+	assert.Nil(t, output)
+	assert.ErrorIs(t, err, entity.ErrMessageAlreadyDeleted)
+}
@@ -0,0 +1,108 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type SendTypingUseCase struct {
+	roomRepository     repository.RoomRepository
+	memberRepository   repository.MemberRepository
+	muteRepository     repository.MuteRepository
+	typingEventGateway gateway.TypingEventGateway
+	throttle           *typingThrottle
+	logger             *log.Logger
+}
+
+func NewSendTypingUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	muteRepository repository.MuteRepository,
+	typingEventGateway gateway.TypingEventGateway,
+) *SendTypingUseCase {
+	return &SendTypingUseCase{
+		roomRepository:     roomRepository,
+		memberRepository:   memberRepository,
+		muteRepository:     muteRepository,
+		typingEventGateway: typingEventGateway,
+		throttle:           newTypingThrottle(typingThrottlePeriod),
+		logger:             log.NewLogger("SendTypingUseCase"),
+	}
+}
+
+// Execute sends a typing event to the room members. Typing notifications
+// sent again by the user within the throttle period are ignored, the period is
+// tracked by each instance.
+func (u *SendTypingUseCase) Execute(ctx context.Context, input *usecase.SendTypingUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	userName, err := valueobject.NewUserNameWith(input.UserName)
+	if err != nil {
+		return err
+	}
+
+	// The throttle is checked first, so the repeated notifications of a
+	// keystroke do not reach the database.
+	if !u.throttle.allow(roomId.Value(), userId.Value(), time.Now()) {
+		return nil
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	_, err = u.memberRepository.FindByRoomAndUser(ctx, roomId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return entity.ErrNotRoomMember
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	err = checkRoomMute(ctx, u.muteRepository, roomId, userId)
+	if err != nil {
+		if !errors.Is(err, entity.ErrUserMuted) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	typing := entity.NewTyping(roomId, userId, userName)
+
+	err = u.typingEventGateway.Send(ctx, event.NewTypingEvent(typing))
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,292 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendTypingUseCase_ShouldSendATypingEventWhenDataIsValid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.SendTypingUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   room.AdminId().Value(),
+		UserName: "An username",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.RoomId, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(entity.NewMember(room.Id(), room.AdminId()), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	typingEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.TypingEvent) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, event.UserTyping, e.Type)
+			assert.Equal(t, input.RoomId, e.RoomId)
+			assert.Equal(t, input.UserId, e.UserId)
+			assert.Equal(t, input.UserName, e.UserName)
+			assert.NotEmpty(t, e.ExpiresAt)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewSendTypingUseCase(roomRepository, memberRepository, muteRepository, typingEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestSendTypingUseCase_ShouldThrottleRepeatedTypingEvents(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.SendTypingUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   room.AdminId().Value(),
+		UserName: "An username",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), room.AdminId()), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	typingEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	useCase := NewSendTypingUseCase(roomRepository, memberRepository, muteRepository, typingEventGateway)
+
+	for i := 0; i < 3; i++ {
+		err := useCase.Execute(ctx, input)
+		assert.Nil(t, err)
+	}
+}
+
+func TestSendTypingUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test  string
+		input *usecase.SendTypingUseCaseInput
+		err   error
+	}{
+		{
+			"empty room id",
+			&usecase.SendTypingUseCaseInput{
+				RoomId:   "",
+				UserId:   "auth0|64c8457bb160e37c8c34533b",
+				UserName: "An username",
+			},
+			valueobject.ErrRequiredId,
+		},
+		{
+			"empty user id",
+			&usecase.SendTypingUseCaseInput{
+				RoomId:   "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId:   "",
+				UserName: "An username",
+			},
+			valueobject.ErrRequiredUserId,
+		},
+		{
+			"empty user name",
+			&usecase.SendTypingUseCaseInput{
+				RoomId:   "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId:   "auth0|64c8457bb160e37c8c34533b",
+				UserName: "",
+			},
+			valueobject.ErrRequiredUserName,
+		},
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+
+	useCase := NewSendTypingUseCase(roomRepository, memberRepository, muteRepository, typingEventGateway)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			err := useCase.Execute(ctx, tc.input)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestSendTypingUseCase_ShouldReturnAnErrorWhenTheUserIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.SendTypingUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   "auth0|64c8457bb160e37c8c34533c",
+		UserName: "An username",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewSendTypingUseCase(roomRepository, memberRepository, muteRepository, typingEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrNotRoomMember)
+}
+
+func TestSendTypingUseCase_ShouldReturnAnErrorWhenTheUserIsMuted(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	mute, _ := entity.NewMute(room.Id(), userId, adminId, valueobject.NewTimestamp().Add(time.Hour))
+
+	ctx := context.Background()
+	input := &usecase.SendTypingUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   userId.Value(),
+		UserName: "An username",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), userId), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(mute, nil).
+		Once()
+
+	useCase := NewSendTypingUseCase(roomRepository, memberRepository, muteRepository, typingEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrUserMuted)
+}
+
+func TestSendTypingUseCase_ShouldReturnAnErrorWhenTheEventIsNotSent(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.SendTypingUseCaseInput{
+		RoomId:   room.Id().Value(),
+		UserId:   room.AdminId().Value(),
+		UserName: "An username",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	muteRepository := mocks.NewMuteRepositoryMock(t)
+	typingEventGateway := mocks.NewTypingEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), room.AdminId()), nil).
+		Once()
+
+	muteRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMute).
+		Once()
+
+	typingEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Return(errors.New("a gateway error")).
+		Once()
+
+	useCase := NewSendTypingUseCase(roomRepository, memberRepository, muteRepository, typingEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.NotNil(t, err)
+}
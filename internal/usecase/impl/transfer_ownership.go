@@ -0,0 +1,143 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type TransferOwnershipUseCase struct {
+	transactionManager          repository.TransactionManager
+	roomRepository              repository.RoomRepository
+	memberRepository            repository.MemberRepository
+	ownershipTransferRepository repository.OwnershipTransferRepository
+	messageEventGateway         gateway.MessageEventGateway
+	logger                      *log.Logger
+}
+
+func NewTransferOwnershipUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	ownershipTransferRepository repository.OwnershipTransferRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *TransferOwnershipUseCase {
+	return &TransferOwnershipUseCase{
+		transactionManager:          transactionManager,
+		roomRepository:              roomRepository,
+		memberRepository:            memberRepository,
+		ownershipTransferRepository: ownershipTransferRepository,
+		messageEventGateway:         messageEventGateway,
+		logger:                      log.NewLogger("TransferOwnershipUseCase"),
+	}
+}
+
+func (u *TransferOwnershipUseCase) Execute(ctx context.Context, input *usecase.TransferOwnershipUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	adminId, err := valueobject.NewUserIdWith(input.AdminId)
+	if err != nil {
+		return err
+	}
+
+	// The room is locked before it is read, so concurrent transfers of the room
+	// see the owner left by each other.
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.roomRepository.Lock(ctx, roomId)
+		if err != nil {
+			return err
+		}
+
+		room, err := u.roomRepository.FindById(ctx, roomId)
+		if err != nil {
+			return err
+		}
+
+		if room.IsDeleted() {
+			return repository.ErrNotFoundRoom
+		}
+
+		// Platform administrators can transfer any room, e.g. when its admin is gone.
+		if !input.PlatformAdmin {
+			_, err = checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionTransferRoom)
+			if err != nil {
+				return err
+			}
+		}
+
+		owner, err := u.memberRepository.FindByRoomAndUser(ctx, room.Id(), room.AdminId())
+		if err != nil {
+			return err
+		}
+
+		member, err := u.memberRepository.FindByRoomAndUser(ctx, room.Id(), adminId)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFoundMember) {
+				return entity.ErrRoomAdminNotMember
+			}
+
+			return err
+		}
+
+		transfer := entity.NewOwnershipTransfer(room.Id(), room.AdminId(), adminId, userId)
+
+		err = room.TransferOwnership(adminId)
+		if err != nil {
+			return err
+		}
+
+		err = member.TransferOwnership(owner)
+		if err != nil {
+			return err
+		}
+
+		err = u.roomRepository.Update(ctx, room)
+		if err != nil {
+			return err
+		}
+
+		err = u.memberRepository.Update(ctx, owner)
+		if err != nil {
+			return err
+		}
+
+		err = u.memberRepository.Update(ctx, member)
+		if err != nil {
+			return err
+		}
+
+		err = u.ownershipTransferRepository.Save(ctx, transfer)
+		if err != nil {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewRoomOwnershipTransferredEvent(transfer))
+	})
+	if err != nil {
+		switch err.(type) {
+		case validation.NotFoundError, validation.UnauthorizedError, validation.ValidationError:
+		default:
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,464 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransferOwnershipUseCase_ShouldTransferTheRoomWhenUserIsTheOwnerOrAPlatformAdmin(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	platformAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+
+	testCases := []struct {
+		test          string
+		userId        *valueobject.UserId
+		platformAdmin bool
+	}{
+		{"room owner", adminId, false},
+		{"platform admin", platformAdminId, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			name, _ := valueobject.NewRoomNameWith("A Game")
+			category, _ := valueobject.NewRoomCategoryWith("Game")
+			room := entity.NewRoom(adminId, name, category)
+
+			owner := entity.NewRoomOwner(room.Id(), adminId)
+			member := entity.NewMember(room.Id(), newAdminId)
+
+			ctx := context.Background()
+			input := &usecase.TransferOwnershipUseCaseInput{
+				RoomId:        room.Id().Value(),
+				UserId:        tc.userId.Value(),
+				AdminId:       newAdminId.Value(),
+				PlatformAdmin: tc.platformAdmin,
+			}
+
+			transactionManager := mocks.NewTransactionManagerMock(t)
+			roomRepository := mocks.NewRoomRepositoryMock(t)
+			memberRepository := mocks.NewMemberRepositoryMock(t)
+			ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+			messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+			roomRepository.EXPECT().
+				Lock(mock.Anything, mock.Anything).
+				Return(nil).
+				Once()
+
+			roomRepository.EXPECT().
+				FindById(mock.Anything, mock.Anything).
+				Return(room, nil).
+				Once()
+
+			ownerLookups := 1
+			if !tc.platformAdmin {
+				ownerLookups = 2
+			}
+
+			memberRepository.EXPECT().
+				FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+				Return(owner, nil).
+				Times(ownerLookups)
+
+			memberRepository.EXPECT().
+				FindByRoomAndUser(mock.Anything, mock.Anything, newAdminId).
+				Return(member, nil).
+				Once()
+
+			transactionManager.EXPECT().
+				Execute(mock.Anything, mock.Anything).
+				RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				}).
+				Once()
+
+			roomRepository.EXPECT().
+				Update(mock.Anything, room).
+				Run(func(c context.Context, r *entity.Room) {
+					assert.Equal(t, newAdminId.Value(), r.AdminId().Value())
+				}).
+				Return(nil).
+				Once()
+
+			memberRepository.EXPECT().
+				Update(mock.Anything, owner).
+				Run(func(c context.Context, m *entity.Member) {
+					assert.Equal(t, valueobject.MemberRoleModerator, m.Role().Value())
+				}).
+				Return(nil).
+				Once()
+
+			memberRepository.EXPECT().
+				Update(mock.Anything, member).
+				Run(func(c context.Context, m *entity.Member) {
+					assert.True(t, m.IsOwner())
+				}).
+				Return(nil).
+				Once()
+
+			ownershipTransferRepository.EXPECT().
+				Save(mock.Anything, mock.Anything).
+				Run(func(c context.Context, o *entity.OwnershipTransfer) {
+					assert.Equal(t, input.RoomId, o.RoomId().Value())
+					assert.Equal(t, adminId.Value(), o.PreviousAdminId().Value())
+					assert.Equal(t, input.AdminId, o.AdminId().Value())
+					assert.Equal(t, input.UserId, o.TransferredBy().Value())
+				}).
+				Return(nil).
+				Once()
+
+			messageEventGateway.EXPECT().
+				Send(mock.Anything, mock.Anything).
+				Run(func(c context.Context, e *event.MessageEvent) {
+					assert.Equal(t, event.RoomOwnershipTransferred, e.Type)
+					assert.Equal(t, input.RoomId, e.RoomId)
+					assert.Equal(t, adminId.Value(), e.PreviousAdminId)
+					assert.Equal(t, input.AdminId, e.AdminId)
+				}).
+				Return(nil).
+				Once()
+
+			useCase := NewTransferOwnershipUseCase(transactionManager, roomRepository, memberRepository, ownershipTransferRepository, messageEventGateway)
+
+			err := useCase.Execute(ctx, input)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestTransferOwnershipUseCase_ShouldReturnAnErrorWhenTheUserCannotTransferTheRoom(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	ctx := context.Background()
+	input := &usecase.TransferOwnershipUseCaseInput{
+		RoomId:  room.Id().Value(),
+		UserId:  userId.Value(),
+		AdminId: userId.Value(),
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), userId), nil).
+		Once()
+
+	useCase := NewTransferOwnershipUseCase(transactionManager, roomRepository, memberRepository, ownershipTransferRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrMissingPermission)
+	assert.Equal(t, adminId.Value(), room.AdminId().Value())
+}
+
+func TestTransferOwnershipUseCase_ShouldReturnAnErrorWhenTheNewAdminIsInvalid(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+
+	testCases := []struct {
+		test    string
+		adminId *valueobject.UserId
+		err     error
+	}{
+		{"not a member", newAdminId, entity.ErrRoomAdminNotMember},
+		{"current admin", adminId, entity.ErrRoomAlreadyOwnedByUser},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			name, _ := valueobject.NewRoomNameWith("A Game")
+			category, _ := valueobject.NewRoomCategoryWith("Game")
+			room := entity.NewRoom(adminId, name, category)
+
+			owner := entity.NewRoomOwner(room.Id(), adminId)
+
+			ctx := context.Background()
+			input := &usecase.TransferOwnershipUseCaseInput{
+				RoomId:  room.Id().Value(),
+				UserId:  adminId.Value(),
+				AdminId: tc.adminId.Value(),
+			}
+
+			transactionManager := mocks.NewTransactionManagerMock(t)
+			roomRepository := mocks.NewRoomRepositoryMock(t)
+			memberRepository := mocks.NewMemberRepositoryMock(t)
+			ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+			messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+			transactionManager.EXPECT().
+				Execute(mock.Anything, mock.Anything).
+				RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+					return fn(c)
+				}).
+				Once()
+
+			roomRepository.EXPECT().
+				Lock(mock.Anything, mock.Anything).
+				Return(nil).
+				Once()
+
+			roomRepository.EXPECT().
+				FindById(mock.Anything, mock.Anything).
+				Return(room, nil).
+				Once()
+
+			if tc.adminId == adminId {
+				memberRepository.EXPECT().
+					FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+					Return(owner, nil).
+					Times(3)
+			} else {
+				memberRepository.EXPECT().
+					FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+					Return(owner, nil).
+					Twice()
+
+				memberRepository.EXPECT().
+					FindByRoomAndUser(mock.Anything, mock.Anything, tc.adminId).
+					Return(nil, repository.ErrNotFoundMember).
+					Once()
+			}
+
+			useCase := NewTransferOwnershipUseCase(transactionManager, roomRepository, memberRepository, ownershipTransferRepository, messageEventGateway)
+
+			err := useCase.Execute(ctx, input)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, adminId.Value(), room.AdminId().Value())
+		})
+	}
+}
+
+func TestTransferOwnershipUseCase_ShouldTransferTheRoomWhenThePreviousAdminIsAPlainMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	platformAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	admin := entity.NewMember(room.Id(), adminId)
+	member := entity.NewMember(room.Id(), newAdminId)
+
+	ctx := context.Background()
+	input := &usecase.TransferOwnershipUseCaseInput{
+		RoomId:        room.Id().Value(),
+		UserId:        platformAdminId.Value(),
+		AdminId:       newAdminId.Value(),
+		PlatformAdmin: true,
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(admin, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, newAdminId).
+		Return(member, nil).
+		Once()
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Update(mock.Anything, room).
+		Return(nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Update(mock.Anything, admin).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.Equal(t, valueobject.MemberRoleMember, m.Role().Value())
+		}).
+		Return(nil).
+		Once()
+
+	memberRepository.EXPECT().
+		Update(mock.Anything, member).
+		Run(func(c context.Context, m *entity.Member) {
+			assert.True(t, m.IsOwner())
+		}).
+		Return(nil).
+		Once()
+
+	ownershipTransferRepository.EXPECT().
+		Save(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	messageEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	useCase := NewTransferOwnershipUseCase(transactionManager, roomRepository, memberRepository, ownershipTransferRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+	assert.Equal(t, newAdminId.Value(), room.AdminId().Value())
+}
+
+func TestTransferOwnershipUseCase_ShouldReturnAnErrorWhenThePreviousAdminIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	newAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	platformAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.TransferOwnershipUseCaseInput{
+		RoomId:        room.Id().Value(),
+		UserId:        platformAdminId.Value(),
+		AdminId:       newAdminId.Value(),
+		PlatformAdmin: true,
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, adminId).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewTransferOwnershipUseCase(transactionManager, roomRepository, memberRepository, ownershipTransferRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, repository.ErrNotFoundMember)
+	assert.Equal(t, adminId.Value(), room.AdminId().Value())
+}
+
+func TestTransferOwnershipUseCase_ShouldReturnAnErrorWhenTheRoomIsDirect(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	otherUserId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	platformAdminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533d")
+	directRoom, _ := entity.NewDirectRoom(userId, otherUserId)
+	room := directRoom.Room()
+
+	ctx := context.Background()
+	input := &usecase.TransferOwnershipUseCaseInput{
+		RoomId:        room.Id().Value(),
+		UserId:        platformAdminId.Value(),
+		AdminId:       otherUserId.Value(),
+		PlatformAdmin: true,
+	}
+
+	transactionManager := mocks.NewTransactionManagerMock(t)
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	ownershipTransferRepository := mocks.NewOwnershipTransferRepositoryMock(t)
+	messageEventGateway := mocks.NewMessageEventGatewayMock(t)
+
+	transactionManager.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(c context.Context, fn func(context.Context) error) error {
+			return fn(c)
+		}).
+		Once()
+
+	roomRepository.EXPECT().
+		Lock(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, userId).
+		Return(entity.NewMember(room.Id(), userId), nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, otherUserId).
+		Return(entity.NewMember(room.Id(), otherUserId), nil).
+		Once()
+
+	useCase := NewTransferOwnershipUseCase(transactionManager, roomRepository, memberRepository, ownershipTransferRepository, messageEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrDirectRoomTransfer)
+	assert.Equal(t, userId.Value(), room.AdminId().Value())
+}
@@ -0,0 +1,49 @@
+package impl
+
+import (
+	"sync"
+	"time"
+)
+
+const typingThrottlePeriod = 2 * time.Second
+const typingThrottlePruneSize = 1024
+
+// typingThrottle allows one typing event per user and room in each period, so
+// the clients can notify every keystroke without flooding the room. The
+// periods are kept in memory, so the limit applies to each instance: a user
+// connected to several instances can send one event per instance in a period.
+// The events expire with the typing, so that is still bounded.
+type typingThrottle struct {
+	period time.Duration
+	mu     sync.Mutex
+	sentAt map[string]time.Time
+}
+
+func newTypingThrottle(period time.Duration) *typingThrottle {
+	return &typingThrottle{
+		period: period,
+		sentAt: make(map[string]time.Time),
+	}
+}
+
+func (t *typingThrottle) allow(roomId string, userId string, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := roomId + "/" + userId
+
+	if sentAt, ok := t.sentAt[key]; ok && now.Sub(sentAt) < t.period {
+		return false
+	}
+
+	if len(t.sentAt) >= typingThrottlePruneSize {
+		for k, sentAt := range t.sentAt {
+			if now.Sub(sentAt) >= t.period {
+				delete(t.sentAt, k)
+			}
+		}
+	}
+
+	t.sentAt[key] = now
+	return true
+}
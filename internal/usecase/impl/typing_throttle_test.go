@@ -0,0 +1,32 @@
+package impl
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypingThrottle_ShouldAllowOneEventPerUserAndRoomInEachPeriod(t *testing.T) {
+	throttle := newTypingThrottle(2 * time.Second)
+	now := time.Now()
+
+	assert.True(t, throttle.allow("room-1", "user-1", now))
+	assert.False(t, throttle.allow("room-1", "user-1", now.Add(time.Second)))
+	assert.True(t, throttle.allow("room-1", "user-2", now.Add(time.Second)))
+	assert.True(t, throttle.allow("room-2", "user-1", now.Add(time.Second)))
+	assert.True(t, throttle.allow("room-1", "user-1", now.Add(2*time.Second)))
+}
+
+func TestTypingThrottle_ShouldPruneTheExpiredEntries(t *testing.T) {
+	throttle := newTypingThrottle(2 * time.Second)
+	now := time.Now()
+
+	for i := 0; i < typingThrottlePruneSize; i++ {
+		throttle.allow("room-1", fmt.Sprintf("user-%d", i), now)
+	}
+
+	assert.True(t, throttle.allow("room-1", "another-user", now.Add(2*time.Second)))
+	assert.Len(t, throttle.sentAt, 1)
+}
@@ -0,0 +1,98 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type UnbanMemberUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	banRepository    repository.BanRepository
+	logger           *log.Logger
+}
+
+func NewUnbanMemberUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	banRepository repository.BanRepository,
+) *UnbanMemberUseCase {
+	return &UnbanMemberUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		banRepository:    banRepository,
+		logger:           log.NewLogger("UnbanMemberUseCase"),
+	}
+}
+
+func (u *UnbanMemberUseCase) Execute(ctx context.Context, input *usecase.UnbanMemberUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	memberId, err := valueobject.NewUserIdWith(input.MemberId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	moderator, err := checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionBanMember)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	ban, err := u.banRepository.FindByRoomAndUser(ctx, room.Id(), memberId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundBan) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = checkModerationRevocation(ctx, u.memberRepository, moderator, room.Id(), memberId, ban.BannedBy())
+	if err != nil {
+		if _, ok := err.(validation.ForbiddenError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = u.banRepository.Delete(ctx, ban)
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type UnmuteMemberUseCase struct {
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	muteRepository   repository.MuteRepository
+	logger           *log.Logger
+}
+
+func NewUnmuteMemberUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	muteRepository repository.MuteRepository,
+) *UnmuteMemberUseCase {
+	return &UnmuteMemberUseCase{
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		muteRepository:   muteRepository,
+		logger:           log.NewLogger("UnmuteMemberUseCase"),
+	}
+}
+
+func (u *UnmuteMemberUseCase) Execute(ctx context.Context, input *usecase.UnmuteMemberUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	memberId, err := valueobject.NewUserIdWith(input.MemberId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	moderator, err := checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionMuteMember)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	mute, err := u.muteRepository.FindByRoomAndUser(ctx, room.Id(), memberId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundMute) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = checkModerationRevocation(ctx, u.memberRepository, moderator, room.Id(), memberId, mute.MutedBy())
+	if err != nil {
+		if _, ok := err.(validation.ForbiddenError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	err = u.muteRepository.Delete(ctx, mute)
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type UnpinMessageUseCase struct {
+	transactionManager  repository.TransactionManager
+	roomRepository      repository.RoomRepository
+	memberRepository    repository.MemberRepository
+	pinRepository       repository.PinRepository
+	messageEventGateway gateway.MessageEventGateway
+	logger              *log.Logger
+}
+
+func NewUnpinMessageUseCase(
+	transactionManager repository.TransactionManager,
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	pinRepository repository.PinRepository,
+	messageEventGateway gateway.MessageEventGateway,
+) *UnpinMessageUseCase {
+	return &UnpinMessageUseCase{
+		transactionManager:  transactionManager,
+		roomRepository:      roomRepository,
+		memberRepository:    memberRepository,
+		pinRepository:       pinRepository,
+		messageEventGateway: messageEventGateway,
+		logger:              log.NewLogger("UnpinMessageUseCase"),
+	}
+}
+
+// Execute unpins the message from the room, deleted messages included.
+// Unpinning a message that is not pinned does nothing.
+func (u *UnpinMessageUseCase) Execute(ctx context.Context, input *usecase.UnpinMessageUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	messageId, err := valueobject.NewIdWith(input.MessageId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	_, err = checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionPinMessage)
+	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	pin := entity.NewPin(roomId, messageId, userId)
+
+	err = u.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		err := u.pinRepository.Delete(ctx, pin)
+		if err != nil {
+			return err
+		}
+
+		return u.messageEventGateway.Send(ctx, event.NewMessageUnpinnedEvent(pin))
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundPin) {
+			return nil
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/gateway"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/pkg/log"
+)
+
+type UpdatePresenceUseCase struct {
+	roomRepository       repository.RoomRepository
+	memberRepository     repository.MemberRepository
+	presenceEventGateway gateway.PresenceEventGateway
+	logger               *log.Logger
+}
+
+func NewUpdatePresenceUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+	presenceEventGateway gateway.PresenceEventGateway,
+) *UpdatePresenceUseCase {
+	return &UpdatePresenceUseCase{
+		roomRepository:       roomRepository,
+		memberRepository:     memberRepository,
+		presenceEventGateway: presenceEventGateway,
+		logger:               log.NewLogger("UpdatePresenceUseCase"),
+	}
+}
+
+// Execute shares the presence of the heartbeats of a user, which share one
+// session apart from its connections. Going offline is always allowed, so a
+// session can be closed after the user left the room or the room was deleted.
+func (u *UpdatePresenceUseCase) Execute(ctx context.Context, input *usecase.UpdatePresenceUseCaseInput) error {
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return err
+	}
+
+	status, err := valueobject.NewPresenceStatusWith(input.Status)
+	if err != nil {
+		return err
+	}
+
+	if !status.IsOffline() {
+		err = u.checkMembership(ctx, roomId, userId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return u.send(ctx, entity.NewPresence(roomId, userId, "", status))
+}
+
+// OpenSession checks the membership once, the session then sends its status
+// and heartbeats without checking it again. Kicked and banned users go offline
+// because their connections are closed.
+func (u *UpdatePresenceUseCase) OpenSession(
+	ctx context.Context,
+	input *usecase.OpenPresenceSessionUseCaseInput,
+) (usecase.PresenceSession, error) {
+
+	roomId, err := valueobject.NewIdWith(input.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := valueobject.NewUserIdWith(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.checkMembership(ctx, roomId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &presenceSession{
+		useCase:   u,
+		roomId:    roomId,
+		userId:    userId,
+		sessionId: valueobject.NewId().Value(),
+	}
+
+	return session, nil
+}
+
+func (u *UpdatePresenceUseCase) send(ctx context.Context, presence *entity.Presence) error {
+	err := u.presenceEventGateway.Send(ctx, event.NewPresenceEvent(presence))
+	if err != nil {
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *UpdatePresenceUseCase) checkMembership(
+	ctx context.Context,
+	roomId *valueobject.Id,
+	userId *valueobject.UserId,
+) error {
+
+	room, err := u.roomRepository.FindById(ctx, roomId)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFoundRoom) {
+			u.logger.Error(err)
+		}
+
+		return err
+	}
+
+	if room.IsDeleted() {
+		return repository.ErrNotFoundRoom
+	}
+
+	_, err = u.memberRepository.FindByRoomAndUser(ctx, roomId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFoundMember) {
+			return entity.ErrNotRoomMember
+		}
+
+		u.logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+type presenceSession struct {
+	useCase   *UpdatePresenceUseCase
+	roomId    *valueobject.Id
+	userId    *valueobject.UserId
+	sessionId string
+}
+
+func (s *presenceSession) Update(ctx context.Context, status string) error {
+	presenceStatus, err := valueobject.NewPresenceStatusWith(status)
+	if err != nil {
+		return err
+	}
+
+	return s.useCase.send(ctx, entity.NewPresence(s.roomId, s.userId, s.sessionId, presenceStatus))
+}
+
+func (s *presenceSession) Close(ctx context.Context) error {
+	return s.Update(ctx, valueobject.PresenceStatusOffline)
+}
@@ -0,0 +1,298 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/event"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+	"github.com/sesaquecruz/go-chat-api/internal/usecase"
+	"github.com/sesaquecruz/go-chat-api/test/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdatePresenceUseCase_ShouldSendThePresenceOfAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.UpdatePresenceUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: room.AdminId().Value(),
+		Status: valueobject.PresenceStatusAway,
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, input.RoomId, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(entity.NewMember(room.Id(), room.AdminId()), nil).
+		Once()
+
+	presenceEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.PresenceEvent) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, event.PresenceChanged, e.Type)
+			assert.Equal(t, input.RoomId, e.RoomId)
+			assert.Equal(t, input.UserId, e.UserId)
+			assert.Empty(t, e.SessionId)
+			assert.Equal(t, input.Status, e.Status)
+			assert.NotEmpty(t, e.SeenAt)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestUpdatePresenceUseCase_ShouldSendAnOfflinePresenceWithoutCheckingTheMembership(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.UpdatePresenceUseCaseInput{
+		RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		Status: valueobject.PresenceStatusOffline,
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+
+	presenceEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.PresenceEvent) {
+			assert.Equal(t, input.Status, e.Status)
+		}).
+		Return(nil).
+		Once()
+
+	useCase := NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.Nil(t, err)
+}
+
+func TestUpdatePresenceUseCase_ShouldSendTheHeartbeatsOfASessionWithoutCheckingTheMembershipAgain(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.OpenPresenceSessionUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: room.AdminId().Value(),
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(room.Id(), room.AdminId()), nil).
+		Once()
+
+	var statuses []string
+	var sessionIds []string
+
+	presenceEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Run(func(c context.Context, e *event.PresenceEvent) {
+			assert.Equal(t, input.RoomId, e.RoomId)
+			assert.Equal(t, input.UserId, e.UserId)
+			statuses = append(statuses, e.Status)
+			sessionIds = append(sessionIds, e.SessionId)
+		}).
+		Return(nil).
+		Times(4)
+
+	useCase := NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+
+	session, err := useCase.OpenSession(ctx, input)
+	assert.Nil(t, err)
+
+	assert.Nil(t, session.Update(ctx, valueobject.PresenceStatusOnline))
+	assert.Nil(t, session.Update(ctx, valueobject.PresenceStatusAway))
+	assert.Nil(t, session.Update(ctx, valueobject.PresenceStatusAway))
+	assert.Nil(t, session.Close(ctx))
+
+	expected := []string{
+		valueobject.PresenceStatusOnline,
+		valueobject.PresenceStatusAway,
+		valueobject.PresenceStatusAway,
+		valueobject.PresenceStatusOffline,
+	}
+	assert.Equal(t, expected, statuses)
+	assert.NotEmpty(t, sessionIds[0])
+
+	for _, sessionId := range sessionIds {
+		assert.Equal(t, sessionIds[0], sessionId)
+	}
+}
+
+func TestUpdatePresenceUseCase_ShouldNotOpenASessionWhenTheUserIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.OpenPresenceSessionUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+
+	session, err := useCase.OpenSession(ctx, input)
+	assert.Nil(t, session)
+	assert.ErrorIs(t, err, entity.ErrNotRoomMember)
+}
+
+func TestUpdatePresenceUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		test  string
+		input *usecase.UpdatePresenceUseCaseInput
+		err   error
+	}{
+		{
+			"empty room id",
+			&usecase.UpdatePresenceUseCaseInput{
+				RoomId: "",
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				Status: valueobject.PresenceStatusOnline,
+			},
+			valueobject.ErrRequiredId,
+		},
+		{
+			"empty user id",
+			&usecase.UpdatePresenceUseCaseInput{
+				RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId: "",
+				Status: valueobject.PresenceStatusOnline,
+			},
+			valueobject.ErrRequiredUserId,
+		},
+		{
+			"invalid status",
+			&usecase.UpdatePresenceUseCaseInput{
+				RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId: "auth0|64c8457bb160e37c8c34533b",
+				Status: "busy",
+			},
+			valueobject.ErrInvalidPresenceStatus,
+		},
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+
+	useCase := NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+
+	for _, tc := range testCases {
+		t.Run(tc.test, func(t *testing.T) {
+			err := useCase.Execute(ctx, tc.input)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestUpdatePresenceUseCase_ShouldReturnAnErrorWhenTheUserIsNotAMember(t *testing.T) {
+	adminId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	name, _ := valueobject.NewRoomNameWith("A Game")
+	category, _ := valueobject.NewRoomCategoryWith("Game")
+	room := entity.NewRoom(adminId, name, category)
+
+	ctx := context.Background()
+	input := &usecase.UpdatePresenceUseCaseInput{
+		RoomId: room.Id().Value(),
+		UserId: "auth0|64c8457bb160e37c8c34533c",
+		Status: valueobject.PresenceStatusOnline,
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(room, nil).
+		Once()
+
+	memberRepository.EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, repository.ErrNotFoundMember).
+		Once()
+
+	useCase := NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrNotRoomMember)
+}
+
+func TestUpdatePresenceUseCase_ShouldReturnAnErrorWhenTheEventIsNotSent(t *testing.T) {
+	ctx := context.Background()
+	input := &usecase.UpdatePresenceUseCaseInput{
+		RoomId: "b3588483-4795-434a-877c-dcd158d6caa7",
+		UserId: "auth0|64c8457bb160e37c8c34533b",
+		Status: valueobject.PresenceStatusOffline,
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+	presenceEventGateway := mocks.NewPresenceEventGatewayMock(t)
+
+	presenceEventGateway.EXPECT().
+		Send(mock.Anything, mock.Anything).
+		Return(errors.New("a gateway error")).
+		Once()
+
+	useCase := NewUpdatePresenceUseCase(roomRepository, memberRepository, presenceEventGateway)
+
+	err := useCase.Execute(ctx, input)
+	assert.NotNil(t, err)
+}
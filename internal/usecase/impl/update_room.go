@@ -4,21 +4,28 @@ import (
 	"context"
 	"errors"
 
+	"github.com/sesaquecruz/go-chat-api/internal/domain/entity"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/repository"
+	"github.com/sesaquecruz/go-chat-api/internal/domain/validation"
 	"github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 	"github.com/sesaquecruz/go-chat-api/internal/usecase"
 	"github.com/sesaquecruz/go-chat-api/pkg/log"
 )
 
 type UpdateRoomUseCase struct {
-	roomRepository repository.RoomRepository
-	logger         *log.Logger
+	roomRepository   repository.RoomRepository
+	memberRepository repository.MemberRepository
+	logger           *log.Logger
 }
 
-func NewUpdateRoomUseCase(roomRepository repository.RoomRepository) *UpdateRoomUseCase {
+func NewUpdateRoomUseCase(
+	roomRepository repository.RoomRepository,
+	memberRepository repository.MemberRepository,
+) *UpdateRoomUseCase {
 	return &UpdateRoomUseCase{
-		roomRepository: roomRepository,
-		logger:         log.NewLogger("UpdateRoomUseCase"),
+		roomRepository:   roomRepository,
+		memberRepository: memberRepository,
+		logger:           log.NewLogger("UpdateRoomUseCase"),
 	}
 }
 
@@ -28,7 +35,7 @@ func (u *UpdateRoomUseCase) Execute(ctx context.Context, input *usecase.UpdateRo
 		return err
 	}
 
-	adminId, err := valueobject.NewUserIdWith(input.AdminId)
+	userId, err := valueobject.NewUserIdWith(input.UserId)
 	if err != nil {
 		return err
 	}
@@ -43,6 +50,20 @@ func (u *UpdateRoomUseCase) Execute(ctx context.Context, input *usecase.UpdateRo
 		return err
 	}
 
+	var visibility *valueobject.RoomVisibility
+
+	if input.Visibility != "" {
+		visibility, err = valueobject.NewRoomVisibilityWith(input.Visibility)
+		if err != nil {
+			return err
+		}
+
+		// Direct rooms are created between two users only.
+		if visibility.IsDirect() {
+			return entity.ErrDirectRoomVisibility
+		}
+	}
+
 	room, err := u.roomRepository.FindById(ctx, id)
 	if err != nil {
 		if !errors.Is(err, repository.ErrNotFoundRoom) {
@@ -56,14 +77,26 @@ func (u *UpdateRoomUseCase) Execute(ctx context.Context, input *usecase.UpdateRo
 		return repository.ErrNotFoundRoom
 	}
 
-	err = room.ValidateAdmin(adminId)
+	if room.IsDirect() {
+		return entity.ErrDirectRoomUpdate
+	}
+
+	_, err = checkMemberPermission(ctx, u.memberRepository, room, userId, entity.PermissionUpdateRoom)
 	if err != nil {
+		if _, ok := err.(validation.UnauthorizedError); !ok {
+			u.logger.Error(err)
+		}
+
 		return err
 	}
 
 	room.UpdateName(name)
 	room.UpdateCategory(category)
 
+	if visibility != nil {
+		room.UpdateVisibility(visibility)
+	}
+
 	err = u.roomRepository.Update(ctx, room)
 	if err != nil {
 		u.logger.Error(err)
@@ -21,12 +21,12 @@ func TestUpdateRoomUseCase_ShouldUpdateARoomWhenDataIsValid(t *testing.T) {
 	category, _ := valueobject.NewRoomCategoryWith("Game")
 	createdAt := valueobject.NewTimestamp()
 	updatedAt, _ := valueobject.NewTimestampWith(createdAt.Value())
-	savedRoom := entity.NewRoomWith(id, adminId, name, category, createdAt, updatedAt, nil)
+	savedRoom := entity.NewRoomWith(id, adminId, name, category, valueobject.NewRoomVisibility(), createdAt, updatedAt, nil)
 
 	ctx := context.Background()
 	input := &usecase.UpdateRoomUseCaseInput{
 		Id:       id.Value(),
-		AdminId:  adminId.Value(),
+		UserId:   adminId.Value(),
 		Name:     "A Programming Language",
 		Category: "Tech",
 	}
@@ -49,7 +49,7 @@ func TestUpdateRoomUseCase_ShouldUpdateARoomWhenDataIsValid(t *testing.T) {
 		Run(func(c context.Context, r *entity.Room) {
 			assert.Equal(t, ctx, c)
 			assert.Equal(t, input.Id, r.Id().Value())
-			assert.Equal(t, input.AdminId, r.AdminId().Value())
+			assert.Equal(t, input.UserId, r.AdminId().Value())
 			assert.Equal(t, input.Name, r.Name().Value())
 			assert.Equal(t, input.Category, r.Category().Value())
 			assert.True(t, createdAt.Time().Equal(r.CreatedAt().Time()))
@@ -58,7 +58,20 @@ func TestUpdateRoomUseCase_ShouldUpdateARoomWhenDataIsValid(t *testing.T) {
 		Return(nil).
 		Once()
 
-	useCase := NewUpdateRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	memberRepository.
+		EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(c context.Context, r *valueobject.Id, u *valueobject.UserId) {
+			assert.Equal(t, ctx, c)
+			assert.Equal(t, input.Id, r.Value())
+			assert.Equal(t, input.UserId, u.Value())
+		}).
+		Return(entity.NewRoomOwner(savedRoom.Id(), savedRoom.AdminId()), nil).
+		Once()
+
+	useCase := NewUpdateRoomUseCase(roomRepository, memberRepository)
 
 	err := useCase.Execute(ctx, input)
 	assert.Nil(t, err)
@@ -69,6 +82,7 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 	name, _ := valueobject.NewRoomNameWith("A Game")
 	category, _ := valueobject.NewRoomCategoryWith("Game")
 	savedRoom := entity.NewRoom(adminId, name, category)
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
 
 	ctx := context.Background()
 
@@ -81,17 +95,17 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 			"empty id",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       "",
-				AdminId:  "auth0|64c8457bb160e37c8c34533c",
+				UserId:   "auth0|64c8457bb160e37c8c34533c",
 				Name:     "A Programming Language",
 				Category: "Tech",
 			},
 			valueobject.ErrRequiredId,
 		},
 		{
-			"empty admin id",
+			"empty user id",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       "b3588483-4795-434a-877c-dcd158d6caa7",
-				AdminId:  "",
+				UserId:   "",
 				Name:     "A Programming Language",
 				Category: "Tech",
 			},
@@ -101,7 +115,7 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 			"empty name",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       "b3588483-4795-434a-877c-dcd158d6caa7",
-				AdminId:  "auth0|64c8457bb160e37c8c34533c",
+				UserId:   "auth0|64c8457bb160e37c8c34533c",
 				Name:     "",
 				Category: "Tech",
 			},
@@ -111,7 +125,7 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 			"empty category",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       "b3588483-4795-434a-877c-dcd158d6caa7",
-				AdminId:  "auth0|64c8457bb160e37c8c34533c",
+				UserId:   "auth0|64c8457bb160e37c8c34533c",
 				Name:     "A Programming Language",
 				Category: "",
 			},
@@ -121,17 +135,17 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 			"invalid id",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       "fdafak12j17921",
-				AdminId:  "auth0|64c8457bb160e37c8c34533c",
+				UserId:   "auth0|64c8457bb160e37c8c34533c",
 				Name:     "A Programming Language",
 				Category: "Tech",
 			},
 			valueobject.ErrInvalidId,
 		},
 		{
-			"invalid admin id",
+			"invalid user id",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       "b3588483-4795-434a-877c-dcd158d6caa7",
-				AdminId:  "fadjkv89123192hfdf",
+				UserId:   "fadjkv89123192hfdf",
 				Name:     "A Programming Language",
 				Category: "Tech",
 			},
@@ -141,21 +155,32 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 			"invalid category",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       "b3588483-4795-434a-877c-dcd158d6caa7",
-				AdminId:  "auth0|64c8457bb160e37c8c34533c",
+				UserId:   "auth0|64c8457bb160e37c8c34533c",
 				Name:     "A Programming Language",
 				Category: "fadferiouk1j23",
 			},
 			valueobject.ErrInvalidRoomCategory,
 		},
 		{
-			"invalid room admin",
+			"direct visibility",
+			&usecase.UpdateRoomUseCaseInput{
+				Id:         "b3588483-4795-434a-877c-dcd158d6caa7",
+				UserId:     "auth0|64c8457bb160e37c8c34533c",
+				Name:       "A Programming Language",
+				Category:   "Tech",
+				Visibility: "direct",
+			},
+			entity.ErrDirectRoomVisibility,
+		},
+		{
+			"member without permission",
 			&usecase.UpdateRoomUseCaseInput{
 				Id:       savedRoom.Id().Value(),
-				AdminId:  "auth0|64c8457bb160e37c8c34533c",
+				UserId:   "auth0|64c8457bb160e37c8c34533c",
 				Name:     "A Programming Language",
 				Category: "Tech",
 			},
-			entity.ErrInvalidRoomAdmin,
+			entity.ErrMissingPermission,
 		},
 	}
 
@@ -171,7 +196,15 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 		Return(savedRoom, nil).
 		Once()
 
-	useCase := NewUpdateRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	memberRepository.
+		EXPECT().
+		FindByRoomAndUser(mock.Anything, mock.Anything, mock.Anything).
+		Return(entity.NewMember(savedRoom.Id(), userId), nil).
+		Once()
+
+	useCase := NewUpdateRoomUseCase(roomRepository, memberRepository)
 
 	for _, tc := range testCases {
 		t.Run(tc.test, func(t *testing.T) {
@@ -182,11 +215,40 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenDataIsInvalid(t *testing.T) {
 	}
 }
 
+func TestUpdateRoomUseCase_ShouldReturnAnErrorWhenTheRoomIsDirect(t *testing.T) {
+	userId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533b")
+	otherUserId, _ := valueobject.NewUserIdWith("auth0|64c8457bb160e37c8c34533c")
+	directRoom, _ := entity.NewDirectRoom(userId, otherUserId)
+
+	ctx := context.Background()
+	input := &usecase.UpdateRoomUseCaseInput{
+		Id:         directRoom.Room().Id().Value(),
+		UserId:     userId.Value(),
+		Name:       "A Programming Language",
+		Category:   "Tech",
+		Visibility: "public",
+	}
+
+	roomRepository := mocks.NewRoomRepositoryMock(t)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	roomRepository.EXPECT().
+		FindById(mock.Anything, mock.Anything).
+		Return(directRoom.Room(), nil).
+		Once()
+
+	useCase := NewUpdateRoomUseCase(roomRepository, memberRepository)
+
+	err := useCase.Execute(ctx, input)
+	assert.ErrorIs(t, err, entity.ErrDirectRoomUpdate)
+	assert.True(t, directRoom.Room().IsDirect())
+}
+
 func TestUpdateRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 	ctx := context.Background()
 	input := &usecase.UpdateRoomUseCaseInput{
 		Id:       "b3588483-4795-434a-877c-dcd158d6caa7",
-		AdminId:  "auth0|64c8457bb160e37c8c34533c",
+		UserId:   "auth0|64c8457bb160e37c8c34533c",
 		Name:     "A Programming Language",
 		Category: "Tech",
 	}
@@ -199,7 +261,9 @@ func TestUpdateRoomUseCase_ShouldReturnAnErrorOnRepositoryError(t *testing.T) {
 		Return(nil, repository.ErrNotFoundRoom).
 		Once()
 
-	useCase := NewUpdateRoomUseCase(roomRepository)
+	memberRepository := mocks.NewMemberRepositoryMock(t)
+
+	useCase := NewUpdateRoomUseCase(roomRepository, memberRepository)
 
 	err := useCase.Execute(ctx, input)
 	assert.NotNil(t, err)
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+)
+
+type JoinRoomUseCaseInput struct {
+	RoomId string
+	UserId string
+}
+
+type JoinRoomUseCase interface {
+	Execute(ctx context.Context, input *JoinRoomUseCaseInput) error
+}
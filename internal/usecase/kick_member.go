@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type KickMemberUseCaseInput struct {
+	RoomId   string
+	UserId   string
+	MemberId string
+}
+
+type KickMemberUseCase interface {
+	Execute(ctx context.Context, input *KickMemberUseCaseInput) error
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+)
+
+type LeaveRoomUseCaseInput struct {
+	RoomId string
+	UserId string
+}
+
+type LeaveRoomUseCase interface {
+	Execute(ctx context.Context, input *LeaveRoomUseCaseInput) error
+}
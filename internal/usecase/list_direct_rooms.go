@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+)
+
+type ListDirectRoomsUseCaseInput struct {
+	UserId string
+	Page   string
+	Size   string
+}
+
+type ListDirectRoomsUseCaseOutput struct {
+	RoomId      string
+	RecipientId string
+	CreatedAt   string
+	LastMessage *LastMessageOutput
+}
+
+type LastMessageOutput struct {
+	MessageId  string
+	SenderId   string
+	SenderName string
+	Text       string
+	CreatedAt  string
+	EditedAt   string
+	DeletedAt  string
+}
+
+type ListDirectRoomsUseCase interface {
+	Execute(ctx context.Context, input *ListDirectRoomsUseCaseInput) (*pagination.Page[*ListDirectRoomsUseCaseOutput], error)
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+)
+
+type ListMembersUseCaseInput struct {
+	RoomId string
+	UserId string
+	Page   string
+	Size   string
+	Sort   string
+	Search string
+}
+
+type ListMembersUseCaseOutput struct {
+	RoomId   string
+	UserId   string
+	Role     string
+	JoinedAt string
+}
+
+type ListMembersUseCase interface {
+	Execute(ctx context.Context, input *ListMembersUseCaseInput) (*pagination.Page[*ListMembersUseCaseOutput], error)
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+)
+
+type ListMessageReadersUseCaseInput struct {
+	RoomId    string
+	UserId    string
+	MessageId string
+	Page      string
+	Size      string
+}
+
+type ListMessageReadersUseCaseOutput struct {
+	UserId string
+	Role   string
+}
+
+type ListMessageReadersUseCase interface {
+	Execute(ctx context.Context, input *ListMessageReadersUseCaseInput) (*pagination.Page[*ListMessageReadersUseCaseOutput], error)
+}
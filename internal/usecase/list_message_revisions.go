@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+)
+
+type ListMessageRevisionsUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	UserId    string
+}
+
+type ListMessageRevisionsUseCaseOutput struct {
+	Id        string
+	Text      string
+	CreatedAt string
+}
+
+type ListMessageRevisionsUseCase interface {
+	Execute(ctx context.Context, input *ListMessageRevisionsUseCaseInput) ([]*ListMessageRevisionsUseCaseOutput, error)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+)
+
+type ListMessagesUseCaseInput struct {
+	RoomId    string
+	Cursor    string
+	Size      string
+	Direction string
+	UserId    string
+}
+
+type ListMessagesUseCaseOutput struct {
+	Id         string
+	RoomId     string
+	SenderId   string
+	SenderName string
+	Text       string
+	CreatedAt  string
+	EditedAt   string
+	DeletedAt  string
+	// ParentId is set on replies, ReplyCount and LastReplyAt on the messages
+	// with replies.
+	ParentId    string
+	ReplyCount  int
+	LastReplyAt string
+	Reactions   []*MessageReactionOutput
+}
+
+// MessageReactionOutput is the number of users that reacted to a message with
+// the emoji.
+type MessageReactionOutput struct {
+	Emoji string
+	Count int
+}
+
+type ListMessagesUseCase interface {
+	Execute(ctx context.Context, input *ListMessagesUseCaseInput) (*pagination.CursorPage[*ListMessagesUseCaseOutput], error)
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+)
+
+type ListOwnershipTransfersUseCaseInput struct {
+	RoomId string
+	UserId string
+}
+
+type ListOwnershipTransfersUseCaseOutput struct {
+	Id              string
+	PreviousAdminId string
+	AdminId         string
+	TransferredBy   string
+	TransferredAt   string
+}
+
+type ListOwnershipTransfersUseCase interface {
+	Execute(ctx context.Context, input *ListOwnershipTransfersUseCaseInput) ([]*ListOwnershipTransfersUseCaseOutput, error)
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+)
+
+type ListPinsUseCaseInput struct {
+	RoomId string
+	UserId string
+}
+
+type ListPinsUseCaseOutput struct {
+	MessageId  string
+	SenderId   string
+	SenderName string
+	Text       string
+	CreatedAt  string
+	EditedAt   string
+	DeletedAt  string
+	PinnedBy   string
+	PinnedAt   string
+}
+
+type ListPinsUseCase interface {
+	Execute(ctx context.Context, input *ListPinsUseCaseInput) ([]*ListPinsUseCaseOutput, error)
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+)
+
+type ListPresenceUseCaseInput struct {
+	RoomId string
+	UserId string
+}
+
+type ListPresenceUseCaseOutput struct {
+	UserId string
+	Status string
+	SeenAt string
+}
+
+type ListPresenceUseCase interface {
+	Execute(ctx context.Context, input *ListPresenceUseCaseInput) ([]*ListPresenceUseCaseOutput, error)
+}
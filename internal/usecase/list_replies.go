@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+)
+
+type ListRepliesUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	Cursor    string
+	Size      string
+	Direction string
+	UserId    string
+}
+
+type ListRepliesUseCaseOutput struct {
+	Id         string
+	RoomId     string
+	ParentId   string
+	SenderId   string
+	SenderName string
+	Text       string
+	CreatedAt  string
+	EditedAt   string
+	DeletedAt  string
+	Reactions  []*MessageReactionOutput
+}
+
+type ListRepliesUseCase interface {
+	Execute(ctx context.Context, input *ListRepliesUseCaseInput) (*pagination.CursorPage[*ListRepliesUseCaseOutput], error)
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+)
+
+type ListUserRoomsUseCaseInput struct {
+	UserId string
+	Page   string
+	Size   string
+}
+
+type ListUserRoomsUseCaseOutput struct {
+	RoomId         string
+	Name           string
+	Category       string
+	Visibility     string
+	Role           string
+	LastActivityAt string
+	UnreadCount    int64
+	LastMessage    *LastMessageOutput
+}
+
+type ListUserRoomsUseCase interface {
+	Execute(ctx context.Context, input *ListUserRoomsUseCaseInput) (*pagination.Page[*ListUserRoomsUseCaseOutput], error)
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type MarkRoomAsReadUseCaseInput struct {
+	RoomId    string
+	UserId    string
+	MessageId string
+}
+
+type MarkRoomAsReadUseCase interface {
+	Execute(ctx context.Context, input *MarkRoomAsReadUseCaseInput) error
+}
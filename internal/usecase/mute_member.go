@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+)
+
+type MuteMemberUseCaseInput struct {
+	RoomId    string
+	UserId    string
+	MemberId  string
+	ExpiresAt string
+}
+
+type MuteMemberUseCase interface {
+	Execute(ctx context.Context, input *MuteMemberUseCaseInput) error
+}
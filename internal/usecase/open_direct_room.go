@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+)
+
+type OpenDirectRoomUseCaseInput struct {
+	UserId      string
+	RecipientId string
+}
+
+type OpenDirectRoomUseCaseOutput struct {
+	RoomId      string
+	RecipientId string
+	CreatedAt   string
+	Created     bool
+}
+
+type OpenDirectRoomUseCase interface {
+	Execute(ctx context.Context, input *OpenDirectRoomUseCaseInput) (*OpenDirectRoomUseCaseOutput, error)
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type PinMessageUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	UserId    string
+}
+
+type PinMessageUseCase interface {
+	Execute(ctx context.Context, input *PinMessageUseCaseInput) error
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type PromoteMemberUseCaseInput struct {
+	RoomId   string
+	UserId   string
+	MemberId string
+}
+
+type PromoteMemberUseCase interface {
+	Execute(ctx context.Context, input *PromoteMemberUseCaseInput) error
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+)
+
+type RemoveReactionUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	UserId    string
+	Emoji     string
+}
+
+type RemoveReactionUseCase interface {
+	Execute(ctx context.Context, input *RemoveReactionUseCaseInput) error
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+)
+
+type ReplayMessagesUseCaseInput struct {
+	RoomId        string
+	LastMessageId string
+}
+
+type ReplayMessagesUseCaseOutput struct {
+	Messages []*ReplayedMessageOutput
+	// Cursor is set when the replay stopped at its limit, the messages after it
+	// are listed with the messages endpoint.
+	Cursor string
+}
+
+type ReplayedMessageOutput struct {
+	Id         string
+	RoomId     string
+	SenderId   string
+	SenderName string
+	Text       string
+	CreatedAt  string
+	EditedAt   string
+	DeletedAt  string
+	ParentId   string
+}
+
+type ReplayMessagesUseCase interface {
+	Execute(ctx context.Context, input *ReplayMessagesUseCaseInput) (*ReplayMessagesUseCaseOutput, error)
+}
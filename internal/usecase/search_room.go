@@ -7,6 +7,7 @@ import (
 )
 
 type SearchRoomUseCaseInput struct {
+	UserId string
 	Page   string
 	Size   string
 	Sort   string
@@ -14,12 +15,13 @@ type SearchRoomUseCaseInput struct {
 }
 
 type SearchRoomUseCaseOutput struct {
-	Id        string
-	AdminId   string
-	Name      string
-	Category  string
-	CreatedAt string
-	UpdatedAt string
+	Id         string
+	AdminId    string
+	Name       string
+	Category   string
+	Visibility string
+	CreatedAt  string
+	UpdatedAt  string
 }
 
 type SearchRoomUseCase interface {
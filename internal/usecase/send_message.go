@@ -9,10 +9,25 @@ type SendMessageUseCaseInput struct {
 	SenderId   string
 	SenderName string
 	Text       string
+	// ParentId is optional. It sends the message as a reply to a message of
+	// the room.
+	ParentId string
+	// IdempotencyKey is optional. Requests repeated with the same key return
+	// the message created by the first one.
+	IdempotencyKey string
 }
 
 type SendMessageUseCaseOutput struct {
-	MessageId string
+	MessageId  string
+	RoomId     string
+	SenderId   string
+	SenderName string
+	Text       string
+	CreatedAt  string
+	EditedAt   string
+	DeletedAt  string
+	ParentId   string
+	Replayed   bool
 }
 
 type SendMessageUseCase interface {
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type SendTypingUseCaseInput struct {
+	RoomId   string
+	UserId   string
+	UserName string
+}
+
+type SendTypingUseCase interface {
+	Execute(ctx context.Context, input *SendTypingUseCaseInput) error
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+)
+
+type TransferOwnershipUseCaseInput struct {
+	RoomId        string
+	UserId        string
+	AdminId       string
+	PlatformAdmin bool
+}
+
+type TransferOwnershipUseCase interface {
+	Execute(ctx context.Context, input *TransferOwnershipUseCaseInput) error
+}
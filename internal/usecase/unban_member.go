@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type UnbanMemberUseCaseInput struct {
+	RoomId   string
+	UserId   string
+	MemberId string
+}
+
+type UnbanMemberUseCase interface {
+	Execute(ctx context.Context, input *UnbanMemberUseCaseInput) error
+}
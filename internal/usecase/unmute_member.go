@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type UnmuteMemberUseCaseInput struct {
+	RoomId   string
+	UserId   string
+	MemberId string
+}
+
+type UnmuteMemberUseCase interface {
+	Execute(ctx context.Context, input *UnmuteMemberUseCaseInput) error
+}
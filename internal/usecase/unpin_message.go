@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+)
+
+type UnpinMessageUseCaseInput struct {
+	RoomId    string
+	MessageId string
+	UserId    string
+}
+
+type UnpinMessageUseCase interface {
+	Execute(ctx context.Context, input *UnpinMessageUseCaseInput) error
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+)
+
+type UpdatePresenceUseCaseInput struct {
+	RoomId string
+	UserId string
+	Status string
+}
+
+type OpenPresenceSessionUseCaseInput struct {
+	RoomId string
+	UserId string
+}
+
+// PresenceSession is the presence of a live connection. Its membership was
+// checked when it went online, so its updates are not checked again.
+type PresenceSession interface {
+	// Update sends the status of the session, it is also its heartbeat.
+	Update(ctx context.Context, status string) error
+	// Close sends the offline status of the session.
+	Close(ctx context.Context) error
+}
+
+type UpdatePresenceUseCase interface {
+	Execute(ctx context.Context, input *UpdatePresenceUseCaseInput) error
+	// OpenSession checks the membership of the user and returns a new session
+	// of the user in the room.
+	OpenSession(ctx context.Context, input *OpenPresenceSessionUseCaseInput) (PresenceSession, error)
+}
@@ -5,10 +5,11 @@ import (
 )
 
 type UpdateRoomUseCaseInput struct {
-	Id       string
-	AdminId  string
-	Name     string
-	Category string
+	Id         string
+	UserId     string
+	Name       string
+	Category   string
+	Visibility string
 }
 
 type UpdateRoomUseCase interface {
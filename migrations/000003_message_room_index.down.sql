@@ -0,0 +1 @@
+drop index if exists messages_room_id_created_at_id_idx;
\ No newline at end of file
@@ -0,0 +1 @@
+create index if not exists messages_room_id_created_at_id_idx on messages (room_id, created_at desc, id desc);
@@ -0,0 +1,12 @@
+create table if not exists outbox (
+	id varchar(36) primary key, 
+	payload jsonb not null, 
+	attempts integer not null default 0, 
+	last_error text, 
+	created_at timestamp with time zone not null, 
+	next_attempt_at timestamp with time zone not null, 
+	sent_at timestamp with time zone, 
+	dead_at timestamp with time zone
+);
+
+create index if not exists outbox_pending_idx on outbox (next_attempt_at) where sent_at is null and dead_at is null;
@@ -0,0 +1,12 @@
+create table if not exists idempotency_keys (
+	sender_id varchar(36) not null, 
+	idempotency_key varchar(255) not null, 
+	room_id varchar(36) not null references rooms(id), 
+	message_id varchar(36) not null references messages(id), 
+	request_hash varchar(64) not null, 
+	created_at timestamp with time zone not null, 
+	expires_at timestamp with time zone not null, 
+	primary key (sender_id, idempotency_key)
+);
+
+create index if not exists idempotency_keys_expires_at_idx on idempotency_keys (expires_at);
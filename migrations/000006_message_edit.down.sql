@@ -0,0 +1,3 @@
+drop table if exists message_revisions;
+
+alter table messages drop column if exists edited_at;
@@ -0,0 +1,10 @@
+alter table messages add column if not exists edited_at timestamp with time zone;
+
+create table if not exists message_revisions (
+	id varchar(36) primary key, 
+	message_id varchar(36) not null references messages(id), 
+	text varchar(100) not null, 
+	created_at timestamp with time zone not null
+);
+
+create index if not exists message_revisions_message_id_created_at_idx on message_revisions (message_id, created_at);
@@ -0,0 +1,10 @@
+create table if not exists room_members (
+	room_id varchar(36) not null references rooms(id), 
+	user_id varchar(36) not null, 
+	joined_at timestamp with time zone not null, 
+	primary key (room_id, user_id)
+);
+
+insert into room_members (room_id, user_id, joined_at) 
+select id, admin_id, created_at from rooms 
+on conflict do nothing;
@@ -0,0 +1,4 @@
+alter table rooms 
+drop column if exists visibility;
+
+drop type if exists room_visibility_enum;
@@ -0,0 +1,7 @@
+create type room_visibility_enum as ENUM (
+	'public', 
+	'private'
+);
+
+alter table rooms 
+add column if not exists visibility room_visibility_enum not null default 'public';
@@ -0,0 +1,3 @@
+drop table if exists room_invitations;
+
+drop type if exists room_invitation_status_enum;
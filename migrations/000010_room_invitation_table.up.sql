@@ -0,0 +1,17 @@
+create type room_invitation_status_enum as ENUM (
+	'pending', 
+	'accepted', 
+	'declined'
+);
+
+create table if not exists room_invitations (
+	id varchar(36) primary key, 
+	room_id varchar(36) not null references rooms(id), 
+	inviter_id varchar(36) not null, 
+	invitee_id varchar(36) not null, 
+	token varchar(64) not null unique, 
+	status room_invitation_status_enum not null, 
+	created_at timestamp with time zone not null, 
+	expires_at timestamp with time zone not null, 
+	answered_at timestamp with time zone null
+);
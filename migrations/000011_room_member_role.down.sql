@@ -0,0 +1,4 @@
+alter table room_members 
+drop column if exists role;
+
+drop type if exists room_member_role_enum;
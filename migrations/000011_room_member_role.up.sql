@@ -0,0 +1,12 @@
+create type room_member_role_enum as ENUM (
+	'owner', 
+	'moderator', 
+	'member'
+);
+
+alter table room_members 
+add column if not exists role room_member_role_enum not null default 'member';
+
+update room_members set role = 'owner' 
+from rooms 
+where rooms.id = room_members.room_id and rooms.admin_id = room_members.user_id;
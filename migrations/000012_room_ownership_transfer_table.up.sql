@@ -0,0 +1,10 @@
+create table if not exists room_ownership_transfers (
+	id varchar(36) primary key, 
+	room_id varchar(36) not null references rooms(id), 
+	previous_admin_id varchar(36) not null, 
+	admin_id varchar(36) not null, 
+	transferred_by varchar(36) not null, 
+	transferred_at timestamp with time zone not null
+);
+
+create index if not exists room_ownership_transfers_room_id_transferred_at_idx on room_ownership_transfers (room_id, transferred_at);
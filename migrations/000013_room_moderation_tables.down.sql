@@ -0,0 +1,2 @@
+drop table if exists room_mutes;
+drop table if exists room_bans;
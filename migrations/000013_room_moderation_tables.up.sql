@@ -0,0 +1,17 @@
+create table if not exists room_bans (
+	room_id varchar(36) not null references rooms(id), 
+	user_id varchar(36) not null, 
+	banned_by varchar(36) not null, 
+	created_at timestamp with time zone not null, 
+	expires_at timestamp with time zone null, 
+	primary key (room_id, user_id)
+);
+
+create table if not exists room_mutes (
+	room_id varchar(36) not null references rooms(id), 
+	user_id varchar(36) not null, 
+	muted_by varchar(36) not null, 
+	created_at timestamp with time zone not null, 
+	expires_at timestamp with time zone not null, 
+	primary key (room_id, user_id)
+);
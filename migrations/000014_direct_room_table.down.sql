@@ -0,0 +1,16 @@
+drop table if exists direct_rooms;
+
+update rooms set visibility = 'private' where visibility = 'direct';
+
+alter table rooms alter column visibility drop default;
+alter type room_visibility_enum rename to room_visibility_enum_old;
+
+create type room_visibility_enum as ENUM (
+	'public', 
+	'private'
+);
+
+alter table rooms alter column visibility type room_visibility_enum using visibility::text::room_visibility_enum;
+alter table rooms alter column visibility set default 'public';
+
+drop type if exists room_visibility_enum_old;
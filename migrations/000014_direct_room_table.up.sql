@@ -0,0 +1,8 @@
+alter type room_visibility_enum add value if not exists 'direct';
+
+create table if not exists direct_rooms (
+	room_id varchar(36) primary key references rooms(id), 
+	first_user_id varchar(36) not null, 
+	second_user_id varchar(36) not null, 
+	unique (first_user_id, second_user_id)
+);
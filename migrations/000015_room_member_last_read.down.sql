@@ -0,0 +1,3 @@
+drop index if exists room_members_user_id_idx;
+
+alter table room_members drop column if exists last_read_message_id;
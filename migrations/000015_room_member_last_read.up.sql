@@ -0,0 +1,4 @@
+alter table room_members 
+add column if not exists last_read_message_id varchar(36) references messages(id);
+
+create index if not exists room_members_user_id_idx on room_members (user_id);
@@ -0,0 +1,3 @@
+drop index if exists messages_parent_id_idx;
+
+alter table messages drop column if exists parent_id;
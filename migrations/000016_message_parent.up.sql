@@ -0,0 +1,4 @@
+alter table messages
+add column if not exists parent_id varchar(36) references messages(id);
+
+create index if not exists messages_parent_id_idx on messages (parent_id, created_at, id);
@@ -0,0 +1,7 @@
+create table if not exists message_reactions (
+	message_id varchar(36) not null references messages(id), 
+	user_id varchar(36) not null, 
+	emoji varchar(32) not null, 
+	created_at timestamp with time zone not null, 
+	primary key (message_id, user_id, emoji)
+);
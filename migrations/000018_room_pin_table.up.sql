@@ -0,0 +1,7 @@
+create table if not exists room_pins (
+	room_id varchar(36) not null references rooms(id), 
+	message_id varchar(36) not null references messages(id), 
+	pinned_by varchar(36) not null, 
+	created_at timestamp with time zone not null, 
+	primary key (room_id, message_id)
+);
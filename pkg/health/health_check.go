@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hellofresh/health-go/v5"
-	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 const serviceName = "chat-api"
@@ -21,11 +20,16 @@ type Health interface {
 	Handler() http.Handler
 }
 
+type BrokerConnection interface {
+	IsClosed() bool
+	IsReconnecting() bool
+}
+
 type HealthCheck struct {
 	health *health.Health
 }
 
-func NewHealthCheck(db *sql.DB, conn *amqp.Connection) *HealthCheck {
+func NewHealthCheck(db *sql.DB, conn BrokerConnection) *HealthCheck {
 	h, _ := health.New(health.WithComponent(health.Component{
 		Name:    serviceName,
 		Version: serviceVersion,
@@ -47,6 +51,10 @@ func NewHealthCheck(db *sql.DB, conn *amqp.Connection) *HealthCheck {
 		Name:    "broker",
 		Timeout: brokerTimeout,
 		Check: func(context.Context) error {
+			if conn.IsReconnecting() {
+				return errors.New("connection reconnecting")
+			}
+
 			if conn.IsClosed() {
 				return errors.New("connection closed")
 			}
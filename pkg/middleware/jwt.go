@@ -15,19 +15,25 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// PlatformAdminPermission is granted to the platform administrators, who can
+// manage any room.
+const PlatformAdminPermission = "admin:rooms"
+
 type JwtAllClaims struct {
-	Issuer    string
-	Subject   string
-	Audience  []string
-	Expiry    int64
-	NotBefore int64
-	IssuedAt  int64
-	ID        string
-	Nickname  string
+	Issuer      string
+	Subject     string
+	Audience    []string
+	Expiry      int64
+	NotBefore   int64
+	IssuedAt    int64
+	ID          string
+	Nickname    string
+	Permissions []string
 }
 
 type JwtCustomClaims struct {
-	Nickname string `json:"https://nickname.com"`
+	Nickname    string   `json:"https://nickname.com"`
+	Permissions []string `json:"permissions"`
 }
 
 func (c *JwtCustomClaims) Validate(ctx context.Context) error {
@@ -95,13 +101,24 @@ func JwtClaims(c *gin.Context) (*JwtAllClaims, error) {
 	custom := claims.CustomClaims.(*JwtCustomClaims)
 
 	return &JwtAllClaims{
-		Issuer:    registered.Issuer,
-		Subject:   registered.Subject,
-		Audience:  registered.Audience,
-		Expiry:    registered.Expiry,
-		NotBefore: registered.NotBefore,
-		IssuedAt:  registered.IssuedAt,
-		ID:        registered.ID,
-		Nickname:  custom.Nickname,
+		Issuer:      registered.Issuer,
+		Subject:     registered.Subject,
+		Audience:    registered.Audience,
+		Expiry:      registered.Expiry,
+		NotBefore:   registered.NotBefore,
+		IssuedAt:    registered.IssuedAt,
+		ID:          registered.ID,
+		Nickname:    custom.Nickname,
+		Permissions: custom.Permissions,
 	}, nil
 }
+
+func (c *JwtAllClaims) IsPlatformAdmin() bool {
+	for _, permission := range c.Permissions {
+		if permission == PlatformAdminPermission {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,181 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// BanRepositoryMock is an autogenerated mock type for the BanRepository type
+type BanRepositoryMock struct {
+	mock.Mock
+}
+
+type BanRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BanRepositoryMock) EXPECT() *BanRepositoryMock_Expecter {
+	return &BanRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, ban
+func (_m *BanRepositoryMock) Delete(ctx context.Context, ban *entity.Ban) error {
+	ret := _m.Called(ctx, ban)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Ban) error); ok {
+		r0 = rf(ctx, ban)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BanRepositoryMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type BanRepositoryMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ban *entity.Ban
+func (_e *BanRepositoryMock_Expecter) Delete(ctx interface{}, ban interface{}) *BanRepositoryMock_Delete_Call {
+	return &BanRepositoryMock_Delete_Call{Call: _e.mock.On("Delete", ctx, ban)}
+}
+
+func (_c *BanRepositoryMock_Delete_Call) Run(run func(ctx context.Context, ban *entity.Ban)) *BanRepositoryMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Ban))
+	})
+	return _c
+}
+
+func (_c *BanRepositoryMock_Delete_Call) Return(_a0 error) *BanRepositoryMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BanRepositoryMock_Delete_Call) RunAndReturn(run func(context.Context, *entity.Ban) error) *BanRepositoryMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByRoomAndUser provides a mock function with given fields: ctx, roomId, userId
+func (_m *BanRepositoryMock) FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Ban, error) {
+	ret := _m.Called(ctx, roomId, userId)
+
+	var r0 *entity.Ban
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Ban, error)); ok {
+		return rf(ctx, roomId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) *entity.Ban); ok {
+		r0 = rf(ctx, roomId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Ban)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id, *valueobject.UserId) error); ok {
+		r1 = rf(ctx, roomId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BanRepositoryMock_FindByRoomAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRoomAndUser'
+type BanRepositoryMock_FindByRoomAndUser_Call struct {
+	*mock.Call
+}
+
+// FindByRoomAndUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+//   - userId *valueobject.UserId
+func (_e *BanRepositoryMock_Expecter) FindByRoomAndUser(ctx interface{}, roomId interface{}, userId interface{}) *BanRepositoryMock_FindByRoomAndUser_Call {
+	return &BanRepositoryMock_FindByRoomAndUser_Call{Call: _e.mock.On("FindByRoomAndUser", ctx, roomId, userId)}
+}
+
+func (_c *BanRepositoryMock_FindByRoomAndUser_Call) Run(run func(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId)) *BanRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id), args[2].(*valueobject.UserId))
+	})
+	return _c
+}
+
+func (_c *BanRepositoryMock_FindByRoomAndUser_Call) Return(_a0 *entity.Ban, _a1 error) *BanRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BanRepositoryMock_FindByRoomAndUser_Call) RunAndReturn(run func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Ban, error)) *BanRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, ban
+func (_m *BanRepositoryMock) Save(ctx context.Context, ban *entity.Ban) error {
+	ret := _m.Called(ctx, ban)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Ban) error); ok {
+		r0 = rf(ctx, ban)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BanRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type BanRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ban *entity.Ban
+func (_e *BanRepositoryMock_Expecter) Save(ctx interface{}, ban interface{}) *BanRepositoryMock_Save_Call {
+	return &BanRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, ban)}
+}
+
+func (_c *BanRepositoryMock_Save_Call) Run(run func(ctx context.Context, ban *entity.Ban)) *BanRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Ban))
+	})
+	return _c
+}
+
+func (_c *BanRepositoryMock_Save_Call) Return(_a0 error) *BanRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BanRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.Ban) error) *BanRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBanRepositoryMock creates a new instance of BanRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBanRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BanRepositoryMock {
+	mock := &BanRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
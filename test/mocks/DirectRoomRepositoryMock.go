@@ -0,0 +1,196 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	pagination "github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// DirectRoomRepositoryMock is an autogenerated mock type for the DirectRoomRepository type
+type DirectRoomRepositoryMock struct {
+	mock.Mock
+}
+
+type DirectRoomRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DirectRoomRepositoryMock) EXPECT() *DirectRoomRepositoryMock_Expecter {
+	return &DirectRoomRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// FindByUsers provides a mock function with given fields: ctx, userId, otherUserId
+func (_m *DirectRoomRepositoryMock) FindByUsers(ctx context.Context, userId *valueobject.UserId, otherUserId *valueobject.UserId) (*entity.DirectRoom, error) {
+	ret := _m.Called(ctx, userId, otherUserId)
+
+	var r0 *entity.DirectRoom
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *valueobject.UserId) (*entity.DirectRoom, error)); ok {
+		return rf(ctx, userId, otherUserId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *valueobject.UserId) *entity.DirectRoom); ok {
+		r0 = rf(ctx, userId, otherUserId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.DirectRoom)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.UserId, *valueobject.UserId) error); ok {
+		r1 = rf(ctx, userId, otherUserId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectRoomRepositoryMock_FindByUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUsers'
+type DirectRoomRepositoryMock_FindByUsers_Call struct {
+	*mock.Call
+}
+
+// FindByUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId *valueobject.UserId
+//   - otherUserId *valueobject.UserId
+func (_e *DirectRoomRepositoryMock_Expecter) FindByUsers(ctx interface{}, userId interface{}, otherUserId interface{}) *DirectRoomRepositoryMock_FindByUsers_Call {
+	return &DirectRoomRepositoryMock_FindByUsers_Call{Call: _e.mock.On("FindByUsers", ctx, userId, otherUserId)}
+}
+
+func (_c *DirectRoomRepositoryMock_FindByUsers_Call) Run(run func(ctx context.Context, userId *valueobject.UserId, otherUserId *valueobject.UserId)) *DirectRoomRepositoryMock_FindByUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.UserId), args[2].(*valueobject.UserId))
+	})
+	return _c
+}
+
+func (_c *DirectRoomRepositoryMock_FindByUsers_Call) Return(_a0 *entity.DirectRoom, _a1 error) *DirectRoomRepositoryMock_FindByUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectRoomRepositoryMock_FindByUsers_Call) RunAndReturn(run func(context.Context, *valueobject.UserId, *valueobject.UserId) (*entity.DirectRoom, error)) *DirectRoomRepositoryMock_FindByUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUser provides a mock function with given fields: ctx, userId, query
+func (_m *DirectRoomRepositoryMock) ListByUser(ctx context.Context, userId *valueobject.UserId, query *pagination.Query) (*pagination.Page[*entity.DirectRoom], error) {
+	ret := _m.Called(ctx, userId, query)
+
+	var r0 *pagination.Page[*entity.DirectRoom]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *pagination.Query) (*pagination.Page[*entity.DirectRoom], error)); ok {
+		return rf(ctx, userId, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *pagination.Query) *pagination.Page[*entity.DirectRoom]); ok {
+		r0 = rf(ctx, userId, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Page[*entity.DirectRoom])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.UserId, *pagination.Query) error); ok {
+		r1 = rf(ctx, userId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DirectRoomRepositoryMock_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type DirectRoomRepositoryMock_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId *valueobject.UserId
+//   - query *pagination.Query
+func (_e *DirectRoomRepositoryMock_Expecter) ListByUser(ctx interface{}, userId interface{}, query interface{}) *DirectRoomRepositoryMock_ListByUser_Call {
+	return &DirectRoomRepositoryMock_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userId, query)}
+}
+
+func (_c *DirectRoomRepositoryMock_ListByUser_Call) Run(run func(ctx context.Context, userId *valueobject.UserId, query *pagination.Query)) *DirectRoomRepositoryMock_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.UserId), args[2].(*pagination.Query))
+	})
+	return _c
+}
+
+func (_c *DirectRoomRepositoryMock_ListByUser_Call) Return(_a0 *pagination.Page[*entity.DirectRoom], _a1 error) *DirectRoomRepositoryMock_ListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DirectRoomRepositoryMock_ListByUser_Call) RunAndReturn(run func(context.Context, *valueobject.UserId, *pagination.Query) (*pagination.Page[*entity.DirectRoom], error)) *DirectRoomRepositoryMock_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, directRoom
+func (_m *DirectRoomRepositoryMock) Save(ctx context.Context, directRoom *entity.DirectRoom) error {
+	ret := _m.Called(ctx, directRoom)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.DirectRoom) error); ok {
+		r0 = rf(ctx, directRoom)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DirectRoomRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type DirectRoomRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - directRoom *entity.DirectRoom
+func (_e *DirectRoomRepositoryMock_Expecter) Save(ctx interface{}, directRoom interface{}) *DirectRoomRepositoryMock_Save_Call {
+	return &DirectRoomRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, directRoom)}
+}
+
+func (_c *DirectRoomRepositoryMock_Save_Call) Run(run func(ctx context.Context, directRoom *entity.DirectRoom)) *DirectRoomRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.DirectRoom))
+	})
+	return _c
+}
+
+func (_c *DirectRoomRepositoryMock_Save_Call) Return(_a0 error) *DirectRoomRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DirectRoomRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.DirectRoom) error) *DirectRoomRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDirectRoomRepositoryMock creates a new instance of DirectRoomRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDirectRoomRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DirectRoomRepositoryMock {
+	mock := &DirectRoomRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
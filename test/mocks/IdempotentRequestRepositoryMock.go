@@ -0,0 +1,138 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// IdempotentRequestRepositoryMock is an autogenerated mock type for the IdempotentRequestRepository type
+type IdempotentRequestRepositoryMock struct {
+	mock.Mock
+}
+
+type IdempotentRequestRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotentRequestRepositoryMock) EXPECT() *IdempotentRequestRepositoryMock_Expecter {
+	return &IdempotentRequestRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// FindByKey provides a mock function with given fields: ctx, senderId, key
+func (_m *IdempotentRequestRepositoryMock) FindByKey(ctx context.Context, senderId *valueobject.UserId, key *valueobject.IdempotencyKey) (*entity.IdempotentRequest, error) {
+	ret := _m.Called(ctx, senderId, key)
+
+	var r0 *entity.IdempotentRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *valueobject.IdempotencyKey) (*entity.IdempotentRequest, error)); ok {
+		return rf(ctx, senderId, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *valueobject.IdempotencyKey) *entity.IdempotentRequest); ok {
+		r0 = rf(ctx, senderId, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.IdempotentRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.UserId, *valueobject.IdempotencyKey) error); ok {
+		r1 = rf(ctx, senderId, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotentRequestRepositoryMock_FindByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKey'
+type IdempotentRequestRepositoryMock_FindByKey_Call struct {
+	*mock.Call
+}
+
+// FindByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - senderId *valueobject.UserId
+//   - key *valueobject.IdempotencyKey
+func (_e *IdempotentRequestRepositoryMock_Expecter) FindByKey(ctx interface{}, senderId interface{}, key interface{}) *IdempotentRequestRepositoryMock_FindByKey_Call {
+	return &IdempotentRequestRepositoryMock_FindByKey_Call{Call: _e.mock.On("FindByKey", ctx, senderId, key)}
+}
+
+func (_c *IdempotentRequestRepositoryMock_FindByKey_Call) Run(run func(ctx context.Context, senderId *valueobject.UserId, key *valueobject.IdempotencyKey)) *IdempotentRequestRepositoryMock_FindByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.UserId), args[2].(*valueobject.IdempotencyKey))
+	})
+	return _c
+}
+
+func (_c *IdempotentRequestRepositoryMock_FindByKey_Call) Return(_a0 *entity.IdempotentRequest, _a1 error) *IdempotentRequestRepositoryMock_FindByKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotentRequestRepositoryMock_FindByKey_Call) RunAndReturn(run func(context.Context, *valueobject.UserId, *valueobject.IdempotencyKey) (*entity.IdempotentRequest, error)) *IdempotentRequestRepositoryMock_FindByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, request
+func (_m *IdempotentRequestRepositoryMock) Save(ctx context.Context, request *entity.IdempotentRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.IdempotentRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotentRequestRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type IdempotentRequestRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *entity.IdempotentRequest
+func (_e *IdempotentRequestRepositoryMock_Expecter) Save(ctx interface{}, request interface{}) *IdempotentRequestRepositoryMock_Save_Call {
+	return &IdempotentRequestRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, request)}
+}
+
+func (_c *IdempotentRequestRepositoryMock_Save_Call) Run(run func(ctx context.Context, request *entity.IdempotentRequest)) *IdempotentRequestRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.IdempotentRequest))
+	})
+	return _c
+}
+
+func (_c *IdempotentRequestRepositoryMock_Save_Call) Return(_a0 error) *IdempotentRequestRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotentRequestRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.IdempotentRequest) error) *IdempotentRequestRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotentRequestRepositoryMock creates a new instance of IdempotentRequestRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotentRequestRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotentRequestRepositoryMock {
+	mock := &IdempotentRequestRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,180 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// InvitationRepositoryMock is an autogenerated mock type for the InvitationRepository type
+type InvitationRepositoryMock struct {
+	mock.Mock
+}
+
+type InvitationRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvitationRepositoryMock) EXPECT() *InvitationRepositoryMock_Expecter {
+	return &InvitationRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// FindByToken provides a mock function with given fields: ctx, token
+func (_m *InvitationRepositoryMock) FindByToken(ctx context.Context, token *valueobject.InvitationToken) (*entity.Invitation, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 *entity.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.InvitationToken) (*entity.Invitation, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.InvitationToken) *entity.Invitation); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.InvitationToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationRepositoryMock_FindByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByToken'
+type InvitationRepositoryMock_FindByToken_Call struct {
+	*mock.Call
+}
+
+// FindByToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *valueobject.InvitationToken
+func (_e *InvitationRepositoryMock_Expecter) FindByToken(ctx interface{}, token interface{}) *InvitationRepositoryMock_FindByToken_Call {
+	return &InvitationRepositoryMock_FindByToken_Call{Call: _e.mock.On("FindByToken", ctx, token)}
+}
+
+func (_c *InvitationRepositoryMock_FindByToken_Call) Run(run func(ctx context.Context, token *valueobject.InvitationToken)) *InvitationRepositoryMock_FindByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.InvitationToken))
+	})
+	return _c
+}
+
+func (_c *InvitationRepositoryMock_FindByToken_Call) Return(_a0 *entity.Invitation, _a1 error) *InvitationRepositoryMock_FindByToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationRepositoryMock_FindByToken_Call) RunAndReturn(run func(context.Context, *valueobject.InvitationToken) (*entity.Invitation, error)) *InvitationRepositoryMock_FindByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, invitation
+func (_m *InvitationRepositoryMock) Save(ctx context.Context, invitation *entity.Invitation) error {
+	ret := _m.Called(ctx, invitation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Invitation) error); ok {
+		r0 = rf(ctx, invitation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type InvitationRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitation *entity.Invitation
+func (_e *InvitationRepositoryMock_Expecter) Save(ctx interface{}, invitation interface{}) *InvitationRepositoryMock_Save_Call {
+	return &InvitationRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, invitation)}
+}
+
+func (_c *InvitationRepositoryMock_Save_Call) Run(run func(ctx context.Context, invitation *entity.Invitation)) *InvitationRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Invitation))
+	})
+	return _c
+}
+
+func (_c *InvitationRepositoryMock_Save_Call) Return(_a0 error) *InvitationRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.Invitation) error) *InvitationRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, invitation
+func (_m *InvitationRepositoryMock) Update(ctx context.Context, invitation *entity.Invitation) error {
+	ret := _m.Called(ctx, invitation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Invitation) error); ok {
+		r0 = rf(ctx, invitation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationRepositoryMock_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type InvitationRepositoryMock_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitation *entity.Invitation
+func (_e *InvitationRepositoryMock_Expecter) Update(ctx interface{}, invitation interface{}) *InvitationRepositoryMock_Update_Call {
+	return &InvitationRepositoryMock_Update_Call{Call: _e.mock.On("Update", ctx, invitation)}
+}
+
+func (_c *InvitationRepositoryMock_Update_Call) Run(run func(ctx context.Context, invitation *entity.Invitation)) *InvitationRepositoryMock_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Invitation))
+	})
+	return _c
+}
+
+func (_c *InvitationRepositoryMock_Update_Call) Return(_a0 error) *InvitationRepositoryMock_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationRepositoryMock_Update_Call) RunAndReturn(run func(context.Context, *entity.Invitation) error) *InvitationRepositoryMock_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvitationRepositoryMock creates a new instance of InvitationRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvitationRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvitationRepositoryMock {
+	mock := &InvitationRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,444 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	pagination "github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// MemberRepositoryMock is an autogenerated mock type for the MemberRepository type
+type MemberRepositoryMock struct {
+	mock.Mock
+}
+
+type MemberRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MemberRepositoryMock) EXPECT() *MemberRepositoryMock_Expecter {
+	return &MemberRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CountByRoom provides a mock function with given fields: ctx, roomId
+func (_m *MemberRepositoryMock) CountByRoom(ctx context.Context, roomId *valueobject.Id) (int64, error) {
+	ret := _m.Called(ctx, roomId)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) (int64, error)); ok {
+		return rf(ctx, roomId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) int64); ok {
+		r0 = rf(ctx, roomId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id) error); ok {
+		r1 = rf(ctx, roomId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MemberRepositoryMock_CountByRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByRoom'
+type MemberRepositoryMock_CountByRoom_Call struct {
+	*mock.Call
+}
+
+// CountByRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+func (_e *MemberRepositoryMock_Expecter) CountByRoom(ctx interface{}, roomId interface{}) *MemberRepositoryMock_CountByRoom_Call {
+	return &MemberRepositoryMock_CountByRoom_Call{Call: _e.mock.On("CountByRoom", ctx, roomId)}
+}
+
+func (_c *MemberRepositoryMock_CountByRoom_Call) Run(run func(ctx context.Context, roomId *valueobject.Id)) *MemberRepositoryMock_CountByRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_CountByRoom_Call) Return(_a0 int64, _a1 error) *MemberRepositoryMock_CountByRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_CountByRoom_Call) RunAndReturn(run func(context.Context, *valueobject.Id) (int64, error)) *MemberRepositoryMock_CountByRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, member
+func (_m *MemberRepositoryMock) Delete(ctx context.Context, member *entity.Member) error {
+	ret := _m.Called(ctx, member)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MemberRepositoryMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MemberRepositoryMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *entity.Member
+func (_e *MemberRepositoryMock_Expecter) Delete(ctx interface{}, member interface{}) *MemberRepositoryMock_Delete_Call {
+	return &MemberRepositoryMock_Delete_Call{Call: _e.mock.On("Delete", ctx, member)}
+}
+
+func (_c *MemberRepositoryMock_Delete_Call) Run(run func(ctx context.Context, member *entity.Member)) *MemberRepositoryMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Member))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_Delete_Call) Return(_a0 error) *MemberRepositoryMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_Delete_Call) RunAndReturn(run func(context.Context, *entity.Member) error) *MemberRepositoryMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByRoomAndUser provides a mock function with given fields: ctx, roomId, userId
+func (_m *MemberRepositoryMock) FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Member, error) {
+	ret := _m.Called(ctx, roomId, userId)
+
+	var r0 *entity.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Member, error)); ok {
+		return rf(ctx, roomId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) *entity.Member); ok {
+		r0 = rf(ctx, roomId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id, *valueobject.UserId) error); ok {
+		r1 = rf(ctx, roomId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MemberRepositoryMock_FindByRoomAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRoomAndUser'
+type MemberRepositoryMock_FindByRoomAndUser_Call struct {
+	*mock.Call
+}
+
+// FindByRoomAndUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+//   - userId *valueobject.UserId
+func (_e *MemberRepositoryMock_Expecter) FindByRoomAndUser(ctx interface{}, roomId interface{}, userId interface{}) *MemberRepositoryMock_FindByRoomAndUser_Call {
+	return &MemberRepositoryMock_FindByRoomAndUser_Call{Call: _e.mock.On("FindByRoomAndUser", ctx, roomId, userId)}
+}
+
+func (_c *MemberRepositoryMock_FindByRoomAndUser_Call) Run(run func(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId)) *MemberRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id), args[2].(*valueobject.UserId))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_FindByRoomAndUser_Call) Return(_a0 *entity.Member, _a1 error) *MemberRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_FindByRoomAndUser_Call) RunAndReturn(run func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Member, error)) *MemberRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByRoom provides a mock function with given fields: ctx, roomId, query
+func (_m *MemberRepositoryMock) ListByRoom(ctx context.Context, roomId *valueobject.Id, query *pagination.Query) (*pagination.Page[*entity.Member], error) {
+	ret := _m.Called(ctx, roomId, query)
+
+	var r0 *pagination.Page[*entity.Member]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *pagination.Query) (*pagination.Page[*entity.Member], error)); ok {
+		return rf(ctx, roomId, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *pagination.Query) *pagination.Page[*entity.Member]); ok {
+		r0 = rf(ctx, roomId, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Page[*entity.Member])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id, *pagination.Query) error); ok {
+		r1 = rf(ctx, roomId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MemberRepositoryMock_ListByRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByRoom'
+type MemberRepositoryMock_ListByRoom_Call struct {
+	*mock.Call
+}
+
+// ListByRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+//   - query *pagination.Query
+func (_e *MemberRepositoryMock_Expecter) ListByRoom(ctx interface{}, roomId interface{}, query interface{}) *MemberRepositoryMock_ListByRoom_Call {
+	return &MemberRepositoryMock_ListByRoom_Call{Call: _e.mock.On("ListByRoom", ctx, roomId, query)}
+}
+
+func (_c *MemberRepositoryMock_ListByRoom_Call) Run(run func(ctx context.Context, roomId *valueobject.Id, query *pagination.Query)) *MemberRepositoryMock_ListByRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id), args[2].(*pagination.Query))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_ListByRoom_Call) Return(_a0 *pagination.Page[*entity.Member], _a1 error) *MemberRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_ListByRoom_Call) RunAndReturn(run func(context.Context, *valueobject.Id, *pagination.Query) (*pagination.Page[*entity.Member], error)) *MemberRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReaders provides a mock function with given fields: ctx, message, query
+func (_m *MemberRepositoryMock) ListReaders(ctx context.Context, message *entity.Message, query *pagination.Query) (*pagination.Page[*entity.Member], error) {
+	ret := _m.Called(ctx, message, query)
+
+	var r0 *pagination.Page[*entity.Member]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Message, *pagination.Query) (*pagination.Page[*entity.Member], error)); ok {
+		return rf(ctx, message, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Message, *pagination.Query) *pagination.Page[*entity.Member]); ok {
+		r0 = rf(ctx, message, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Page[*entity.Member])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Message, *pagination.Query) error); ok {
+		r1 = rf(ctx, message, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MemberRepositoryMock_ListReaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReaders'
+type MemberRepositoryMock_ListReaders_Call struct {
+	*mock.Call
+}
+
+// ListReaders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *entity.Message
+//   - query *pagination.Query
+func (_e *MemberRepositoryMock_Expecter) ListReaders(ctx interface{}, message interface{}, query interface{}) *MemberRepositoryMock_ListReaders_Call {
+	return &MemberRepositoryMock_ListReaders_Call{Call: _e.mock.On("ListReaders", ctx, message, query)}
+}
+
+func (_c *MemberRepositoryMock_ListReaders_Call) Run(run func(ctx context.Context, message *entity.Message, query *pagination.Query)) *MemberRepositoryMock_ListReaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Message), args[2].(*pagination.Query))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_ListReaders_Call) Return(_a0 *pagination.Page[*entity.Member], _a1 error) *MemberRepositoryMock_ListReaders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_ListReaders_Call) RunAndReturn(run func(context.Context, *entity.Message, *pagination.Query) (*pagination.Page[*entity.Member], error)) *MemberRepositoryMock_ListReaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, member
+func (_m *MemberRepositoryMock) Save(ctx context.Context, member *entity.Member) error {
+	ret := _m.Called(ctx, member)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MemberRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MemberRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *entity.Member
+func (_e *MemberRepositoryMock_Expecter) Save(ctx interface{}, member interface{}) *MemberRepositoryMock_Save_Call {
+	return &MemberRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, member)}
+}
+
+func (_c *MemberRepositoryMock_Save_Call) Run(run func(ctx context.Context, member *entity.Member)) *MemberRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Member))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_Save_Call) Return(_a0 error) *MemberRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.Member) error) *MemberRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, member
+func (_m *MemberRepositoryMock) Update(ctx context.Context, member *entity.Member) error {
+	ret := _m.Called(ctx, member)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Member) error); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MemberRepositoryMock_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MemberRepositoryMock_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *entity.Member
+func (_e *MemberRepositoryMock_Expecter) Update(ctx interface{}, member interface{}) *MemberRepositoryMock_Update_Call {
+	return &MemberRepositoryMock_Update_Call{Call: _e.mock.On("Update", ctx, member)}
+}
+
+func (_c *MemberRepositoryMock_Update_Call) Run(run func(ctx context.Context, member *entity.Member)) *MemberRepositoryMock_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Member))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_Update_Call) Return(_a0 error) *MemberRepositoryMock_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_Update_Call) RunAndReturn(run func(context.Context, *entity.Member) error) *MemberRepositoryMock_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastRead provides a mock function with given fields: ctx, member
+func (_m *MemberRepositoryMock) UpdateLastRead(ctx context.Context, member *entity.Member) (bool, error) {
+	ret := _m.Called(ctx, member)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Member) (bool, error)); ok {
+		return rf(ctx, member)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Member) bool); ok {
+		r0 = rf(ctx, member)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Member) error); ok {
+		r1 = rf(ctx, member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MemberRepositoryMock_UpdateLastRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastRead'
+type MemberRepositoryMock_UpdateLastRead_Call struct {
+	*mock.Call
+}
+
+// UpdateLastRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *entity.Member
+func (_e *MemberRepositoryMock_Expecter) UpdateLastRead(ctx interface{}, member interface{}) *MemberRepositoryMock_UpdateLastRead_Call {
+	return &MemberRepositoryMock_UpdateLastRead_Call{Call: _e.mock.On("UpdateLastRead", ctx, member)}
+}
+
+func (_c *MemberRepositoryMock_UpdateLastRead_Call) Run(run func(ctx context.Context, member *entity.Member)) *MemberRepositoryMock_UpdateLastRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Member))
+	})
+	return _c
+}
+
+func (_c *MemberRepositoryMock_UpdateLastRead_Call) Return(_a0 bool, _a1 error) *MemberRepositoryMock_UpdateLastRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MemberRepositoryMock_UpdateLastRead_Call) RunAndReturn(run func(context.Context, *entity.Member) (bool, error)) *MemberRepositoryMock_UpdateLastRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMemberRepositoryMock creates a new instance of MemberRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMemberRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MemberRepositoryMock {
+	mock := &MemberRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,13 +23,13 @@ func (_m *MessageEventGatewayMock) EXPECT() *MessageEventGatewayMock_Expecter {
 	return &MessageEventGatewayMock_Expecter{mock: &_m.Mock}
 }
 
-// Receive provides a mock function with given fields: ctx, messageEvents
-func (_m *MessageEventGatewayMock) Receive(ctx context.Context, messageEvents chan<- *event.MessageEvent) error {
-	ret := _m.Called(ctx, messageEvents)
+// Receive provides a mock function with given fields: ctx, roomId, messageEvents, ready
+func (_m *MessageEventGatewayMock) Receive(ctx context.Context, roomId string, messageEvents chan<- *event.MessageEvent, ready func()) error {
+	ret := _m.Called(ctx, roomId, messageEvents, ready)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, chan<- *event.MessageEvent) error); ok {
-		r0 = rf(ctx, messageEvents)
+	if rf, ok := ret.Get(0).(func(context.Context, string, chan<- *event.MessageEvent, func()) error); ok {
+		r0 = rf(ctx, roomId, messageEvents, ready)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,14 +44,16 @@ type MessageEventGatewayMock_Receive_Call struct {
 
 // Receive is a helper method to define mock.On call
 //   - ctx context.Context
+//   - roomId string
 //   - messageEvents chan<- *event.MessageEvent
-func (_e *MessageEventGatewayMock_Expecter) Receive(ctx interface{}, messageEvents interface{}) *MessageEventGatewayMock_Receive_Call {
-	return &MessageEventGatewayMock_Receive_Call{Call: _e.mock.On("Receive", ctx, messageEvents)}
+//   - ready func()
+func (_e *MessageEventGatewayMock_Expecter) Receive(ctx interface{}, roomId interface{}, messageEvents interface{}, ready interface{}) *MessageEventGatewayMock_Receive_Call {
+	return &MessageEventGatewayMock_Receive_Call{Call: _e.mock.On("Receive", ctx, roomId, messageEvents, ready)}
 }
 
-func (_c *MessageEventGatewayMock_Receive_Call) Run(run func(ctx context.Context, messageEvents chan<- *event.MessageEvent)) *MessageEventGatewayMock_Receive_Call {
+func (_c *MessageEventGatewayMock_Receive_Call) Run(run func(ctx context.Context, roomId string, messageEvents chan<- *event.MessageEvent, ready func())) *MessageEventGatewayMock_Receive_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(chan<- *event.MessageEvent))
+		run(args[0].(context.Context), args[1].(string), args[2].(chan<- *event.MessageEvent), args[3].(func()))
 	})
 	return _c
 }
@@ -61,7 +63,7 @@ func (_c *MessageEventGatewayMock_Receive_Call) Return(_a0 error) *MessageEventG
 	return _c
 }
 
-func (_c *MessageEventGatewayMock_Receive_Call) RunAndReturn(run func(context.Context, chan<- *event.MessageEvent) error) *MessageEventGatewayMock_Receive_Call {
+func (_c *MessageEventGatewayMock_Receive_Call) RunAndReturn(run func(context.Context, string, chan<- *event.MessageEvent, func()) error) *MessageEventGatewayMock_Receive_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8,6 +8,8 @@ import (
 	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+
 	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
 )
 
@@ -79,6 +81,118 @@ func (_c *MessageRepositoryMock_FindById_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListByRoom provides a mock function with given fields: ctx, roomId, query
+func (_m *MessageRepositoryMock) ListByRoom(ctx context.Context, roomId *valueobject.Id, query *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error) {
+	ret := _m.Called(ctx, roomId, query)
+
+	var r0 *pagination.CursorPage[*entity.Message]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error)); ok {
+		return rf(ctx, roomId, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *pagination.CursorQuery) *pagination.CursorPage[*entity.Message]); ok {
+		r0 = rf(ctx, roomId, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.CursorPage[*entity.Message])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id, *pagination.CursorQuery) error); ok {
+		r1 = rf(ctx, roomId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageRepositoryMock_ListByRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByRoom'
+type MessageRepositoryMock_ListByRoom_Call struct {
+	*mock.Call
+}
+
+// ListByRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+//   - query *pagination.CursorQuery
+func (_e *MessageRepositoryMock_Expecter) ListByRoom(ctx interface{}, roomId interface{}, query interface{}) *MessageRepositoryMock_ListByRoom_Call {
+	return &MessageRepositoryMock_ListByRoom_Call{Call: _e.mock.On("ListByRoom", ctx, roomId, query)}
+}
+
+func (_c *MessageRepositoryMock_ListByRoom_Call) Run(run func(ctx context.Context, roomId *valueobject.Id, query *pagination.CursorQuery)) *MessageRepositoryMock_ListByRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id), args[2].(*pagination.CursorQuery))
+	})
+	return _c
+}
+
+func (_c *MessageRepositoryMock_ListByRoom_Call) Return(_a0 *pagination.CursorPage[*entity.Message], _a1 error) *MessageRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageRepositoryMock_ListByRoom_Call) RunAndReturn(run func(context.Context, *valueobject.Id, *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error)) *MessageRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplies provides a mock function with given fields: ctx, parentId, query
+func (_m *MessageRepositoryMock) ListReplies(ctx context.Context, parentId *valueobject.Id, query *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error) {
+	ret := _m.Called(ctx, parentId, query)
+
+	var r0 *pagination.CursorPage[*entity.Message]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error)); ok {
+		return rf(ctx, parentId, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *pagination.CursorQuery) *pagination.CursorPage[*entity.Message]); ok {
+		r0 = rf(ctx, parentId, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.CursorPage[*entity.Message])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id, *pagination.CursorQuery) error); ok {
+		r1 = rf(ctx, parentId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageRepositoryMock_ListReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplies'
+type MessageRepositoryMock_ListReplies_Call struct {
+	*mock.Call
+}
+
+// ListReplies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentId *valueobject.Id
+//   - query *pagination.CursorQuery
+func (_e *MessageRepositoryMock_Expecter) ListReplies(ctx interface{}, parentId interface{}, query interface{}) *MessageRepositoryMock_ListReplies_Call {
+	return &MessageRepositoryMock_ListReplies_Call{Call: _e.mock.On("ListReplies", ctx, parentId, query)}
+}
+
+func (_c *MessageRepositoryMock_ListReplies_Call) Run(run func(ctx context.Context, parentId *valueobject.Id, query *pagination.CursorQuery)) *MessageRepositoryMock_ListReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id), args[2].(*pagination.CursorQuery))
+	})
+	return _c
+}
+
+func (_c *MessageRepositoryMock_ListReplies_Call) Return(_a0 *pagination.CursorPage[*entity.Message], _a1 error) *MessageRepositoryMock_ListReplies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageRepositoryMock_ListReplies_Call) RunAndReturn(run func(context.Context, *valueobject.Id, *pagination.CursorQuery) (*pagination.CursorPage[*entity.Message], error)) *MessageRepositoryMock_ListReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, message
 func (_m *MessageRepositoryMock) Save(ctx context.Context, message *entity.Message) error {
 	ret := _m.Called(ctx, message)
@@ -122,6 +236,49 @@ func (_c *MessageRepositoryMock_Save_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// Update provides a mock function with given fields: ctx, message
+func (_m *MessageRepositoryMock) Update(ctx context.Context, message *entity.Message) error {
+	ret := _m.Called(ctx, message)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Message) error); ok {
+		r0 = rf(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MessageRepositoryMock_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MessageRepositoryMock_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *entity.Message
+func (_e *MessageRepositoryMock_Expecter) Update(ctx interface{}, message interface{}) *MessageRepositoryMock_Update_Call {
+	return &MessageRepositoryMock_Update_Call{Call: _e.mock.On("Update", ctx, message)}
+}
+
+func (_c *MessageRepositoryMock_Update_Call) Run(run func(ctx context.Context, message *entity.Message)) *MessageRepositoryMock_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Message))
+	})
+	return _c
+}
+
+func (_c *MessageRepositoryMock_Update_Call) Return(_a0 error) *MessageRepositoryMock_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MessageRepositoryMock_Update_Call) RunAndReturn(run func(context.Context, *entity.Message) error) *MessageRepositoryMock_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMessageRepositoryMock creates a new instance of MessageRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMessageRepositoryMock(t interface {
@@ -0,0 +1,137 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// MessageRevisionRepositoryMock is an autogenerated mock type for the MessageRevisionRepository type
+type MessageRevisionRepositoryMock struct {
+	mock.Mock
+}
+
+type MessageRevisionRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MessageRevisionRepositoryMock) EXPECT() *MessageRevisionRepositoryMock_Expecter {
+	return &MessageRevisionRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ListByMessage provides a mock function with given fields: ctx, messageId
+func (_m *MessageRevisionRepositoryMock) ListByMessage(ctx context.Context, messageId *valueobject.Id) ([]*entity.MessageRevision, error) {
+	ret := _m.Called(ctx, messageId)
+
+	var r0 []*entity.MessageRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) ([]*entity.MessageRevision, error)); ok {
+		return rf(ctx, messageId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) []*entity.MessageRevision); ok {
+		r0 = rf(ctx, messageId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.MessageRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id) error); ok {
+		r1 = rf(ctx, messageId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageRevisionRepositoryMock_ListByMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByMessage'
+type MessageRevisionRepositoryMock_ListByMessage_Call struct {
+	*mock.Call
+}
+
+// ListByMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageId *valueobject.Id
+func (_e *MessageRevisionRepositoryMock_Expecter) ListByMessage(ctx interface{}, messageId interface{}) *MessageRevisionRepositoryMock_ListByMessage_Call {
+	return &MessageRevisionRepositoryMock_ListByMessage_Call{Call: _e.mock.On("ListByMessage", ctx, messageId)}
+}
+
+func (_c *MessageRevisionRepositoryMock_ListByMessage_Call) Run(run func(ctx context.Context, messageId *valueobject.Id)) *MessageRevisionRepositoryMock_ListByMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id))
+	})
+	return _c
+}
+
+func (_c *MessageRevisionRepositoryMock_ListByMessage_Call) Return(_a0 []*entity.MessageRevision, _a1 error) *MessageRevisionRepositoryMock_ListByMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageRevisionRepositoryMock_ListByMessage_Call) RunAndReturn(run func(context.Context, *valueobject.Id) ([]*entity.MessageRevision, error)) *MessageRevisionRepositoryMock_ListByMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, revision
+func (_m *MessageRevisionRepositoryMock) Save(ctx context.Context, revision *entity.MessageRevision) error {
+	ret := _m.Called(ctx, revision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.MessageRevision) error); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MessageRevisionRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MessageRevisionRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision *entity.MessageRevision
+func (_e *MessageRevisionRepositoryMock_Expecter) Save(ctx interface{}, revision interface{}) *MessageRevisionRepositoryMock_Save_Call {
+	return &MessageRevisionRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, revision)}
+}
+
+func (_c *MessageRevisionRepositoryMock_Save_Call) Run(run func(ctx context.Context, revision *entity.MessageRevision)) *MessageRevisionRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.MessageRevision))
+	})
+	return _c
+}
+
+func (_c *MessageRevisionRepositoryMock_Save_Call) Return(_a0 error) *MessageRevisionRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MessageRevisionRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.MessageRevision) error) *MessageRevisionRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMessageRevisionRepositoryMock creates a new instance of MessageRevisionRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMessageRevisionRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MessageRevisionRepositoryMock {
+	mock := &MessageRevisionRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
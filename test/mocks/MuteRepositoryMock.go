@@ -0,0 +1,181 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// MuteRepositoryMock is an autogenerated mock type for the MuteRepository type
+type MuteRepositoryMock struct {
+	mock.Mock
+}
+
+type MuteRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MuteRepositoryMock) EXPECT() *MuteRepositoryMock_Expecter {
+	return &MuteRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, mute
+func (_m *MuteRepositoryMock) Delete(ctx context.Context, mute *entity.Mute) error {
+	ret := _m.Called(ctx, mute)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Mute) error); ok {
+		r0 = rf(ctx, mute)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MuteRepositoryMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MuteRepositoryMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mute *entity.Mute
+func (_e *MuteRepositoryMock_Expecter) Delete(ctx interface{}, mute interface{}) *MuteRepositoryMock_Delete_Call {
+	return &MuteRepositoryMock_Delete_Call{Call: _e.mock.On("Delete", ctx, mute)}
+}
+
+func (_c *MuteRepositoryMock_Delete_Call) Run(run func(ctx context.Context, mute *entity.Mute)) *MuteRepositoryMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Mute))
+	})
+	return _c
+}
+
+func (_c *MuteRepositoryMock_Delete_Call) Return(_a0 error) *MuteRepositoryMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MuteRepositoryMock_Delete_Call) RunAndReturn(run func(context.Context, *entity.Mute) error) *MuteRepositoryMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByRoomAndUser provides a mock function with given fields: ctx, roomId, userId
+func (_m *MuteRepositoryMock) FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Mute, error) {
+	ret := _m.Called(ctx, roomId, userId)
+
+	var r0 *entity.Mute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Mute, error)); ok {
+		return rf(ctx, roomId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) *entity.Mute); ok {
+		r0 = rf(ctx, roomId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Mute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id, *valueobject.UserId) error); ok {
+		r1 = rf(ctx, roomId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MuteRepositoryMock_FindByRoomAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRoomAndUser'
+type MuteRepositoryMock_FindByRoomAndUser_Call struct {
+	*mock.Call
+}
+
+// FindByRoomAndUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+//   - userId *valueobject.UserId
+func (_e *MuteRepositoryMock_Expecter) FindByRoomAndUser(ctx interface{}, roomId interface{}, userId interface{}) *MuteRepositoryMock_FindByRoomAndUser_Call {
+	return &MuteRepositoryMock_FindByRoomAndUser_Call{Call: _e.mock.On("FindByRoomAndUser", ctx, roomId, userId)}
+}
+
+func (_c *MuteRepositoryMock_FindByRoomAndUser_Call) Run(run func(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId)) *MuteRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id), args[2].(*valueobject.UserId))
+	})
+	return _c
+}
+
+func (_c *MuteRepositoryMock_FindByRoomAndUser_Call) Return(_a0 *entity.Mute, _a1 error) *MuteRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MuteRepositoryMock_FindByRoomAndUser_Call) RunAndReturn(run func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Mute, error)) *MuteRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, mute
+func (_m *MuteRepositoryMock) Save(ctx context.Context, mute *entity.Mute) error {
+	ret := _m.Called(ctx, mute)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Mute) error); ok {
+		r0 = rf(ctx, mute)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MuteRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MuteRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mute *entity.Mute
+func (_e *MuteRepositoryMock_Expecter) Save(ctx interface{}, mute interface{}) *MuteRepositoryMock_Save_Call {
+	return &MuteRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, mute)}
+}
+
+func (_c *MuteRepositoryMock_Save_Call) Run(run func(ctx context.Context, mute *entity.Mute)) *MuteRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Mute))
+	})
+	return _c
+}
+
+func (_c *MuteRepositoryMock_Save_Call) Return(_a0 error) *MuteRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MuteRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.Mute) error) *MuteRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMuteRepositoryMock creates a new instance of MuteRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMuteRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MuteRepositoryMock {
+	mock := &MuteRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,137 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// OwnershipTransferRepositoryMock is an autogenerated mock type for the OwnershipTransferRepository type
+type OwnershipTransferRepositoryMock struct {
+	mock.Mock
+}
+
+type OwnershipTransferRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OwnershipTransferRepositoryMock) EXPECT() *OwnershipTransferRepositoryMock_Expecter {
+	return &OwnershipTransferRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ListByRoom provides a mock function with given fields: ctx, roomId
+func (_m *OwnershipTransferRepositoryMock) ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.OwnershipTransfer, error) {
+	ret := _m.Called(ctx, roomId)
+
+	var r0 []*entity.OwnershipTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) ([]*entity.OwnershipTransfer, error)); ok {
+		return rf(ctx, roomId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) []*entity.OwnershipTransfer); ok {
+		r0 = rf(ctx, roomId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.OwnershipTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id) error); ok {
+		r1 = rf(ctx, roomId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OwnershipTransferRepositoryMock_ListByRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByRoom'
+type OwnershipTransferRepositoryMock_ListByRoom_Call struct {
+	*mock.Call
+}
+
+// ListByRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+func (_e *OwnershipTransferRepositoryMock_Expecter) ListByRoom(ctx interface{}, roomId interface{}) *OwnershipTransferRepositoryMock_ListByRoom_Call {
+	return &OwnershipTransferRepositoryMock_ListByRoom_Call{Call: _e.mock.On("ListByRoom", ctx, roomId)}
+}
+
+func (_c *OwnershipTransferRepositoryMock_ListByRoom_Call) Run(run func(ctx context.Context, roomId *valueobject.Id)) *OwnershipTransferRepositoryMock_ListByRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id))
+	})
+	return _c
+}
+
+func (_c *OwnershipTransferRepositoryMock_ListByRoom_Call) Return(_a0 []*entity.OwnershipTransfer, _a1 error) *OwnershipTransferRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OwnershipTransferRepositoryMock_ListByRoom_Call) RunAndReturn(run func(context.Context, *valueobject.Id) ([]*entity.OwnershipTransfer, error)) *OwnershipTransferRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, transfer
+func (_m *OwnershipTransferRepositoryMock) Save(ctx context.Context, transfer *entity.OwnershipTransfer) error {
+	ret := _m.Called(ctx, transfer)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.OwnershipTransfer) error); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OwnershipTransferRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type OwnershipTransferRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer *entity.OwnershipTransfer
+func (_e *OwnershipTransferRepositoryMock_Expecter) Save(ctx interface{}, transfer interface{}) *OwnershipTransferRepositoryMock_Save_Call {
+	return &OwnershipTransferRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, transfer)}
+}
+
+func (_c *OwnershipTransferRepositoryMock_Save_Call) Run(run func(ctx context.Context, transfer *entity.OwnershipTransfer)) *OwnershipTransferRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.OwnershipTransfer))
+	})
+	return _c
+}
+
+func (_c *OwnershipTransferRepositoryMock_Save_Call) Return(_a0 error) *OwnershipTransferRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OwnershipTransferRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.OwnershipTransfer) error) *OwnershipTransferRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOwnershipTransferRepositoryMock creates a new instance of OwnershipTransferRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOwnershipTransferRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OwnershipTransferRepositoryMock {
+	mock := &OwnershipTransferRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
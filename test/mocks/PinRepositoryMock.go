@@ -0,0 +1,233 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// PinRepositoryMock is an autogenerated mock type for the PinRepository type
+type PinRepositoryMock struct {
+	mock.Mock
+}
+
+type PinRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PinRepositoryMock) EXPECT() *PinRepositoryMock_Expecter {
+	return &PinRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CountByRoom provides a mock function with given fields: ctx, roomId
+func (_m *PinRepositoryMock) CountByRoom(ctx context.Context, roomId *valueobject.Id) (int64, error) {
+	ret := _m.Called(ctx, roomId)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) (int64, error)); ok {
+		return rf(ctx, roomId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) int64); ok {
+		r0 = rf(ctx, roomId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id) error); ok {
+		r1 = rf(ctx, roomId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PinRepositoryMock_CountByRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByRoom'
+type PinRepositoryMock_CountByRoom_Call struct {
+	*mock.Call
+}
+
+// CountByRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+func (_e *PinRepositoryMock_Expecter) CountByRoom(ctx interface{}, roomId interface{}) *PinRepositoryMock_CountByRoom_Call {
+	return &PinRepositoryMock_CountByRoom_Call{Call: _e.mock.On("CountByRoom", ctx, roomId)}
+}
+
+func (_c *PinRepositoryMock_CountByRoom_Call) Run(run func(ctx context.Context, roomId *valueobject.Id)) *PinRepositoryMock_CountByRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id))
+	})
+	return _c
+}
+
+func (_c *PinRepositoryMock_CountByRoom_Call) Return(_a0 int64, _a1 error) *PinRepositoryMock_CountByRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PinRepositoryMock_CountByRoom_Call) RunAndReturn(run func(context.Context, *valueobject.Id) (int64, error)) *PinRepositoryMock_CountByRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, pin
+func (_m *PinRepositoryMock) Delete(ctx context.Context, pin *entity.Pin) error {
+	ret := _m.Called(ctx, pin)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Pin) error); ok {
+		r0 = rf(ctx, pin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PinRepositoryMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PinRepositoryMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pin *entity.Pin
+func (_e *PinRepositoryMock_Expecter) Delete(ctx interface{}, pin interface{}) *PinRepositoryMock_Delete_Call {
+	return &PinRepositoryMock_Delete_Call{Call: _e.mock.On("Delete", ctx, pin)}
+}
+
+func (_c *PinRepositoryMock_Delete_Call) Run(run func(ctx context.Context, pin *entity.Pin)) *PinRepositoryMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Pin))
+	})
+	return _c
+}
+
+func (_c *PinRepositoryMock_Delete_Call) Return(_a0 error) *PinRepositoryMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PinRepositoryMock_Delete_Call) RunAndReturn(run func(context.Context, *entity.Pin) error) *PinRepositoryMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByRoom provides a mock function with given fields: ctx, roomId
+func (_m *PinRepositoryMock) ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.PinnedMessage, error) {
+	ret := _m.Called(ctx, roomId)
+
+	var r0 []*entity.PinnedMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) ([]*entity.PinnedMessage, error)); ok {
+		return rf(ctx, roomId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) []*entity.PinnedMessage); ok {
+		r0 = rf(ctx, roomId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PinnedMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id) error); ok {
+		r1 = rf(ctx, roomId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PinRepositoryMock_ListByRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByRoom'
+type PinRepositoryMock_ListByRoom_Call struct {
+	*mock.Call
+}
+
+// ListByRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+func (_e *PinRepositoryMock_Expecter) ListByRoom(ctx interface{}, roomId interface{}) *PinRepositoryMock_ListByRoom_Call {
+	return &PinRepositoryMock_ListByRoom_Call{Call: _e.mock.On("ListByRoom", ctx, roomId)}
+}
+
+func (_c *PinRepositoryMock_ListByRoom_Call) Run(run func(ctx context.Context, roomId *valueobject.Id)) *PinRepositoryMock_ListByRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id))
+	})
+	return _c
+}
+
+func (_c *PinRepositoryMock_ListByRoom_Call) Return(_a0 []*entity.PinnedMessage, _a1 error) *PinRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PinRepositoryMock_ListByRoom_Call) RunAndReturn(run func(context.Context, *valueobject.Id) ([]*entity.PinnedMessage, error)) *PinRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, pin
+func (_m *PinRepositoryMock) Save(ctx context.Context, pin *entity.Pin) error {
+	ret := _m.Called(ctx, pin)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Pin) error); ok {
+		r0 = rf(ctx, pin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PinRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type PinRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pin *entity.Pin
+func (_e *PinRepositoryMock_Expecter) Save(ctx interface{}, pin interface{}) *PinRepositoryMock_Save_Call {
+	return &PinRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, pin)}
+}
+
+func (_c *PinRepositoryMock_Save_Call) Run(run func(ctx context.Context, pin *entity.Pin)) *PinRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Pin))
+	})
+	return _c
+}
+
+func (_c *PinRepositoryMock_Save_Call) Return(_a0 error) *PinRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PinRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.Pin) error) *PinRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPinRepositoryMock creates a new instance of PinRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPinRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PinRepositoryMock {
+	mock := &PinRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,125 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/sesaquecruz/go-chat-api/internal/domain/event"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PresenceEventGatewayMock is an autogenerated mock type for the PresenceEventGateway type
+type PresenceEventGatewayMock struct {
+	mock.Mock
+}
+
+type PresenceEventGatewayMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PresenceEventGatewayMock) EXPECT() *PresenceEventGatewayMock_Expecter {
+	return &PresenceEventGatewayMock_Expecter{mock: &_m.Mock}
+}
+
+// Receive provides a mock function with given fields: ctx, presenceEvents, ready
+func (_m *PresenceEventGatewayMock) Receive(ctx context.Context, presenceEvents chan<- *event.PresenceEvent, ready func()) error {
+	ret := _m.Called(ctx, presenceEvents, ready)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- *event.PresenceEvent, func()) error); ok {
+		r0 = rf(ctx, presenceEvents, ready)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PresenceEventGatewayMock_Receive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Receive'
+type PresenceEventGatewayMock_Receive_Call struct {
+	*mock.Call
+}
+
+// Receive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - presenceEvents chan<- *event.PresenceEvent
+//   - ready func()
+func (_e *PresenceEventGatewayMock_Expecter) Receive(ctx interface{}, presenceEvents interface{}, ready interface{}) *PresenceEventGatewayMock_Receive_Call {
+	return &PresenceEventGatewayMock_Receive_Call{Call: _e.mock.On("Receive", ctx, presenceEvents, ready)}
+}
+
+func (_c *PresenceEventGatewayMock_Receive_Call) Run(run func(ctx context.Context, presenceEvents chan<- *event.PresenceEvent, ready func())) *PresenceEventGatewayMock_Receive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(chan<- *event.PresenceEvent), args[2].(func()))
+	})
+	return _c
+}
+
+func (_c *PresenceEventGatewayMock_Receive_Call) Return(_a0 error) *PresenceEventGatewayMock_Receive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PresenceEventGatewayMock_Receive_Call) RunAndReturn(run func(context.Context, chan<- *event.PresenceEvent, func()) error) *PresenceEventGatewayMock_Receive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: ctx, presenceEvent
+func (_m *PresenceEventGatewayMock) Send(ctx context.Context, presenceEvent *event.PresenceEvent) error {
+	ret := _m.Called(ctx, presenceEvent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *event.PresenceEvent) error); ok {
+		r0 = rf(ctx, presenceEvent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PresenceEventGatewayMock_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type PresenceEventGatewayMock_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - presenceEvent *event.PresenceEvent
+func (_e *PresenceEventGatewayMock_Expecter) Send(ctx interface{}, presenceEvent interface{}) *PresenceEventGatewayMock_Send_Call {
+	return &PresenceEventGatewayMock_Send_Call{Call: _e.mock.On("Send", ctx, presenceEvent)}
+}
+
+func (_c *PresenceEventGatewayMock_Send_Call) Run(run func(ctx context.Context, presenceEvent *event.PresenceEvent)) *PresenceEventGatewayMock_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*event.PresenceEvent))
+	})
+	return _c
+}
+
+func (_c *PresenceEventGatewayMock_Send_Call) Return(_a0 error) *PresenceEventGatewayMock_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PresenceEventGatewayMock_Send_Call) RunAndReturn(run func(context.Context, *event.PresenceEvent) error) *PresenceEventGatewayMock_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPresenceEventGatewayMock creates a new instance of PresenceEventGatewayMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPresenceEventGatewayMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PresenceEventGatewayMock {
+	mock := &PresenceEventGatewayMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
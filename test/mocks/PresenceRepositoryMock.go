@@ -0,0 +1,290 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// PresenceRepositoryMock is an autogenerated mock type for the PresenceRepository type
+type PresenceRepositoryMock struct {
+	mock.Mock
+}
+
+type PresenceRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PresenceRepositoryMock) EXPECT() *PresenceRepositoryMock_Expecter {
+	return &PresenceRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, presence
+func (_m *PresenceRepositoryMock) Delete(ctx context.Context, presence *entity.Presence) error {
+	ret := _m.Called(ctx, presence)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Presence) error); ok {
+		r0 = rf(ctx, presence)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PresenceRepositoryMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PresenceRepositoryMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - presence *entity.Presence
+func (_e *PresenceRepositoryMock_Expecter) Delete(ctx interface{}, presence interface{}) *PresenceRepositoryMock_Delete_Call {
+	return &PresenceRepositoryMock_Delete_Call{Call: _e.mock.On("Delete", ctx, presence)}
+}
+
+func (_c *PresenceRepositoryMock_Delete_Call) Run(run func(ctx context.Context, presence *entity.Presence)) *PresenceRepositoryMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Presence))
+	})
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_Delete_Call) Return(_a0 error) *PresenceRepositoryMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_Delete_Call) RunAndReturn(run func(context.Context, *entity.Presence) error) *PresenceRepositoryMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByRoomAndUser provides a mock function with given fields: ctx, roomId, userId
+func (_m *PresenceRepositoryMock) FindByRoomAndUser(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId) (*entity.Presence, error) {
+	ret := _m.Called(ctx, roomId, userId)
+
+	var r0 *entity.Presence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Presence, error)); ok {
+		return rf(ctx, roomId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id, *valueobject.UserId) *entity.Presence); ok {
+		r0 = rf(ctx, roomId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Presence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id, *valueobject.UserId) error); ok {
+		r1 = rf(ctx, roomId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PresenceRepositoryMock_FindByRoomAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRoomAndUser'
+type PresenceRepositoryMock_FindByRoomAndUser_Call struct {
+	*mock.Call
+}
+
+// FindByRoomAndUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+//   - userId *valueobject.UserId
+func (_e *PresenceRepositoryMock_Expecter) FindByRoomAndUser(ctx interface{}, roomId interface{}, userId interface{}) *PresenceRepositoryMock_FindByRoomAndUser_Call {
+	return &PresenceRepositoryMock_FindByRoomAndUser_Call{Call: _e.mock.On("FindByRoomAndUser", ctx, roomId, userId)}
+}
+
+func (_c *PresenceRepositoryMock_FindByRoomAndUser_Call) Run(run func(ctx context.Context, roomId *valueobject.Id, userId *valueobject.UserId)) *PresenceRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id), args[2].(*valueobject.UserId))
+	})
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_FindByRoomAndUser_Call) Return(_a0 *entity.Presence, _a1 error) *PresenceRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_FindByRoomAndUser_Call) RunAndReturn(run func(context.Context, *valueobject.Id, *valueobject.UserId) (*entity.Presence, error)) *PresenceRepositoryMock_FindByRoomAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByRoom provides a mock function with given fields: ctx, roomId
+func (_m *PresenceRepositoryMock) ListByRoom(ctx context.Context, roomId *valueobject.Id) ([]*entity.Presence, error) {
+	ret := _m.Called(ctx, roomId)
+
+	var r0 []*entity.Presence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) ([]*entity.Presence, error)); ok {
+		return rf(ctx, roomId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) []*entity.Presence); ok {
+		r0 = rf(ctx, roomId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Presence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.Id) error); ok {
+		r1 = rf(ctx, roomId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PresenceRepositoryMock_ListByRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByRoom'
+type PresenceRepositoryMock_ListByRoom_Call struct {
+	*mock.Call
+}
+
+// ListByRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId *valueobject.Id
+func (_e *PresenceRepositoryMock_Expecter) ListByRoom(ctx interface{}, roomId interface{}) *PresenceRepositoryMock_ListByRoom_Call {
+	return &PresenceRepositoryMock_ListByRoom_Call{Call: _e.mock.On("ListByRoom", ctx, roomId)}
+}
+
+func (_c *PresenceRepositoryMock_ListByRoom_Call) Run(run func(ctx context.Context, roomId *valueobject.Id)) *PresenceRepositoryMock_ListByRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id))
+	})
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_ListByRoom_Call) Return(_a0 []*entity.Presence, _a1 error) *PresenceRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_ListByRoom_Call) RunAndReturn(run func(context.Context, *valueobject.Id) ([]*entity.Presence, error)) *PresenceRepositoryMock_ListByRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpired provides a mock function with given fields: ctx
+func (_m *PresenceRepositoryMock) ListExpired(ctx context.Context) ([]*entity.Presence, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*entity.Presence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Presence, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Presence); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Presence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PresenceRepositoryMock_ListExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpired'
+type PresenceRepositoryMock_ListExpired_Call struct {
+	*mock.Call
+}
+
+// ListExpired is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PresenceRepositoryMock_Expecter) ListExpired(ctx interface{}) *PresenceRepositoryMock_ListExpired_Call {
+	return &PresenceRepositoryMock_ListExpired_Call{Call: _e.mock.On("ListExpired", ctx)}
+}
+
+func (_c *PresenceRepositoryMock_ListExpired_Call) Run(run func(ctx context.Context)) *PresenceRepositoryMock_ListExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_ListExpired_Call) Return(_a0 []*entity.Presence, _a1 error) *PresenceRepositoryMock_ListExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_ListExpired_Call) RunAndReturn(run func(context.Context) ([]*entity.Presence, error)) *PresenceRepositoryMock_ListExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, presence
+func (_m *PresenceRepositoryMock) Save(ctx context.Context, presence *entity.Presence) error {
+	ret := _m.Called(ctx, presence)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Presence) error); ok {
+		r0 = rf(ctx, presence)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PresenceRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type PresenceRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - presence *entity.Presence
+func (_e *PresenceRepositoryMock_Expecter) Save(ctx interface{}, presence interface{}) *PresenceRepositoryMock_Save_Call {
+	return &PresenceRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, presence)}
+}
+
+func (_c *PresenceRepositoryMock_Save_Call) Run(run func(ctx context.Context, presence *entity.Presence)) *PresenceRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Presence))
+	})
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_Save_Call) Return(_a0 error) *PresenceRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PresenceRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.Presence) error) *PresenceRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPresenceRepositoryMock creates a new instance of PresenceRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPresenceRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PresenceRepositoryMock {
+	mock := &PresenceRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
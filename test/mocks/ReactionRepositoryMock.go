@@ -0,0 +1,123 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReactionRepositoryMock is an autogenerated mock type for the ReactionRepository type
+type ReactionRepositoryMock struct {
+	mock.Mock
+}
+
+type ReactionRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReactionRepositoryMock) EXPECT() *ReactionRepositoryMock_Expecter {
+	return &ReactionRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, reaction
+func (_m *ReactionRepositoryMock) Delete(ctx context.Context, reaction *entity.Reaction) error {
+	ret := _m.Called(ctx, reaction)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reaction) error); ok {
+		r0 = rf(ctx, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactionRepositoryMock_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ReactionRepositoryMock_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reaction *entity.Reaction
+func (_e *ReactionRepositoryMock_Expecter) Delete(ctx interface{}, reaction interface{}) *ReactionRepositoryMock_Delete_Call {
+	return &ReactionRepositoryMock_Delete_Call{Call: _e.mock.On("Delete", ctx, reaction)}
+}
+
+func (_c *ReactionRepositoryMock_Delete_Call) Run(run func(ctx context.Context, reaction *entity.Reaction)) *ReactionRepositoryMock_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Reaction))
+	})
+	return _c
+}
+
+func (_c *ReactionRepositoryMock_Delete_Call) Return(_a0 error) *ReactionRepositoryMock_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReactionRepositoryMock_Delete_Call) RunAndReturn(run func(context.Context, *entity.Reaction) error) *ReactionRepositoryMock_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, reaction
+func (_m *ReactionRepositoryMock) Save(ctx context.Context, reaction *entity.Reaction) error {
+	ret := _m.Called(ctx, reaction)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Reaction) error); ok {
+		r0 = rf(ctx, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactionRepositoryMock_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type ReactionRepositoryMock_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reaction *entity.Reaction
+func (_e *ReactionRepositoryMock_Expecter) Save(ctx interface{}, reaction interface{}) *ReactionRepositoryMock_Save_Call {
+	return &ReactionRepositoryMock_Save_Call{Call: _e.mock.On("Save", ctx, reaction)}
+}
+
+func (_c *ReactionRepositoryMock_Save_Call) Run(run func(ctx context.Context, reaction *entity.Reaction)) *ReactionRepositoryMock_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Reaction))
+	})
+	return _c
+}
+
+func (_c *ReactionRepositoryMock_Save_Call) Return(_a0 error) *ReactionRepositoryMock_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReactionRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *entity.Reaction) error) *ReactionRepositoryMock_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReactionRepositoryMock creates a new instance of ReactionRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReactionRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReactionRepositoryMock {
+	mock := &ReactionRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -81,6 +81,49 @@ func (_c *RoomRepositoryMock_FindById_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Lock provides a mock function with given fields: ctx, id
+func (_m *RoomRepositoryMock) Lock(ctx context.Context, id *valueobject.Id) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.Id) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RoomRepositoryMock_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type RoomRepositoryMock_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id *valueobject.Id
+func (_e *RoomRepositoryMock_Expecter) Lock(ctx interface{}, id interface{}) *RoomRepositoryMock_Lock_Call {
+	return &RoomRepositoryMock_Lock_Call{Call: _e.mock.On("Lock", ctx, id)}
+}
+
+func (_c *RoomRepositoryMock_Lock_Call) Run(run func(ctx context.Context, id *valueobject.Id)) *RoomRepositoryMock_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.Id))
+	})
+	return _c
+}
+
+func (_c *RoomRepositoryMock_Lock_Call) Return(_a0 error) *RoomRepositoryMock_Lock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RoomRepositoryMock_Lock_Call) RunAndReturn(run func(context.Context, *valueobject.Id) error) *RoomRepositoryMock_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: ctx, room
 func (_m *RoomRepositoryMock) Save(ctx context.Context, room *entity.Room) error {
 	ret := _m.Called(ctx, room)
@@ -124,25 +167,25 @@ func (_c *RoomRepositoryMock_Save_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
-// Search provides a mock function with given fields: ctx, query
-func (_m *RoomRepositoryMock) Search(ctx context.Context, query *pagination.Query) (*pagination.Page[*entity.Room], error) {
-	ret := _m.Called(ctx, query)
+// Search provides a mock function with given fields: ctx, userId, query
+func (_m *RoomRepositoryMock) Search(ctx context.Context, userId *valueobject.UserId, query *pagination.Query) (*pagination.Page[*entity.Room], error) {
+	ret := _m.Called(ctx, userId, query)
 
 	var r0 *pagination.Page[*entity.Room]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pagination.Query) (*pagination.Page[*entity.Room], error)); ok {
-		return rf(ctx, query)
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *pagination.Query) (*pagination.Page[*entity.Room], error)); ok {
+		return rf(ctx, userId, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pagination.Query) *pagination.Page[*entity.Room]); ok {
-		r0 = rf(ctx, query)
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *pagination.Query) *pagination.Page[*entity.Room]); ok {
+		r0 = rf(ctx, userId, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*pagination.Page[*entity.Room])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pagination.Query) error); ok {
-		r1 = rf(ctx, query)
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.UserId, *pagination.Query) error); ok {
+		r1 = rf(ctx, userId, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -157,14 +200,15 @@ type RoomRepositoryMock_Search_Call struct {
 
 // Search is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userId *valueobject.UserId
 //   - query *pagination.Query
-func (_e *RoomRepositoryMock_Expecter) Search(ctx interface{}, query interface{}) *RoomRepositoryMock_Search_Call {
-	return &RoomRepositoryMock_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+func (_e *RoomRepositoryMock_Expecter) Search(ctx interface{}, userId interface{}, query interface{}) *RoomRepositoryMock_Search_Call {
+	return &RoomRepositoryMock_Search_Call{Call: _e.mock.On("Search", ctx, userId, query)}
 }
 
-func (_c *RoomRepositoryMock_Search_Call) Run(run func(ctx context.Context, query *pagination.Query)) *RoomRepositoryMock_Search_Call {
+func (_c *RoomRepositoryMock_Search_Call) Run(run func(ctx context.Context, userId *valueobject.UserId, query *pagination.Query)) *RoomRepositoryMock_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*pagination.Query))
+		run(args[0].(context.Context), args[1].(*valueobject.UserId), args[2].(*pagination.Query))
 	})
 	return _c
 }
@@ -174,7 +218,7 @@ func (_c *RoomRepositoryMock_Search_Call) Return(_a0 *pagination.Page[*entity.Ro
 	return _c
 }
 
-func (_c *RoomRepositoryMock_Search_Call) RunAndReturn(run func(context.Context, *pagination.Query) (*pagination.Page[*entity.Room], error)) *RoomRepositoryMock_Search_Call {
+func (_c *RoomRepositoryMock_Search_Call) RunAndReturn(run func(context.Context, *valueobject.UserId, *pagination.Query) (*pagination.Page[*entity.Room], error)) *RoomRepositoryMock_Search_Call {
 	_c.Call.Return(run)
 	return _c
 }
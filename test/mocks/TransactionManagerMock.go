@@ -0,0 +1,79 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransactionManagerMock is an autogenerated mock type for the TransactionManager type
+type TransactionManagerMock struct {
+	mock.Mock
+}
+
+type TransactionManagerMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactionManagerMock) EXPECT() *TransactionManagerMock_Expecter {
+	return &TransactionManagerMock_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, fn
+func (_m *TransactionManagerMock) Execute(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionManagerMock_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type TransactionManagerMock_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *TransactionManagerMock_Expecter) Execute(ctx interface{}, fn interface{}) *TransactionManagerMock_Execute_Call {
+	return &TransactionManagerMock_Execute_Call{Call: _e.mock.On("Execute", ctx, fn)}
+}
+
+func (_c *TransactionManagerMock_Execute_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *TransactionManagerMock_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *TransactionManagerMock_Execute_Call) Return(_a0 error) *TransactionManagerMock_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionManagerMock_Execute_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *TransactionManagerMock_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactionManagerMock creates a new instance of TransactionManagerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactionManagerMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactionManagerMock {
+	mock := &TransactionManagerMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
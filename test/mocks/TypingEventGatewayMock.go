@@ -0,0 +1,126 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	event "github.com/sesaquecruz/go-chat-api/internal/domain/event"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TypingEventGatewayMock is an autogenerated mock type for the TypingEventGateway type
+type TypingEventGatewayMock struct {
+	mock.Mock
+}
+
+type TypingEventGatewayMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TypingEventGatewayMock) EXPECT() *TypingEventGatewayMock_Expecter {
+	return &TypingEventGatewayMock_Expecter{mock: &_m.Mock}
+}
+
+// Receive provides a mock function with given fields: ctx, roomId, typingEvents, ready
+func (_m *TypingEventGatewayMock) Receive(ctx context.Context, roomId string, typingEvents chan<- *event.TypingEvent, ready func()) error {
+	ret := _m.Called(ctx, roomId, typingEvents, ready)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, chan<- *event.TypingEvent, func()) error); ok {
+		r0 = rf(ctx, roomId, typingEvents, ready)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TypingEventGatewayMock_Receive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Receive'
+type TypingEventGatewayMock_Receive_Call struct {
+	*mock.Call
+}
+
+// Receive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomId string
+//   - typingEvents chan<- *event.TypingEvent
+//   - ready func()
+func (_e *TypingEventGatewayMock_Expecter) Receive(ctx interface{}, roomId interface{}, typingEvents interface{}, ready interface{}) *TypingEventGatewayMock_Receive_Call {
+	return &TypingEventGatewayMock_Receive_Call{Call: _e.mock.On("Receive", ctx, roomId, typingEvents, ready)}
+}
+
+func (_c *TypingEventGatewayMock_Receive_Call) Run(run func(ctx context.Context, roomId string, typingEvents chan<- *event.TypingEvent, ready func())) *TypingEventGatewayMock_Receive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(chan<- *event.TypingEvent), args[3].(func()))
+	})
+	return _c
+}
+
+func (_c *TypingEventGatewayMock_Receive_Call) Return(_a0 error) *TypingEventGatewayMock_Receive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TypingEventGatewayMock_Receive_Call) RunAndReturn(run func(context.Context, string, chan<- *event.TypingEvent, func()) error) *TypingEventGatewayMock_Receive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: ctx, typingEvent
+func (_m *TypingEventGatewayMock) Send(ctx context.Context, typingEvent *event.TypingEvent) error {
+	ret := _m.Called(ctx, typingEvent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *event.TypingEvent) error); ok {
+		r0 = rf(ctx, typingEvent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TypingEventGatewayMock_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type TypingEventGatewayMock_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - typingEvent *event.TypingEvent
+func (_e *TypingEventGatewayMock_Expecter) Send(ctx interface{}, typingEvent interface{}) *TypingEventGatewayMock_Send_Call {
+	return &TypingEventGatewayMock_Send_Call{Call: _e.mock.On("Send", ctx, typingEvent)}
+}
+
+func (_c *TypingEventGatewayMock_Send_Call) Run(run func(ctx context.Context, typingEvent *event.TypingEvent)) *TypingEventGatewayMock_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*event.TypingEvent))
+	})
+	return _c
+}
+
+func (_c *TypingEventGatewayMock_Send_Call) Return(_a0 error) *TypingEventGatewayMock_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TypingEventGatewayMock_Send_Call) RunAndReturn(run func(context.Context, *event.TypingEvent) error) *TypingEventGatewayMock_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTypingEventGatewayMock creates a new instance of TypingEventGatewayMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTypingEventGatewayMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TypingEventGatewayMock {
+	mock := &TypingEventGatewayMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
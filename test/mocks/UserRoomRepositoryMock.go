@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/sesaquecruz/go-chat-api/internal/domain/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	pagination "github.com/sesaquecruz/go-chat-api/internal/domain/pagination"
+
+	valueobject "github.com/sesaquecruz/go-chat-api/internal/domain/valueobject"
+)
+
+// UserRoomRepositoryMock is an autogenerated mock type for the UserRoomRepository type
+type UserRoomRepositoryMock struct {
+	mock.Mock
+}
+
+type UserRoomRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserRoomRepositoryMock) EXPECT() *UserRoomRepositoryMock_Expecter {
+	return &UserRoomRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// ListByUser provides a mock function with given fields: ctx, userId, query
+func (_m *UserRoomRepositoryMock) ListByUser(ctx context.Context, userId *valueobject.UserId, query *pagination.Query) (*pagination.Page[*entity.UserRoom], error) {
+	ret := _m.Called(ctx, userId, query)
+
+	var r0 *pagination.Page[*entity.UserRoom]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *pagination.Query) (*pagination.Page[*entity.UserRoom], error)); ok {
+		return rf(ctx, userId, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *valueobject.UserId, *pagination.Query) *pagination.Page[*entity.UserRoom]); ok {
+		r0 = rf(ctx, userId, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Page[*entity.UserRoom])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *valueobject.UserId, *pagination.Query) error); ok {
+		r1 = rf(ctx, userId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRoomRepositoryMock_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type UserRoomRepositoryMock_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId *valueobject.UserId
+//   - query *pagination.Query
+func (_e *UserRoomRepositoryMock_Expecter) ListByUser(ctx interface{}, userId interface{}, query interface{}) *UserRoomRepositoryMock_ListByUser_Call {
+	return &UserRoomRepositoryMock_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userId, query)}
+}
+
+func (_c *UserRoomRepositoryMock_ListByUser_Call) Run(run func(ctx context.Context, userId *valueobject.UserId, query *pagination.Query)) *UserRoomRepositoryMock_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*valueobject.UserId), args[2].(*pagination.Query))
+	})
+	return _c
+}
+
+func (_c *UserRoomRepositoryMock_ListByUser_Call) Return(_a0 *pagination.Page[*entity.UserRoom], _a1 error) *UserRoomRepositoryMock_ListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRoomRepositoryMock_ListByUser_Call) RunAndReturn(run func(context.Context, *valueobject.UserId, *pagination.Query) (*pagination.Page[*entity.UserRoom], error)) *UserRoomRepositoryMock_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserRoomRepositoryMock creates a new instance of UserRoomRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserRoomRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserRoomRepositoryMock {
+	mock := &UserRoomRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
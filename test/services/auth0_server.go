@@ -19,14 +19,15 @@ import (
 
 type Claims struct {
 	jwt.Claims
-	Issuer    string           `json:"iss,omitempty"`
-	Subject   string           `json:"sub,omitempty"`
-	Audience  jwt.Audience     `json:"aud,omitempty"`
-	Expiry    *jwt.NumericDate `json:"exp,omitempty"`
-	NotBefore *jwt.NumericDate `json:"nbf,omitempty"`
-	IssuedAt  *jwt.NumericDate `json:"iat,omitempty"`
-	ID        string           `json:"jti,omitempty"`
-	Nickname  string           `json:"https://nickname.com"`
+	Issuer      string           `json:"iss,omitempty"`
+	Subject     string           `json:"sub,omitempty"`
+	Audience    jwt.Audience     `json:"aud,omitempty"`
+	Expiry      *jwt.NumericDate `json:"exp,omitempty"`
+	NotBefore   *jwt.NumericDate `json:"nbf,omitempty"`
+	IssuedAt    *jwt.NumericDate `json:"iat,omitempty"`
+	ID          string           `json:"jti,omitempty"`
+	Nickname    string           `json:"https://nickname.com"`
+	Permissions []string         `json:"permissions,omitempty"`
 }
 
 type Auth0Server struct {
@@ -133,12 +134,13 @@ func (s *Auth0Server) GenerateSub() string {
 	return fmt.Sprintf("auth0|%s", strings.ReplaceAll(uuid.NewString(), "-", "")[:24])
 }
 
-func (s *Auth0Server) GenerateJWT(subject string) (string, error) {
+func (s *Auth0Server) GenerateJWT(subject string, permissions ...string) (string, error) {
 	claims := Claims{
-		Issuer:   s.GetIssuer(),
-		Audience: []string{s.GetAudience()},
-		Subject:  subject,
-		Nickname: s.GetNickname(),
+		Issuer:      s.GetIssuer(),
+		Audience:    []string{s.GetAudience()},
+		Subject:     subject,
+		Nickname:    s.GetNickname(),
+		Permissions: permissions,
 	}
 
 	token, err := jwt.Signed(s.signer).Claims(claims).CompactSerialize()